@@ -2,14 +2,17 @@ package app
 
 import (
 	"encoding/json"
+	"fmt"
 
 	"github.com/tendermint/tendermint/libs/log"
 
 	"github.com/PhenixChain/PhenixChain/codec"
 	"github.com/PhenixChain/PhenixChain/x/auth"
 	"github.com/PhenixChain/PhenixChain/x/bank"
+	"github.com/PhenixChain/PhenixChain/x/crisis"
 	"github.com/PhenixChain/PhenixChain/x/params"
 	"github.com/PhenixChain/PhenixChain/x/staking"
+	"github.com/PhenixChain/PhenixChain/x/token"
 
 	bam "github.com/PhenixChain/PhenixChain/baseapp"
 	sdk "github.com/PhenixChain/PhenixChain/types"
@@ -27,40 +30,56 @@ type nameServiceApp struct {
 	*bam.BaseApp
 	cdc *codec.Codec
 
+	// encoder serializes transactions and accounts in the encoding chosen at genesis
+	encoder *codec.Encoder
+
 	keyMain          *sdk.KVStoreKey
 	keyAddress       *sdk.KVStoreKey
 	keyAccount       *sdk.KVStoreKey
 	keyFeeCollection *sdk.KVStoreKey
+	keyBank          *sdk.KVStoreKey
 	keyParams        *sdk.KVStoreKey
 	tkeyParams       *sdk.TransientStoreKey
+	keyToken         *sdk.KVStoreKey
 
 	accountKeeper       auth.AccountKeeper
 	bankKeeper          bank.Keeper
 	txKeeper            bank.TxKeeper
 	feeCollectionKeeper auth.FeeCollectionKeeper
 	paramsKeeper        params.Keeper
+	crisisKeeper        crisis.Keeper
+	tokenKeeper         token.Keeper
 }
 
-// NewNameServiceApp is a constructor function for nameServiceApp
-func NewNameServiceApp(logger log.Logger, db dbm.DB) *nameServiceApp {
+// NewNameServiceApp is a constructor function for nameServiceApp. When
+// loadLatest is false no version is loaded and LoadHeight must be called
+// before the app is used.
+func NewNameServiceApp(logger log.Logger, db dbm.DB, loadLatest bool, baseAppOptions ...func(*bam.BaseApp)) *nameServiceApp {
 
 	// First define the top level codec that will be shared by the different modules
 	cdc := MakeCodec()
 
+	// The encoding is read from the genesis file or the stored params once
+	// known, until then JSON written before it could be chosen is assumed
+	encoder := codec.NewEncoder(cdc, codec.EncodingJSON)
+
 	// BaseApp handles interactions with Tendermint through the ABCI protocol
-	bApp := bam.NewBaseApp(appName, logger, db, auth.DefaultTxDecoder(cdc))
+	bApp := bam.NewBaseApp(appName, logger, db, auth.NewTxDecoder(encoder), baseAppOptions...)
 
 	// Here you initialize your application with the store keys it requires
 	var app = &nameServiceApp{
 		BaseApp: bApp,
 		cdc:     cdc,
+		encoder: encoder,
 
 		keyMain:          sdk.NewKVStoreKey(bam.MainStoreKey),
 		keyAccount:       sdk.NewKVStoreKey(auth.StoreKey),
 		keyAddress:       sdk.NewKVStoreKey(auth.StoreAdrKey),
 		keyFeeCollection: sdk.NewKVStoreKey(auth.FeeStoreKey),
+		keyBank:          sdk.NewKVStoreKey(bank.StoreKey),
 		keyParams:        sdk.NewKVStoreKey(params.StoreKey),
 		tkeyParams:       sdk.NewTransientStoreKey(params.TStoreKey),
+		keyToken:         sdk.NewKVStoreKey(token.StoreKey),
 	}
 
 	// The ParamsKeeper handles parameter storage for the application
@@ -72,32 +91,58 @@ func NewNameServiceApp(logger log.Logger, db dbm.DB) *nameServiceApp {
 		app.keyAccount,
 		app.paramsKeeper.Subspace(auth.DefaultParamspace),
 		auth.ProtoBaseAccount,
-	)
+	).WithEncoder(encoder)
 
 	// add txKeeper --nikolas
-	app.txKeeper = bank.NewTxKeeper(cdc, app.keyAddress)
+	app.txKeeper = bank.NewTxKeeper(cdc, app.keyAddress).WithEncoder(encoder)
 	// The BankKeeper allows you perform sdk.Coins interactions
 	app.bankKeeper = bank.NewBaseKeeper(
 		app.accountKeeper,
 		app.txKeeper,
+		app.keyBank,
 		app.paramsKeeper.Subspace(bank.DefaultParamspace),
 		bank.DefaultCodespace,
-	)
+	).WithMetrics(bApp.Metrics())
 
 	// The FeeCollectionKeeper collects transaction fees and renders them to the fee distribution module
 	app.feeCollectionKeeper = auth.NewFeeCollectionKeeper(cdc, app.keyFeeCollection)
 
+	// The TokenKeeper handles issuance, minting and burning of user-created tokens
+	app.tokenKeeper = token.NewKeeper(
+		app.cdc,
+		app.keyToken,
+		app.paramsKeeper.Subspace(token.DefaultParamspace),
+		app.bankKeeper,
+		app.feeCollectionKeeper,
+		token.DefaultCodespace,
+	)
+
+	// The CrisisKeeper checks the registered invariants on demand. The distribution
+	// module is not mounted, so no keeper is given to refund the constant fee.
+	app.crisisKeeper = crisis.NewKeeper(
+		app.paramsKeeper.Subspace(crisis.DefaultParamspace),
+		nil,
+		app.bankKeeper,
+		app.feeCollectionKeeper,
+	)
+	bank.RegisterInvariants(&app.crisisKeeper, app.bankKeeper, app.accountKeeper, app.feeCollectionKeeper.GetCollectedFees)
+	token.RegisterInvariants(&app.crisisKeeper, app.tokenKeeper, app.accountKeeper)
+
 	// The AnteHandler handles signature verification and transaction pre-processing
 	app.SetAnteHandler(auth.NewAnteHandler(app.accountKeeper, app.feeCollectionKeeper))
 
 	// The app.Router is the main transaction router where each module registers its routes
 	// Register the bank routes here
 	app.Router().
-		AddRoute(bank.RouterKey, bank.NewHandler(app.bankKeeper))
+		AddRoute(bank.RouterKey, bank.NewHandler(app.bankKeeper)).
+		AddRoute(token.RouterKey, token.NewHandler(app.tokenKeeper)).
+		AddRoute(crisis.RouterKey, crisis.NewHandler(app.crisisKeeper))
 
 		// The app.QueryRouter is the main query router where each module registers its routes
 	app.QueryRouter().
-		AddRoute(auth.QuerierRoute, auth.NewQuerier(app.accountKeeper))
+		AddRoute(auth.QuerierRoute, auth.NewQuerier(app.accountKeeper)).
+		AddRoute(bank.QuerierRoute, bank.NewQuerier(app.bankKeeper)).
+		AddRoute(token.QuerierRoute, token.NewQuerier(app.tokenKeeper))
 
 	// The initChainer handles translating the genesis.json file into initial state for the network
 	app.SetInitChainer(app.initChainer)
@@ -107,23 +152,51 @@ func NewNameServiceApp(logger log.Logger, db dbm.DB) *nameServiceApp {
 		app.keyAccount,
 		app.keyAddress,
 		app.keyFeeCollection,
+		app.keyBank,
 		app.keyParams,
 		app.tkeyParams,
+		app.keyToken,
 	)
 
-	err := app.LoadLatestVersion(app.keyMain)
-	if err != nil {
-		cmn.Exit(err.Error())
+	if loadLatest {
+		err := app.LoadLatestVersion(app.keyMain)
+		if err != nil {
+			cmn.Exit(err.Error())
+		}
+		app.loadEncoding()
 	}
 
 	return app
 }
 
+// LoadHeight loads the application state committed at a particular height
+func (app *nameServiceApp) LoadHeight(height int64) error {
+	if err := app.LoadVersion(height, app.keyMain); err != nil {
+		return err
+	}
+	app.loadEncoding()
+	return nil
+}
+
+// loadEncoding switches the encoder to the encoding stored in the loaded state.
+// Nothing is stored before InitChain, which sets it from the genesis file.
+func (app *nameServiceApp) loadEncoding() {
+	if app.LastBlockHeight() == 0 {
+		return
+	}
+	ctx := app.NewContext(true, abci.Header{Height: app.LastBlockHeight()})
+	app.encoder.SetEncoding(app.accountKeeper.GetEncoding(ctx))
+}
+
 // GenesisState represents chain state at the start of the chain. Any initial state (account balances) are stored here.
 type GenesisState struct {
-	AuthData auth.GenesisState   `json:"auth"`
-	BankData bank.GenesisState   `json:"bank"`
-	Accounts []*auth.BaseAccount `json:"accounts"`
+	AuthData   auth.GenesisState   `json:"auth"`
+	BankData   bank.GenesisState   `json:"bank"`
+	CrisisData crisis.GenesisState `json:"crisis"`
+	TokenData  token.GenesisState  `json:"token"`
+	Accounts   []*auth.BaseAccount `json:"accounts"`
+
+	VestingAccounts []auth.VestingAccount `json:"vesting_accounts"`
 }
 
 func (app *nameServiceApp) initChainer(ctx sdk.Context, req abci.RequestInitChain) abci.ResponseInitChain {
@@ -135,37 +208,77 @@ func (app *nameServiceApp) initChainer(ctx sdk.Context, req abci.RequestInitChai
 		panic(err)
 	}
 
+	// the encoding must be set before any account is stored
+	auth.InitGenesis(ctx, app.accountKeeper, app.feeCollectionKeeper, genesisState.AuthData)
+
 	for _, acc := range genesisState.Accounts {
 		app.accountKeeper.SetAccount(ctx, acc)
 	}
+	for _, acc := range genesisState.VestingAccounts {
+		app.accountKeeper.SetAccount(ctx, acc)
+	}
 
-	auth.InitGenesis(ctx, app.accountKeeper, app.feeCollectionKeeper, genesisState.AuthData)
-	bank.InitGenesis(ctx, app.bankKeeper, genesisState.BankData)
+	// genesis files written before supply tracking start from the sum of all coins
+	if genesisState.BankData.Supply.Empty() {
+		supply := genesisState.AuthData.CollectedFees
+		for _, acc := range genesisState.Accounts {
+			supply = supply.Add(acc.Coins)
+		}
+		for _, acc := range genesisState.VestingAccounts {
+			supply = supply.Add(acc.GetCoins())
+		}
+		genesisState.BankData.Supply = supply
+	}
+
+	bank.InitGenesis(ctx, app.bankKeeper, app.txKeeper, genesisState.BankData)
+	crisis.InitGenesis(ctx, app.crisisKeeper, genesisState.CrisisData)
+	token.InitGenesis(ctx, app.tokenKeeper, genesisState.TokenData)
 
 	return abci.ResponseInitChain{}
 }
 
-// ExportAppStateAndValidators does the things
-func (app *nameServiceApp) ExportAppStateAndValidators() (appState json.RawMessage, validators []tmtypes.GenesisValidator, err error) {
-	ctx := app.NewContext(true, abci.Header{})
+// ExportAppStateAndValidators exports the state of the application at the
+// loaded height. Accounts are written out in full so that sequences, public
+// keys and vesting schedules survive a restart from the exported genesis.
+func (app *nameServiceApp) ExportAppStateAndValidators(forZeroHeight bool, jailWhiteList []string) (
+	appState json.RawMessage, validators []tmtypes.GenesisValidator, err error) {
+
+	ctx := app.NewContext(true, abci.Header{Height: app.LastBlockHeight()})
+
+	if forZeroHeight {
+		if err = app.prepForZeroHeightGenesis(ctx, jailWhiteList); err != nil {
+			return nil, nil, err
+		}
+	}
+
 	accounts := []*auth.BaseAccount{}
+	vestingAccounts := []auth.VestingAccount{}
 
 	appendAccountsFn := func(acc auth.Account) bool {
-		account := &auth.BaseAccount{
-			Address: acc.GetAddress(),
-			Coins:   acc.GetCoins(),
+		switch acc := acc.(type) {
+		case *auth.BaseAccount:
+			accounts = append(accounts, acc)
+		case auth.VestingAccount:
+			vestingAccounts = append(vestingAccounts, acc)
+		default:
+			err = fmt.Errorf("cannot export account %s of type %T", acc.GetAddress(), acc)
+			return true
 		}
-
-		accounts = append(accounts, account)
 		return false
 	}
 
 	app.accountKeeper.IterateAccounts(ctx, appendAccountsFn)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	genState := GenesisState{
-		Accounts: accounts,
-		AuthData: auth.DefaultGenesisState(),
-		BankData: bank.DefaultGenesisState(),
+		Accounts:        accounts,
+		VestingAccounts: vestingAccounts,
+		AuthData:        auth.ExportGenesis(ctx, app.accountKeeper, app.feeCollectionKeeper),
+		BankData:        bank.ExportGenesis(ctx, app.bankKeeper, app.txKeeper),
+		CrisisData:      crisis.ExportGenesis(ctx, app.crisisKeeper),
+		TokenData:       token.ExportGenesis(ctx, app.tokenKeeper),
 	}
 
 	appState, err = codec.MarshalJSONIndent(app.cdc, genState)
@@ -176,17 +289,43 @@ func (app *nameServiceApp) ExportAppStateAndValidators() (appState json.RawMessa
 	return appState, validators, err
 }
 
+// prepForZeroHeightGenesis prepares the state for a chain restarting at
+// height zero. None of the mounted modules keep state indexed by block height,
+// so the exported state can be used as is; the validator set is kept from the
+// existing genesis file since staking is not mounted.
+func (app *nameServiceApp) prepForZeroHeightGenesis(ctx sdk.Context, jailWhiteList []string) error {
+	if len(jailWhiteList) > 0 {
+		return fmt.Errorf("cannot apply jail whitelist %v: staking is not mounted", jailWhiteList)
+	}
+	return nil
+}
+
 // MakeCodec generates the necessary codecs for Amino
 func MakeCodec() *codec.Codec {
 	var cdc = codec.New()
 	auth.RegisterCodec(cdc)
 	bank.RegisterCodec(cdc)
+	crisis.RegisterCodec(cdc)
 	staking.RegisterCodec(cdc)
+	token.RegisterCodec(cdc)
 	sdk.RegisterCodec(cdc)
 	codec.RegisterCrypto(cdc)
 	return cdc
 }
 
+// StoreDecoders returns the decoders of the values in the stores mounted by the
+// app by store name, to inspect the raw state of the chain.
+func StoreDecoders() map[string]sdk.StoreDecoder {
+	return map[string]sdk.StoreDecoder{
+		auth.StoreKey:    auth.DecodeStore,
+		auth.StoreAdrKey: bank.DecodeTxStore,
+		auth.FeeStoreKey: auth.DecodeFeeStore,
+		bank.StoreKey:    bank.DecodeStore,
+		params.StoreKey:  params.DecodeStore,
+		token.StoreKey:   token.DecodeStore,
+	}
+}
+
 var _ auth.Account = (*AppAccount)(nil)
 
 // AppAccount is a custom extension for this application. It is an example of
@@ -0,0 +1,77 @@
+package app
+
+import (
+	"testing"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+	dbm "github.com/tendermint/tendermint/libs/db"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/PhenixChain/PhenixChain/codec"
+	sdk "github.com/PhenixChain/PhenixChain/types"
+	"github.com/PhenixChain/PhenixChain/x/auth"
+)
+
+// txsPerBlock is the number of send transactions of every benchmarked block
+const txsPerBlock = 100
+
+func BenchmarkBlockProcessing(b *testing.B) {
+	for _, encoding := range []codec.Encoding{codec.EncodingJSON, codec.EncodingBinary} {
+		encoding := encoding
+		b.Run(encoding.String(), func(b *testing.B) { benchmarkBlockProcessing(b, encoding) })
+	}
+}
+
+// benchmarkBlockProcessing delivers blocks of send transactions, each sent
+// from its own account, to a chain storing its state in the given encoding
+func benchmarkBlockProcessing(b *testing.B, encoding codec.Encoding) {
+	cdc := MakeCodec()
+	enc := codec.NewEncoder(cdc, encoding)
+	coins := sdk.NewCoins(sdk.NewInt64Coin("stake", 1000000))
+
+	privs := make([]crypto.PrivKey, txsPerBlock)
+	genesis := NewDefaultGenesisState()
+	genesis.AuthData.Encoding = encoding
+	for i := range privs {
+		privs[i] = secp256k1.GenPrivKey()
+		acc := auth.NewBaseAccountWithAddress(sdk.AccAddress(privs[i].PubKey().Address()))
+		acc.Coins = coins
+		genesis.Accounts = append(genesis.Accounts, &acc)
+	}
+	appState, err := codec.MarshalJSONIndent(cdc, genesis)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	app := NewNameServiceApp(log.NewNopLogger(), dbm.NewMemDB(), true)
+	app.InitChain(abci.RequestInitChain{AppStateBytes: appState})
+	app.Commit()
+
+	// sign all transactions up front, only their processing is measured
+	to := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	amt := sdk.NewCoins(sdk.NewInt64Coin("stake", 1))
+	blocks := make([][][]byte, b.N)
+	for height := range blocks {
+		blocks[height] = make([][]byte, txsPerBlock)
+		for i, priv := range privs {
+			blocks[height][i], err = auth.NewTxEncoder(enc)(signedSendTx(priv, uint64(height), to, amt))
+			if err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+
+	b.ResetTimer()
+	for _, txs := range blocks {
+		app.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: app.LastBlockHeight() + 1}})
+		for _, tx := range txs {
+			if res := app.DeliverTx(tx); !res.IsOK() {
+				b.Fatal(res.Log)
+			}
+		}
+		app.EndBlock(abci.RequestEndBlock{})
+		app.Commit()
+	}
+}
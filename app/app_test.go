@@ -0,0 +1,172 @@
+package app
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+	dbm "github.com/tendermint/tendermint/libs/db"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/PhenixChain/PhenixChain/codec"
+	sdk "github.com/PhenixChain/PhenixChain/types"
+	"github.com/PhenixChain/PhenixChain/x/auth"
+	"github.com/PhenixChain/PhenixChain/x/bank"
+	"github.com/PhenixChain/PhenixChain/x/crisis"
+	"github.com/PhenixChain/PhenixChain/x/token"
+)
+
+func initApp(t *testing.T, appState []byte) *nameServiceApp {
+	app := NewNameServiceApp(log.NewNopLogger(), dbm.NewMemDB(), true)
+	app.InitChain(abci.RequestInitChain{AppStateBytes: appState})
+	app.Commit()
+	return app
+}
+
+// signedSendTx returns a transaction sending amt from the account of priv to
+// the given address
+func signedSendTx(priv crypto.PrivKey, seq uint64, to sdk.AccAddress, amt sdk.Coins) auth.StdTx {
+	msgs := []sdk.Msg{bank.NewMsgSend(sdk.AccAddress(priv.PubKey().Address()), to, amt)}
+	fee := auth.NewStdFee(200000, nil)
+
+	sig, err := priv.Sign(auth.StdSignBytes("", seq, fee, msgs, ""))
+	if err != nil {
+		panic(err)
+	}
+
+	return auth.NewStdTx(msgs, fee, []auth.StdSignature{{PubKey: priv.PubKey(), Signature: sig}}, "")
+}
+
+func TestExportRoundTrip(t *testing.T) {
+	cdc := MakeCodec()
+
+	pk := secp256k1.GenPrivKey().PubKey()
+	addr := sdk.AccAddress(pk.Address())
+	acc := auth.NewBaseAccountWithAddress(addr)
+	acc.Coins = sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	acc.PubKey = pk
+	acc.Sequence = 7
+
+	vestingAddr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	vestingBase := auth.NewBaseAccountWithAddress(vestingAddr)
+	vestingBase.Coins = sdk.NewCoins(sdk.NewInt64Coin("stake", 50))
+	vestingAcc := auth.NewContinuousVestingAccount(&vestingBase, 1000, 2000)
+
+	bankData := bank.DefaultGenesisState()
+	bankData.TxHistory = []bank.AddressTxs{{Address: addr, Txs: []bank.Tx{{ID: "ab"}, {ID: "cd"}}}}
+
+	tokenData := token.DefaultGenesisState()
+	tokenData.Tokens = token.Tokens{
+		token.NewToken("gold", "GLD", 2, sdk.NewInt(1000), true, addr),
+	}
+
+	genesis := GenesisState{
+		AuthData:        auth.DefaultGenesisState(),
+		BankData:        bankData,
+		CrisisData:      crisis.DefaultGenesisState(),
+		TokenData:       tokenData,
+		Accounts:        []*auth.BaseAccount{&acc},
+		VestingAccounts: []auth.VestingAccount{vestingAcc},
+	}
+	appState, err := codec.MarshalJSONIndent(cdc, genesis)
+	require.NoError(t, err)
+
+	exported, validators, err := initApp(t, appState).ExportAppStateAndValidators(false, nil)
+	require.NoError(t, err)
+	require.Nil(t, validators)
+
+	var exportedGenesis GenesisState
+	require.NoError(t, cdc.UnmarshalJSON(exported, &exportedGenesis))
+	require.Equal(t, genesis.Accounts, exportedGenesis.Accounts)
+	require.Equal(t, genesis.VestingAccounts, exportedGenesis.VestingAccounts)
+	require.Equal(t, bankData.TxHistory, exportedGenesis.BankData.TxHistory)
+
+	reexported, _, err := initApp(t, exported).ExportAppStateAndValidators(false, nil)
+	require.NoError(t, err)
+	require.Equal(t, string(exported), string(reexported))
+
+	// zero height exports refuse a jail whitelist without staking
+	_, _, err = initApp(t, exported).ExportAppStateAndValidators(true, []string{"val"})
+	require.Error(t, err)
+}
+
+func TestCreateVestingAccount(t *testing.T) {
+	cdc := MakeCodec()
+
+	from := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	acc := auth.NewBaseAccountWithAddress(from)
+	acc.Coins = sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+
+	genesis := NewDefaultGenesisState()
+	genesis.Accounts = []*auth.BaseAccount{&acc}
+	appState, err := codec.MarshalJSONIndent(cdc, genesis)
+	require.NoError(t, err)
+
+	app := initApp(t, appState)
+	header := abci.Header{Height: app.LastBlockHeight() + 1, Time: time.Unix(1500, 0)}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+	ctx := app.NewContext(false, header)
+	handler := bank.NewHandler(app.bankKeeper)
+
+	to := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	amt := sdk.NewCoins(sdk.NewInt64Coin("stake", 40))
+	res := handler(ctx, bank.NewMsgCreateVestingAccount(from, to, amt, 1000, 2000, false))
+	require.True(t, res.IsOK(), res.Log)
+
+	vacc, ok := app.accountKeeper.GetAccount(ctx, to).(*auth.ContinuousVestingAccount)
+	require.True(t, ok)
+	require.Equal(t, amt, vacc.GetCoins())
+	require.Equal(t, amt, vacc.GetOriginalVesting())
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 20)), vacc.SpendableCoins(ctx.BlockHeader().Time))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 60)), app.accountKeeper.GetAccount(ctx, from).GetCoins())
+
+	// existing accounts cannot be turned into vesting accounts
+	res = handler(ctx, bank.NewMsgCreateVestingAccount(from, to, amt, 1000, 2000, true))
+	require.False(t, res.IsOK())
+}
+
+func TestEncoding(t *testing.T) {
+	cdc := MakeCodec()
+	amt := sdk.NewCoins(sdk.NewInt64Coin("stake", 10))
+
+	for _, encoding := range []codec.Encoding{codec.EncodingJSON, codec.EncodingBinary} {
+		enc := codec.NewEncoder(cdc, encoding)
+
+		priv := secp256k1.GenPrivKey()
+		acc := auth.NewBaseAccountWithAddress(sdk.AccAddress(priv.PubKey().Address()))
+		acc.Coins = sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+
+		genesis := NewDefaultGenesisState()
+		genesis.AuthData.Encoding = encoding
+		genesis.Accounts = []*auth.BaseAccount{&acc}
+		appState, err := codec.MarshalJSONIndent(cdc, genesis)
+		require.NoError(t, err)
+
+		db := dbm.NewMemDB()
+		app := NewNameServiceApp(log.NewNopLogger(), db, true)
+		app.InitChain(abci.RequestInitChain{AppStateBytes: appState})
+		app.Commit()
+
+		// a restarted app reads the encoding from its state
+		app = NewNameServiceApp(log.NewNopLogger(), db, true)
+		require.Equal(t, encoding, app.encoder.Encoding())
+
+		to := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+		txBytes, err := auth.NewTxEncoder(enc)(signedSendTx(priv, 0, to, amt))
+		require.NoError(t, err)
+
+		app.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: app.LastBlockHeight() + 1}})
+		res := app.DeliverTx(txBytes)
+		require.True(t, res.IsOK(), res.Log)
+		app.EndBlock(abci.RequestEndBlock{})
+		app.Commit()
+
+		var stored auth.Account
+		ctx := app.NewContext(true, abci.Header{})
+		require.NoError(t, enc.Unmarshal(ctx.KVStore(app.keyAccount).Get(auth.AddressStoreKey(to)), &stored))
+		require.Equal(t, amt, stored.GetCoins())
+	}
+}
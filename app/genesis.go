@@ -0,0 +1,91 @@
+package app
+
+import (
+	"fmt"
+
+	"github.com/PhenixChain/PhenixChain/x/auth"
+	"github.com/PhenixChain/PhenixChain/x/bank"
+	"github.com/PhenixChain/PhenixChain/x/crisis"
+	"github.com/PhenixChain/PhenixChain/x/token"
+)
+
+// NewDefaultGenesisState returns the default genesis state of every module
+// with no genesis accounts.
+func NewDefaultGenesisState() GenesisState {
+	return GenesisState{
+		AuthData:   auth.DefaultGenesisState(),
+		BankData:   bank.DefaultGenesisState(),
+		CrisisData: crisis.DefaultGenesisState(),
+		TokenData:  token.DefaultGenesisState(),
+	}
+}
+
+// ValidateGenesis performs validation of the genesis accounts and of the
+// genesis state of every module, returning the first failure.
+func ValidateGenesis(genesisState GenesisState) error {
+	if err := validateGenesisAccounts(genesisState.Accounts, genesisState.VestingAccounts); err != nil {
+		return err
+	}
+	if err := auth.ValidateGenesis(genesisState.AuthData); err != nil {
+		return err
+	}
+	if err := bank.ValidateGenesis(genesisState.BankData); err != nil {
+		return err
+	}
+	if err := crisis.ValidateGenesis(genesisState.CrisisData); err != nil {
+		return err
+	}
+	return token.ValidateGenesis(genesisState.TokenData)
+}
+
+// validateGenesisAccounts checks that every account has an address and valid
+// coins, that vesting schedules are well formed and that no address appears
+// twice.
+func validateGenesisAccounts(accounts []*auth.BaseAccount, vestingAccounts []auth.VestingAccount) error {
+	seen := make(map[string]bool)
+	validate := func(acc auth.Account) error {
+		addr := acc.GetAddress()
+		if addr.Empty() {
+			return fmt.Errorf("genesis account without an address")
+		}
+		if seen[addr.String()] {
+			return fmt.Errorf("duplicate genesis account %s", addr)
+		}
+		seen[addr.String()] = true
+
+		if !acc.GetCoins().IsValid() {
+			return fmt.Errorf("invalid coins for genesis account %s: %s", addr, acc.GetCoins())
+		}
+		return nil
+	}
+
+	for _, acc := range accounts {
+		if err := validate(acc); err != nil {
+			return err
+		}
+	}
+
+	for _, acc := range vestingAccounts {
+		if err := validate(acc); err != nil {
+			return err
+		}
+		addr := acc.GetAddress()
+		if !acc.GetOriginalVesting().IsValid() || acc.GetOriginalVesting().Empty() {
+			return fmt.Errorf("invalid original vesting for genesis account %s: %s", addr, acc.GetOriginalVesting())
+		}
+		if acc.GetStartTime() < 0 || acc.GetEndTime() < acc.GetStartTime() {
+			return fmt.Errorf("invalid vesting schedule for genesis account %s: start %d, end %d",
+				addr, acc.GetStartTime(), acc.GetEndTime())
+		}
+		if pva, ok := acc.(*auth.PeriodicVestingAccount); ok {
+			if err := pva.VestingPeriods.Validate(); err != nil {
+				return fmt.Errorf("invalid vesting periods for genesis account %s: %v", addr, err)
+			}
+			if pva.StartTime+pva.VestingPeriods.TotalLength() != pva.EndTime {
+				return fmt.Errorf("vesting periods of genesis account %s do not end at %d", addr, pva.EndTime)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,46 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	sdk "github.com/PhenixChain/PhenixChain/types"
+	"github.com/PhenixChain/PhenixChain/x/auth"
+	"github.com/PhenixChain/PhenixChain/x/bank"
+	"github.com/PhenixChain/PhenixChain/x/crisis"
+	"github.com/PhenixChain/PhenixChain/x/token"
+)
+
+func TestValidateGenesis(t *testing.T) {
+	addr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	acc := auth.NewBaseAccountWithAddress(addr)
+	acc.Coins = sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+
+	genesis := GenesisState{
+		AuthData:   auth.DefaultGenesisState(),
+		BankData:   bank.DefaultGenesisState(),
+		CrisisData: crisis.DefaultGenesisState(),
+		TokenData:  token.DefaultGenesisState(),
+		Accounts:   []*auth.BaseAccount{&acc},
+	}
+	require.NoError(t, ValidateGenesis(genesis))
+
+	// the same address as a vesting account
+	vestingBase := auth.NewBaseAccountWithAddress(addr)
+	vestingBase.Coins = sdk.NewCoins(sdk.NewInt64Coin("stake", 50))
+	genesis.VestingAccounts = []auth.VestingAccount{auth.NewContinuousVestingAccount(&vestingBase, 1000, 2000)}
+	require.Error(t, ValidateGenesis(genesis))
+
+	vestingBase.Address = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	genesis.VestingAccounts = []auth.VestingAccount{auth.NewContinuousVestingAccount(&vestingBase, 1000, 2000)}
+	require.NoError(t, ValidateGenesis(genesis))
+
+	genesis.VestingAccounts = []auth.VestingAccount{auth.NewContinuousVestingAccount(&vestingBase, 2000, 1000)}
+	require.Error(t, ValidateGenesis(genesis))
+	genesis.VestingAccounts = nil
+
+	genesis.BankData.TxHistory = []bank.AddressTxs{{Address: addr}, {Address: addr}}
+	require.Error(t, ValidateGenesis(genesis))
+}
@@ -0,0 +1,170 @@
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/PhenixChain/PhenixChain/codec"
+)
+
+// AppMap is the genesis app state keyed by module, kept as raw JSON so that
+// state written by older versions of the app can be rewritten before it is
+// decoded.
+type AppMap map[string]json.RawMessage
+
+// MigrationCallback converts the genesis state of a single module to the
+// layout of a newer version. The state is nil when the module is missing from
+// the genesis file, and a nil result removes the module.
+type MigrationCallback func(state json.RawMessage) (json.RawMessage, error)
+
+// MigrationMap holds the migrations of each module keyed by module name
+type MigrationMap map[string]MigrationCallback
+
+// migrationMap holds the migrations that produce the genesis layout of each
+// version from the layout of the version before it.
+var migrationMap = map[string]MigrationMap{
+	"v0.2": {
+		"bank":             migrateBankV02,
+		"vesting_accounts": defaultJSON("[]"),
+	},
+	"v0.3": {
+		"auth": migrateAuthV03,
+	},
+}
+
+// MigrationVersions returns the versions genesis files can be migrated to
+func MigrationVersions() []string {
+	versions := make([]string, 0, len(migrationMap))
+	for version := range migrationMap {
+		versions = append(versions, version)
+	}
+	sort.Slice(versions, func(i, j int) bool {
+		return versionLess(versions[i], versions[j])
+	})
+	return versions
+}
+
+// versionLess compares versions of the form vMAJOR.MINOR by their numbers, so
+// that v0.10 follows v0.9
+func versionLess(a, b string) bool {
+	as := strings.Split(strings.TrimPrefix(a, "v"), ".")
+	bs := strings.Split(strings.TrimPrefix(b, "v"), ".")
+	for i := 0; i < len(as) && i < len(bs); i++ {
+		an, aErr := strconv.Atoi(as[i])
+		bn, bErr := strconv.Atoi(bs[i])
+		if aErr != nil || bErr != nil {
+			if as[i] != bs[i] {
+				return as[i] < bs[i]
+			}
+			continue
+		}
+		if an != bn {
+			return an < bn
+		}
+	}
+	return len(as) < len(bs)
+}
+
+// Migrate rewrites the app state to the genesis layout of the target version
+// by applying the migrations of every version up to and including the target
+// in order. Migrations leave state already in their layout unchanged, so state
+// exported by any older version can be migrated.
+func Migrate(targetVersion string, appState AppMap) (AppMap, error) {
+	if _, ok := migrationMap[targetVersion]; !ok {
+		return nil, fmt.Errorf("unknown migration target version %s, expected one of %v",
+			targetVersion, MigrationVersions())
+	}
+
+	for _, version := range MigrationVersions() {
+		if err := migrateVersion(version, appState); err != nil {
+			return nil, err
+		}
+		if version == targetVersion {
+			break
+		}
+	}
+
+	return appState, nil
+}
+
+// migrateVersion applies the module migrations of a single version
+func migrateVersion(version string, appState AppMap) error {
+	migrations := migrationMap[version]
+
+	// apply the module migrations in a deterministic order
+	modules := make([]string, 0, len(migrations))
+	for module := range migrations {
+		modules = append(modules, module)
+	}
+	sort.Strings(modules)
+
+	for _, module := range modules {
+		state, err := migrations[module](appState[module])
+		if err != nil {
+			return fmt.Errorf("failed to migrate %s to %s: %v", module, version, err)
+		}
+		if state == nil {
+			delete(appState, module)
+			continue
+		}
+		appState[module] = state
+	}
+
+	return nil
+}
+
+// defaultJSON returns a migration that sets the given state when a module is
+// missing from the genesis file
+func defaultJSON(value string) MigrationCallback {
+	return func(state json.RawMessage) (json.RawMessage, error) {
+		if state == nil || string(state) == "null" {
+			return json.RawMessage(value), nil
+		}
+		return state, nil
+	}
+}
+
+// migrateBankV02 adds the transaction history of addresses to the bank
+// genesis state, which v0.2 exports along with the bank parameters.
+func migrateBankV02(state json.RawMessage) (json.RawMessage, error) {
+	if state == nil || string(state) == "null" {
+		return state, nil
+	}
+
+	var bank map[string]json.RawMessage
+	if err := json.Unmarshal(state, &bank); err != nil {
+		return nil, err
+	}
+
+	bank["tx_history"], _ = defaultJSON("[]")(bank["tx_history"])
+	return json.Marshal(bank)
+}
+
+// migrateAuthV03 switches chains that store their state as JSON to the binary
+// encoding, which v0.3 chooses at genesis. The exported state is JSON whatever
+// the encoding, so the restarted chain stores it in binary from height zero.
+func migrateAuthV03(state json.RawMessage) (json.RawMessage, error) {
+	if state == nil || string(state) == "null" {
+		return state, nil
+	}
+
+	var auth map[string]json.RawMessage
+	if err := json.Unmarshal(state, &auth); err != nil {
+		return nil, err
+	}
+
+	var encoding codec.Encoding
+	if raw, ok := auth["encoding"]; ok {
+		if err := json.Unmarshal(raw, &encoding); err != nil {
+			return nil, err
+		}
+	}
+	if encoding == "" || encoding == codec.EncodingJSON {
+		auth["encoding"], _ = json.Marshal(codec.EncodingBinary)
+	}
+
+	return json.Marshal(auth)
+}
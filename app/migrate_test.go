@@ -0,0 +1,80 @@
+package app
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/PhenixChain/PhenixChain/codec"
+	"github.com/PhenixChain/PhenixChain/x/auth"
+)
+
+func TestMigrate(t *testing.T) {
+	appState := AppMap{
+		"accounts": json.RawMessage(`[]`),
+		"bank":     json.RawMessage(`{"send_enabled":true}`),
+	}
+
+	_, err := Migrate("v0.0", appState)
+	require.Error(t, err)
+
+	migrated, err := Migrate("v0.2", appState)
+	require.NoError(t, err)
+	require.Equal(t, `[]`, string(migrated["accounts"]))
+	require.Equal(t, `[]`, string(migrated["vesting_accounts"]))
+	require.JSONEq(t, `{"send_enabled":true,"tx_history":[]}`, string(migrated["bank"]))
+
+	// migrating again leaves the state unchanged
+	again, err := Migrate("v0.2", migrated)
+	require.NoError(t, err)
+	require.Equal(t, migrated, again)
+}
+
+func TestMigrateEncoding(t *testing.T) {
+	appState := AppMap{
+		"auth": json.RawMessage(`{"collected_fees":[]}`),
+	}
+
+	migrated, err := Migrate("v0.3", appState)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"collected_fees":[],"encoding":"binary"}`, string(migrated["auth"]))
+
+	var authState auth.GenesisState
+	require.NoError(t, MakeCodec().UnmarshalJSON(migrated["auth"], &authState))
+	require.Equal(t, codec.EncodingBinary, authState.Encoding)
+
+	// chains already storing their state in binary are left unchanged
+	again, err := Migrate("v0.3", migrated)
+	require.NoError(t, err)
+	require.Equal(t, migrated, again)
+}
+
+func TestMigrateAcrossVersions(t *testing.T) {
+	// a v0.1 genesis state has neither the bank history, the vesting accounts
+	// nor the auth encoding
+	appState := AppMap{
+		"accounts": json.RawMessage(`[]`),
+		"auth":     json.RawMessage(`{"collected_fees":[]}`),
+		"bank":     json.RawMessage(`{"send_enabled":true}`),
+	}
+
+	migrated, err := Migrate("v0.3", appState)
+	require.NoError(t, err)
+	require.Equal(t, `[]`, string(migrated["vesting_accounts"]))
+	require.JSONEq(t, `{"send_enabled":true,"tx_history":[]}`, string(migrated["bank"]))
+	require.JSONEq(t, `{"collected_fees":[],"encoding":"binary"}`, string(migrated["auth"]))
+
+	// later versions are not applied
+	appState = AppMap{"auth": json.RawMessage(`{"collected_fees":[]}`)}
+	migrated, err = Migrate("v0.2", appState)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"collected_fees":[]}`, string(migrated["auth"]))
+}
+
+func TestMigrationVersions(t *testing.T) {
+	require.Equal(t, []string{"v0.2", "v0.3"}, MigrationVersions())
+	require.True(t, versionLess("v0.9", "v0.10"))
+	require.False(t, versionLess("v0.10", "v0.9"))
+	require.True(t, versionLess("v0.3", "v1.0"))
+}
@@ -5,10 +5,12 @@ import (
 	"io"
 	"reflect"
 	"runtime/debug"
+	"strconv"
 	"strings"
 
 	"errors"
 
+	"github.com/go-kit/kit/metrics"
 	"github.com/gogo/protobuf/proto"
 
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -18,6 +20,7 @@ import (
 
 	"github.com/PhenixChain/PhenixChain/codec"
 	"github.com/PhenixChain/PhenixChain/store"
+	"github.com/PhenixChain/PhenixChain/telemetry"
 	sdk "github.com/PhenixChain/PhenixChain/types"
 	"github.com/PhenixChain/PhenixChain/version"
 )
@@ -40,6 +43,18 @@ const (
 	MainStoreKey = "main"
 )
 
+// String returns the name of the mode as reported in metrics
+func (mode runTxMode) String() string {
+	switch mode {
+	case runTxModeCheck:
+		return "check"
+	case runTxModeSimulate:
+		return "simulate"
+	default:
+		return "deliver"
+	}
+}
+
 // BaseApp reflects the ABCI application implementation.
 type BaseApp struct {
 	// initialized on creation
@@ -79,6 +94,16 @@ type BaseApp struct {
 	// transaction. This is mainly used for DoS and spam prevention.
 	minGasPrices sdk.DecCoins
 
+	// metrics of transaction and block processing
+	metrics *telemetry.Metrics
+
+	// The block height and time, in unix seconds, after which the node halts
+	// once the block is committed. Zero disables them.
+	haltHeight uint64
+	haltTime   uint64
+	// closed once the node must halt, see Halted
+	halted chan struct{}
+
 	// flag for sealing options and parameters to a BaseApp
 	sealed bool
 }
@@ -103,6 +128,8 @@ func NewBaseApp(
 		queryRouter:    NewQueryRouter(),
 		txDecoder:      txDecoder,
 		fauxMerkleMode: false,
+		metrics:        telemetry.NopMetrics(),
+		halted:         make(chan struct{}),
 	}
 	for _, option := range options {
 		option(app)
@@ -180,6 +207,16 @@ func (app *BaseApp) LoadVersion(version int64, baseKey *sdk.KVStoreKey) error {
 	return app.initFromMainStore(baseKey)
 }
 
+// RollbackToHeight deletes the state committed after the given height, which
+// must not be pruned, and loads the state of that height.
+func (app *BaseApp) RollbackToHeight(height int64) error {
+	if err := app.cms.RollbackToVersion(height); err != nil {
+		return err
+	}
+	app.setCheckState(abci.Header{})
+	return nil
+}
+
 // LastCommitID returns the last CommitID of the multistore.
 func (app *BaseApp) LastCommitID() sdk.CommitID {
 	return app.cms.LastCommitID()
@@ -572,7 +609,9 @@ func (app *BaseApp) BeginBlock(req abci.RequestBeginBlock) (res abci.ResponseBeg
 	app.deliverState.ctx = app.deliverState.ctx.WithBlockGasMeter(gasMeter)
 
 	if app.beginBlocker != nil {
+		timer := metrics.NewTimer(app.metrics.BeginBlockSeconds)
 		res = app.beginBlocker(app.deliverState.ctx, req)
+		timer.ObserveDuration()
 	}
 
 	// set the signed validators for addition to context in deliverTx
@@ -687,7 +726,7 @@ func (app *BaseApp) runMsgs(ctx sdk.Context, msgs []sdk.Msg, mode runTxMode) (re
 
 		// Result.Data must be length prefixed in order to separate each result
 		data = append(data, msgResult.Data...)
-		tags = append(tags, sdk.MakeTag(sdk.TagAction, msg.Type()))
+		tags = append(tags, msgTags(msg)...)
 		tags = append(tags, msgResult.Tags...)
 
 		// stop execution and return on first failed message
@@ -717,6 +756,19 @@ func (app *BaseApp) runMsgs(ctx sdk.Context, msgs []sdk.Msg, mode runTxMode) (re
 	return result
 }
 
+// msgTags returns the tags common to all messages, which precede the tags of
+// their module
+func msgTags(msg sdk.Msg) sdk.Tags {
+	tags := sdk.NewTags(
+		sdk.TagAction, msg.Type(),
+		sdk.TagModule, msg.Route(),
+	)
+	for _, signer := range msg.GetSigners() {
+		tags = tags.AppendTag(sdk.TagSender, signer.String())
+	}
+	return tags
+}
+
 // Returns the applications's deliverState if app is in runTxModeDeliver,
 // otherwise it returns the application's checkstate.
 func (app *BaseApp) getState(mode runTxMode) *state {
@@ -758,6 +810,11 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte, tx sdk.Tx) (result sdk
 	// meter so we initialize upfront.
 	var gasWanted uint64
 
+	if mode == runTxModeDeliver {
+		// registered first to see the result once the recovery below set it
+		defer func() { app.recordTx(tx, result) }()
+	}
+
 	ctx := app.getContextForTx(mode, txBytes)
 	ms := ctx.MultiStore()
 
@@ -842,6 +899,10 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte, tx sdk.Tx) (result sdk
 		gasWanted = result.GasWanted
 
 		if abort {
+			if mode != runTxModeSimulate {
+				app.metrics.AnteFailures.With("mode", mode.String(), "codespace", string(result.Codespace),
+					"code", strconv.Itoa(int(result.Code))).Add(1)
+			}
 			return result
 		}
 
@@ -853,8 +914,10 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte, tx sdk.Tx) (result sdk
 	}
 
 	// Create a new context based off of the existing context with a cache wrapped
-	// multi-store in case message processing fails.
+	// multi-store in case message processing fails. The metrics the messages
+	// report are held back the same way.
 	runMsgCtx, msCache := app.cacheTxContext(ctx, txBytes)
+	runMsgCtx, recordMetrics := telemetry.WithTxMetrics(runMsgCtx)
 	result = app.runMsgs(runMsgCtx, msgs, mode)
 	result.GasWanted = gasWanted
 
@@ -862,14 +925,29 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte, tx sdk.Tx) (result sdk
 		return
 	}
 
-	// only update state if all messages pass
+	// only update state and report metrics if all messages pass
 	if result.IsOK() {
 		msCache.Write()
+		recordMetrics()
 	}
 
 	return
 }
 
+// recordTx reports a delivered transaction to the metrics
+func (app *BaseApp) recordTx(tx sdk.Tx, result sdk.Result) {
+	routes := make(map[string]bool)
+	for _, msg := range tx.GetMsgs() {
+		if route := msg.Route(); !routes[route] {
+			routes[route] = true
+			app.metrics.Txs.With("route", route).Add(1)
+		}
+	}
+
+	app.metrics.TxGasUsed.Observe(float64(result.GasUsed))
+	app.metrics.TxGasWanted.Observe(float64(result.GasWanted))
+}
+
 // EndBlock implements the ABCI interface.
 func (app *BaseApp) EndBlock(req abci.RequestEndBlock) (res abci.ResponseEndBlock) {
 	if app.deliverState.ms.TracingEnabled() {
@@ -877,7 +955,9 @@ func (app *BaseApp) EndBlock(req abci.RequestEndBlock) (res abci.ResponseEndBloc
 	}
 
 	if app.endBlocker != nil {
+		timer := metrics.NewTimer(app.metrics.EndBlockSeconds)
 		res = app.endBlocker(app.deliverState.ctx, req)
+		timer.ObserveDuration()
 	}
 
 	return
@@ -885,6 +965,8 @@ func (app *BaseApp) EndBlock(req abci.RequestEndBlock) (res abci.ResponseEndBloc
 
 // Commit implements the ABCI interface.
 func (app *BaseApp) Commit() (res abci.ResponseCommit) {
+	defer metrics.NewTimer(app.metrics.CommitSeconds).ObserveDuration()
+
 	header := app.deliverState.ctx.BlockHeader()
 
 	// write the Deliver state and commit the MultiStore
@@ -901,11 +983,48 @@ func (app *BaseApp) Commit() (res abci.ResponseCommit) {
 	// empty/reset the deliver state
 	app.deliverState = nil
 
+	// The block is committed and the state flushed to the database, so the
+	// node can stop here and be exported at this height.
+	if reason := app.haltReason(header); reason != "" {
+		app.halt(reason)
+	}
+
 	return abci.ResponseCommit{
 		Data: commitID.Hash,
 	}
 }
 
+// haltReason returns why the node must halt after committing the block of the
+// given header, or an empty string if it must not.
+func (app *BaseApp) haltReason(header abci.Header) string {
+	switch {
+	case app.haltHeight > 0 && uint64(header.Height) >= app.haltHeight:
+		return fmt.Sprintf("reached halt height %d", app.haltHeight)
+	case app.haltTime > 0 && uint64(header.Time.Unix()) >= app.haltTime:
+		return fmt.Sprintf("reached halt time %d", app.haltTime)
+	default:
+		return ""
+	}
+}
+
+// halt signals the server that the node must stop, which it does once Commit
+// returned to Tendermint. Stopping Tendermint from within Commit would block.
+func (app *BaseApp) halt(reason string) {
+	select {
+	case <-app.halted:
+		// already halting, e.g. when another block is committed meanwhile
+	default:
+		app.logger.Info("Halting node per configuration", "reason", reason)
+		close(app.halted)
+	}
+}
+
+// Halted returns a channel closed once the block at the halt height or time is
+// committed. The server then stops the node, closes its databases and exits.
+func (app *BaseApp) Halted() <-chan struct{} {
+	return app.halted
+}
+
 // ----------------------------------------------------------------------------
 // State
 
@@ -5,8 +5,11 @@ import (
 	"encoding/binary"
 	"fmt"
 	"os"
+	"strings"
 	"testing"
 
+	"github.com/go-kit/kit/metrics"
+
 	store "github.com/PhenixChain/PhenixChain/store/types"
 
 	"github.com/stretchr/testify/assert"
@@ -17,6 +20,7 @@ import (
 	"github.com/tendermint/tendermint/libs/log"
 
 	"github.com/PhenixChain/PhenixChain/codec"
+	"github.com/PhenixChain/PhenixChain/telemetry"
 	sdk "github.com/PhenixChain/PhenixChain/types"
 )
 
@@ -662,6 +666,25 @@ func TestMultiMsgDeliverTx(t *testing.T) {
 	require.Equal(t, int64(4), msgCounter)
 	msgCounter2 := getIntFromStore(store, deliverKey2)
 	require.Equal(t, int64(2), msgCounter2)
+
+	// every message is tagged with its action and module
+	require.Equal(t, sdk.NewTags(
+		sdk.TagAction, "counter1", sdk.TagModule, routeMsgCounter,
+		sdk.TagAction, "counter2", sdk.TagModule, routeMsgCounter2,
+		sdk.TagAction, "counter2", sdk.TagModule, routeMsgCounter2,
+	).ToKVPairs(), res.Tags)
+}
+
+func TestMsgTags(t *testing.T) {
+	addr1 := sdk.AccAddress([]byte("addr1"))
+	addr2 := sdk.AccAddress([]byte("addr2"))
+
+	require.Equal(t, sdk.NewTags(
+		sdk.TagAction, "Test message",
+		sdk.TagModule, "TestMsg",
+		sdk.TagSender, addr1.String(),
+		sdk.TagSender, addr2.String(),
+	), msgTags(sdk.NewTestMsg(addr1, addr2)))
 }
 
 // Interleave calls to Check and Deliver and ensure
@@ -1253,3 +1276,133 @@ func TestGetMaximumBlockGas(t *testing.T) {
 	app.setConsensusParams(&abci.ConsensusParams{Block: &abci.BlockParams{MaxGas: -5000000}})
 	require.Panics(t, func() { app.getMaximumBlockGas() })
 }
+
+// testCounter counts the additions to a counter by label values
+type testCounter struct {
+	counts map[string]float64
+	lvs    []string
+}
+
+func newTestCounter() *testCounter {
+	return &testCounter{counts: make(map[string]float64)}
+}
+
+func (c *testCounter) With(lvs ...string) metrics.Counter {
+	return &testCounter{c.counts, append(append([]string{}, c.lvs...), lvs...)}
+}
+
+func (c *testCounter) Add(delta float64) {
+	c.counts[strings.Join(c.lvs, ",")] += delta
+}
+
+// testHistogram records the observations of a histogram by label values
+type testHistogram struct {
+	observations map[string][]float64
+	lvs          []string
+}
+
+func newTestHistogram() *testHistogram {
+	return &testHistogram{observations: make(map[string][]float64)}
+}
+
+func (h *testHistogram) With(lvs ...string) metrics.Histogram {
+	return &testHistogram{h.observations, append(append([]string{}, h.lvs...), lvs...)}
+}
+
+func (h *testHistogram) Observe(value float64) {
+	key := strings.Join(h.lvs, ",")
+	h.observations[key] = append(h.observations[key], value)
+}
+
+func TestMetrics(t *testing.T) {
+	anteOpt := func(bapp *BaseApp) { bapp.SetAnteHandler(anteHandlerTxTest(t, capKey1, []byte("ante-key"))) }
+	routerOpt := func(bapp *BaseApp) {
+		bapp.Router().AddRoute(routeMsgCounter, handlerMsgCounter(t, capKey1, []byte("deliver-key")))
+	}
+
+	txs, gasUsed, anteFailures := newTestCounter(), newTestHistogram(), newTestCounter()
+	commits, storeCommits := newTestHistogram(), newTestHistogram()
+	m := telemetry.NopMetrics()
+	m.Txs, m.TxGasUsed, m.AnteFailures = txs, gasUsed, anteFailures
+	m.CommitSeconds, m.StoreCommitSeconds = commits, storeCommits
+
+	app := setupBaseApp(t, anteOpt, routerOpt, SetMetrics(m))
+	require.Equal(t, m, app.Metrics())
+	app.InitChain(abci.RequestInitChain{})
+
+	cdc := codec.New()
+	registerTestCodec(cdc)
+
+	failing := newTxCounter(0, 0)
+	failing.setFailOnAnte(true)
+	failingBytes, err := cdc.MarshalJSON(failing)
+	require.NoError(t, err)
+
+	// checked transactions are only counted when the ante handler fails
+	require.False(t, app.CheckTx(failingBytes).IsOK())
+	require.Empty(t, txs.counts)
+	require.Equal(t, float64(1), anteFailures.counts["mode,check,codespace,sdk,code,1"])
+
+	app.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: 1}})
+	require.False(t, app.DeliverTx(failingBytes).IsOK())
+	require.Equal(t, float64(1), anteFailures.counts["mode,deliver,codespace,sdk,code,1"])
+
+	txBytes, err := cdc.MarshalJSON(newTxCounter(0, 0, 1))
+	require.NoError(t, err)
+	require.True(t, app.DeliverTx(txBytes).IsOK())
+	app.EndBlock(abci.RequestEndBlock{})
+	app.Commit()
+
+	// failed transactions are counted as well, and every transaction once per route
+	require.Equal(t, float64(2), txs.counts["route,"+routeMsgCounter])
+	require.Len(t, gasUsed.observations[""], 2)
+	require.Len(t, commits.observations[""], 1)
+	require.Len(t, storeCommits.observations["store,key1"], 1)
+	require.Len(t, storeCommits.observations["store,key2"], 1)
+}
+
+func TestTxMetricsRecordedOnSuccess(t *testing.T) {
+	recorded := newTestCounter()
+	anteOpt := func(bapp *BaseApp) { bapp.SetAnteHandler(anteHandlerTxTest(t, capKey1, []byte("ante-key"))) }
+	routerOpt := func(bapp *BaseApp) {
+		handler := handlerMsgCounter(t, capKey1, []byte("deliver-key"))
+		bapp.Router().AddRoute(routeMsgCounter, func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+			telemetry.RecordOnSuccess(ctx, func() { recorded.Add(1) })
+			return handler(ctx, msg)
+		})
+	}
+
+	app := setupBaseApp(t, anteOpt, routerOpt)
+	app.InitChain(abci.RequestInitChain{})
+
+	cdc := codec.New()
+	registerTestCodec(cdc)
+
+	// the first message reports metrics before the second one fails the tx
+	failing := newTxCounter(0, 0)
+	failing.Msgs = append(failing.Msgs, msgCounter{1, true})
+	failingBytes, err := cdc.MarshalJSON(failing)
+	require.NoError(t, err)
+
+	app.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: 1}})
+	require.False(t, app.DeliverTx(failingBytes).IsOK())
+	require.Empty(t, recorded.counts)
+
+	tx := newTxCounter(1, 0)
+	txBytes, err := cdc.MarshalJSON(tx)
+	require.NoError(t, err)
+	require.True(t, app.DeliverTx(txBytes).IsOK())
+	require.Equal(t, float64(1), recorded.counts[""])
+
+	// simulations never report metrics
+	simTx := newTxCounter(0, 0)
+	simBytes, err := cdc.MarshalJSON(simTx)
+	require.NoError(t, err)
+	require.True(t, app.Simulate(simBytes, *simTx).IsOK())
+	require.Equal(t, float64(1), recorded.counts[""])
+
+	// metrics reported outside of transactions, e.g. by the EndBlocker, are
+	// reported right away
+	telemetry.RecordOnSuccess(app.deliverState.ctx, func() { recorded.Add(1) })
+	require.Equal(t, float64(2), recorded.counts[""])
+}
@@ -7,6 +7,7 @@ import (
 	dbm "github.com/tendermint/tendermint/libs/db"
 
 	"github.com/PhenixChain/PhenixChain/store"
+	"github.com/PhenixChain/PhenixChain/telemetry"
 	sdk "github.com/PhenixChain/PhenixChain/types"
 )
 
@@ -28,6 +29,55 @@ func SetMinGasPrices(gasPricesStr string) func(*BaseApp) {
 	return func(bap *BaseApp) { bap.setMinGasPrices(gasPrices) }
 }
 
+// SetMetrics returns an option that reports the metrics of the app to the given
+// metrics, including the commit durations of the stores when the multistore
+// measures them.
+func SetMetrics(metrics *telemetry.Metrics) func(*BaseApp) {
+	return func(bap *BaseApp) { bap.setMetrics(metrics) }
+}
+
+// SetHaltHeight returns an option that halts the node once the block at the
+// given height is committed. Zero disables it.
+func SetHaltHeight(height uint64) func(*BaseApp) {
+	return func(bap *BaseApp) { bap.setHaltHeight(height) }
+}
+
+// SetHaltTime returns an option that halts the node once the first block with
+// a time, in unix seconds, at or after the given one is committed. Zero
+// disables it.
+func SetHaltTime(haltTime uint64) func(*BaseApp) {
+	return func(bap *BaseApp) { bap.setHaltTime(haltTime) }
+}
+
+func (app *BaseApp) setMetrics(metrics *telemetry.Metrics) {
+	if app.sealed {
+		panic("SetMetrics() on sealed BaseApp")
+	}
+	app.metrics = metrics
+	if cms, ok := app.cms.(interface{ SetMetrics(*telemetry.Metrics) }); ok {
+		cms.SetMetrics(metrics)
+	}
+}
+
+func (app *BaseApp) setHaltHeight(height uint64) {
+	if app.sealed {
+		panic("SetHaltHeight() on sealed BaseApp")
+	}
+	app.haltHeight = height
+}
+
+func (app *BaseApp) setHaltTime(haltTime uint64) {
+	if app.sealed {
+		panic("SetHaltTime() on sealed BaseApp")
+	}
+	app.haltTime = haltTime
+}
+
+// Metrics returns the metrics of the app, which modules may report to as well
+func (app *BaseApp) Metrics() *telemetry.Metrics {
+	return app.metrics
+}
+
 func (app *BaseApp) SetName(name string) {
 	if app.sealed {
 		panic("SetName() on sealed BaseApp")
@@ -155,15 +155,12 @@ func (ctx CLIContext) WithCodec(cdc *codec.Codec) CLIContext {
 	return ctx
 }
 
-// GetAccountDecoder gets the account decoder for auth.DefaultAccount.
+// GetAccountDecoder gets the account decoder for auth.DefaultAccount. Accounts
+// are stored as JSON unless the chain was started with the binary encoding, so
+// the binary encoding is tried when the bytes are not JSON.
 func GetAccountDecoder(cdc *codec.Codec) auth.AccountDecoder {
 	return func(accBytes []byte) (acct auth.Account, err error) {
-		// nikolas
-		err = cdc.UnmarshalJSON(accBytes, &acct)
-		if err != nil {
-			panic(err)
-		}
-
+		err = codec.UnmarshalAnyEncoding(cdc, accBytes, &acct)
 		return acct, err
 	}
 }
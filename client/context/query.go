@@ -3,6 +3,7 @@ package context
 import (
 	"fmt"
 
+	"github.com/PhenixChain/PhenixChain/codec"
 	sdk "github.com/PhenixChain/PhenixChain/types"
 	"github.com/PhenixChain/PhenixChain/x/auth"
 
@@ -79,6 +80,22 @@ func (ctx CLIContext) GetAccount(address []byte) (auth.Account, error) {
 	return account, nil
 }
 
+// GetEncoding queries the encoding transactions and state values are
+// serialized in by the chain of the connected node.
+func (ctx CLIContext) GetEncoding() (codec.Encoding, error) {
+	res, err := ctx.QueryWithData(fmt.Sprintf("custom/%s/%s", auth.QuerierRoute, auth.QueryEncoding), nil)
+	if err != nil {
+		return "", err
+	}
+
+	var encoding codec.Encoding
+	if err := ctx.Codec.UnmarshalJSON(res, &encoding); err != nil {
+		return "", err
+	}
+
+	return encoding, encoding.Validate()
+}
+
 // GetFromAddress returns the from address from the context's name.
 func (ctx CLIContext) GetFromAddress() sdk.AccAddress {
 	return ctx.FromAddress
@@ -28,6 +28,12 @@ const (
 	// immediately.
 	BroadcastAsync = "async"
 
+	// SignRequestJSON makes --generate-only print a JSON sign request.
+	SignRequestJSON = "json"
+	// SignRequestCompact makes --generate-only print a compact, QR code
+	// friendly sign request.
+	SignRequestCompact = "compact"
+
 	FlagUseLedger          = "ledger"
 	FlagChainID            = "chain-id"
 	FlagNode               = "node"
@@ -44,6 +50,7 @@ const (
 	FlagPrintResponse      = "print-response"
 	FlagDryRun             = "dry-run"
 	FlagGenerateOnly       = "generate-only"
+	FlagSignRequest        = "sign-request"
 	FlagIndentResponse     = "indent"
 	FlagListenAddr         = "laddr"
 	FlagCORS               = "cors"
@@ -93,6 +100,11 @@ func PostCommands(cmds ...*cobra.Command) []*cobra.Command {
 		c.Flags().Bool(FlagDryRun, false, "ignore the --gas flag and perform a simulation of a transaction, but don't broadcast it")
 		c.Flags().Bool(FlagGenerateOnly, false, "Build an unsigned transaction and write it to STDOUT (when enabled, the local Keybase is not accessible)")
 		c.Flags().BoolP(FlagSkipConfirmation, "y", false, "Skip tx broadcasting prompt confirmation")
+		c.Flags().String(FlagSignRequest, "", fmt.Sprintf(
+			"Used with --%s, wrap the unsigned transaction in a sign request embedding the chain ID and signer sequence (%s|%s)",
+			FlagGenerateOnly, SignRequestJSON, SignRequestCompact,
+		))
+		c.Flags().Lookup(FlagSignRequest).NoOptDefVal = SignRequestJSON
 
 		// --gas can accept integers and "simulate"
 		c.Flags().Var(&GasFlagVar, "gas", fmt.Sprintf(
@@ -0,0 +1,93 @@
+package rpc
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/PhenixChain/PhenixChain/client"
+	"github.com/PhenixChain/PhenixChain/client/context"
+	"github.com/PhenixChain/PhenixChain/codec"
+	sdk "github.com/PhenixChain/PhenixChain/types"
+)
+
+const flagPrefix = "prefix"
+
+// StoreCommand returns a command querying the raw value of a key of a store, or
+// of all keys having a prefix, decoding values with the decoder of the store
+// in decoders.
+func StoreCommand(cdc *codec.Codec, decoders map[string]sdk.StoreDecoder) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "store <store> <key>",
+		Short: "Query the raw key/value pairs of a store",
+		Long: `store queries the value of a hex key of a store, or with --prefix the values of
+all keys having a hex prefix, and prints the decoded pairs. Values are decoded
+with the codec of the module owning the store; the raw value is printed instead,
+with the reason, when they cannot be decoded.
+
+Example:
+	phenixcli query store acc 01 --prefix
+`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			storeName := args[0]
+			decoder := decoders[storeName]
+
+			key, err := hex.DecodeString(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid hex key %q: %v", args[1], err)
+			}
+
+			if viper.GetBool(flagPrefix) {
+				kvs, err := cliCtx.QuerySubspace(key, storeName)
+				if err != nil {
+					return err
+				}
+
+				pairs := make([]sdk.DecodedKVPair, len(kvs))
+				for i, kv := range kvs {
+					pairs[i] = sdk.DecodeKVPair(cdc, decoder, kv.Key, kv.Value)
+				}
+				return printDecodedKVPairs(cliCtx, pairs)
+			}
+
+			value, err := cliCtx.QueryStore(key, storeName)
+			if err != nil {
+				return err
+			}
+			if len(value) == 0 {
+				return fmt.Errorf("no value for key %X in store %s", key, storeName)
+			}
+			return printDecodedKVPairs(cliCtx, sdk.DecodeKVPair(cdc, decoder, key, value))
+		},
+	}
+	cmd.Flags().Bool(flagPrefix, false, "Query all keys having the given key as prefix")
+	cmd.Flags().Int64(client.FlagHeight, 0, "Height to query, the latest one if 0")
+	viper.BindPFlag(client.FlagHeight, cmd.Flags().Lookup(client.FlagHeight))
+	return client.GetCommands(cmd)[0]
+}
+
+// printDecodedKVPairs prints decoded pairs as JSON. Decoded values are raw JSON,
+// which the amino JSON of PrintOutput would print as base64, so they are
+// marshalled with encoding/json.
+func printDecodedKVPairs(cliCtx context.CLIContext, o interface{}) error {
+	var (
+		out []byte
+		err error
+	)
+	if cliCtx.Indent {
+		out, err = json.MarshalIndent(o, "", "  ")
+	} else {
+		out, err = json.Marshal(o)
+	}
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(string(out))
+	return nil
+}
@@ -43,8 +43,7 @@ func BroadcastTxRequest(cliCtx context.CLIContext, cdc *codec.Codec) http.Handle
 			return
 		}
 
-		// nikolas
-		txBytes, err := cdc.MarshalJSON(req.Tx)
+		txBytes, err := utils.GetTxEncoder(cdc)(req.Tx)
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
 			return
@@ -82,8 +81,7 @@ $ gaiacli tx broadcast ./mytxn.json
 				return
 			}
 
-			// nikolas
-			txBytes, err := cliCtx.Codec.MarshalJSON(stdTx)
+			txBytes, err := utils.GetTxEncoder(cliCtx.Codec)(stdTx)
 			if err != nil {
 				return
 			}
@@ -47,9 +47,8 @@ func EncodeTxRequestHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.
 			return
 		}
 
-		// nikolas
 		// Re-encode it to the wire protocol
-		txBytes, err := cliCtx.Codec.MarshalJSON(req.Tx)
+		txBytes, err := utils.GetTxEncoder(cliCtx.Codec)(req.Tx)
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
 			return
@@ -88,8 +87,7 @@ If you supply a dash (-) argument in place of an input filename, the command rea
 				return
 			}
 
-			// nikolas
-			txBytes, err := cliCtx.Codec.MarshalJSON(stdTx)
+			txBytes, err := utils.GetTxEncoder(cliCtx.Codec)(stdTx)
 			if err != nil {
 				return err
 			}
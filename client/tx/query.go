@@ -3,11 +3,11 @@ package tx
 import (
 	"fmt"
 	"net/http"
+	"sort"
 	"strings"
 
 	"github.com/gorilla/mux"
 	"github.com/spf13/cobra"
-	"github.com/tendermint/tendermint/types"
 
 	"github.com/spf13/viper"
 
@@ -35,9 +35,13 @@ func SearchTxCmd(cdc *codec.Codec) *cobra.Command {
 		Short: "Search for paginated transactions that match a set of tags",
 		Long: strings.TrimSpace(`
 Search for transactions that match the exact given tags where results are paginated.
+Every message is tagged with its action, module and senders, which the filters
+message.action, message.module and message.sender match, along with module
+specific tags such as the recipients of transfers matched by transfer.recipient.
+The filters tx.minheight and tx.maxheight restrict the heights of transactions.
 
 Example:
-$ gaiacli query txs --tags '<tag1>:<value1>&<tag2>:<value2>' --page 1 --limit 30
+$ phenixcli query txs --tags 'message.action:send&transfer.recipient:<address>&tx.minheight:1000' --page 1 --limit 30
 `),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			tagsStr := viper.GetString(flagTags)
@@ -59,12 +63,11 @@ $ gaiacli query txs --tags '<tag1>:<value1>&<tag2>:<value2>' --page 1 --limit 30
 				}
 
 				keyValue := strings.Split(tag, ":")
-				if keyValue[0] == types.TxHeightKey {
-					tag = fmt.Sprintf("%s=%s", keyValue[0], keyValue[1])
-				} else {
-					tag = fmt.Sprintf("%s='%s'", keyValue[0], keyValue[1])
+				tmTag, err := FilterCondition(keyValue[0], keyValue[1])
+				if err != nil {
+					return err
 				}
-				tmTags = append(tmTags, tag)
+				tmTags = append(tmTags, tmTag)
 			}
 
 			page := viper.GetInt(flagPage)
@@ -76,19 +79,7 @@ $ gaiacli query txs --tags '<tag1>:<value1>&<tag2>:<value2>' --page 1 --limit 30
 				return err
 			}
 
-			var output []byte
-			if cliCtx.Indent {
-				output, err = cdc.MarshalJSONIndent(txs, "", "  ")
-			} else {
-				output, err = cdc.MarshalJSON(txs)
-			}
-
-			if err != nil {
-				return err
-			}
-
-			fmt.Println(string(output))
-			return nil
+			return cliCtx.PrintOutput(txs)
 		},
 	}
 
@@ -96,6 +87,7 @@ $ gaiacli query txs --tags '<tag1>:<value1>&<tag2>:<value2>' --page 1 --limit 30
 	viper.BindPFlag(client.FlagNode, cmd.Flags().Lookup(client.FlagNode))
 	cmd.Flags().Bool(client.FlagTrustNode, false, "Trust connected full node (don't verify proofs for responses)")
 	viper.BindPFlag(client.FlagTrustNode, cmd.Flags().Lookup(client.FlagTrustNode))
+	cmd.Flags().Bool(client.FlagIndentResponse, false, "Add indent to JSON response")
 
 	cmd.Flags().String(flagTags, "", "tag:value list of tags that must match")
 	cmd.Flags().Uint32(flagPage, rest.DefaultPage, "Query a specific page of paginated results")
@@ -140,15 +132,10 @@ func QueryTxCmd(cdc *codec.Codec) *cobra.Command {
 // ----------------------------------------------------------------------------
 
 // QueryTxsByTagsRequestHandlerFn implements a REST handler that searches for
-// transactions by tags.
+// transactions by tags and structured filters, e.g.
+// /txs?message.action=send&transfer.recipient=<address>&tx.minheight=1000&page=1&limit=30
 func QueryTxsByTagsRequestHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		var (
-			tags        []string
-			txs         []sdk.TxResponse
-			page, limit int
-		)
-
 		err := r.ParseForm()
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest,
@@ -156,19 +143,36 @@ func QueryTxsByTagsRequestHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec)
 			return
 		}
 
-		if len(r.Form) == 0 {
-			rest.PostProcessResponse(w, cdc, txs, cliCtx.Indent)
+		page, limit, err := rest.ParseHTTPPagination(r)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
 		}
 
-		tags, page, limit, err = rest.ParseHTTPArgs(r)
+		var tags []string
+		for key, values := range r.Form {
+			if key == "page" || key == "limit" {
+				continue
+			}
+			for _, value := range values {
+				tag, err := FilterCondition(key, value)
+				if err != nil {
+					rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+					return
+				}
+				tags = append(tags, tag)
+			}
+		}
 
-		if err != nil {
-			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		if len(tags) == 0 {
+			rest.PostProcessResponse(w, cdc, sdk.NewSearchTxsResult(0, page, limit, nil), cliCtx.Indent)
 			return
 		}
 
-		txs, err = SearchTxs(cliCtx, cdc, tags, page, limit)
+		// map iteration is random, keep queries deterministic
+		sort.Strings(tags)
+
+		txs, err := SearchTxs(cliCtx, cdc, tags, page, limit)
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
 			return
@@ -3,6 +3,8 @@ package tx
 import (
 	"encoding/hex"
 	"errors"
+	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
@@ -11,22 +13,73 @@ import (
 	sdk "github.com/PhenixChain/PhenixChain/types"
 	"github.com/PhenixChain/PhenixChain/x/auth"
 	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	"github.com/tendermint/tendermint/types"
 )
 
+// Structured search filters, which map to the tags of messages or to the
+// height of transactions. Any other filter matches the tag of the same name.
+const (
+	FilterMessageAction     = "message.action"
+	FilterMessageModule     = "message.module"
+	FilterMessageSender     = "message.sender"
+	FilterTransferRecipient = "transfer.recipient"
+	FilterMinHeight         = "tx.minheight"
+	FilterMaxHeight         = "tx.maxheight"
+)
+
+var filterTags = map[string]string{
+	FilterMessageAction:     sdk.TagAction,
+	FilterMessageModule:     sdk.TagModule,
+	FilterMessageSender:     sdk.TagSender,
+	FilterTransferRecipient: sdk.TagRecipient,
+}
+
+// FilterCondition returns the condition of a Tendermint tx search query matching
+// a search filter or a tag.
+func FilterCondition(key, value string) (string, error) {
+	if key == "" {
+		return "", errors.New("empty search filter")
+	}
+	if strings.ContainsAny(value, "'") {
+		return "", fmt.Errorf("invalid value %q of search filter %s", value, key)
+	}
+
+	switch key {
+	case types.TxHeightKey, FilterMinHeight, FilterMaxHeight:
+		height, err := strconv.ParseInt(value, 10, 64)
+		if err != nil || height < 0 {
+			return "", fmt.Errorf("invalid height %q of search filter %s", value, key)
+		}
+
+		op := "="
+		if key == FilterMinHeight {
+			op = ">="
+		} else if key == FilterMaxHeight {
+			op = "<="
+		}
+		return fmt.Sprintf("%s%s%d", types.TxHeightKey, op, height), nil
+	}
+
+	if tag, ok := filterTags[key]; ok {
+		key = tag
+	}
+	return fmt.Sprintf("%s='%s'", key, value), nil
+}
+
 // SearchTxs performs a search for transactions for a given set of tags via
-// Tendermint RPC. It returns a slice of Info object containing txs and metadata.
-// An error is returned if the query fails.
-func SearchTxs(cliCtx context.CLIContext, cdc *codec.Codec, tags []string, page, limit int) ([]sdk.TxResponse, error) {
+// Tendermint RPC. It returns a page of the matching txs along with their total
+// count. An error is returned if the query fails.
+func SearchTxs(cliCtx context.CLIContext, cdc *codec.Codec, tags []string, page, limit int) (sdk.SearchTxsResult, error) {
 	if len(tags) == 0 {
-		return nil, errors.New("must declare at least one tag to search")
+		return sdk.SearchTxsResult{}, errors.New("must declare at least one tag to search")
 	}
 
 	if page <= 0 {
-		return nil, errors.New("page must greater than 0")
+		return sdk.SearchTxsResult{}, errors.New("page must greater than 0")
 	}
 
 	if limit <= 0 {
-		return nil, errors.New("limit must greater than 0")
+		return sdk.SearchTxsResult{}, errors.New("limit must greater than 0")
 	}
 
 	// XXX: implement ANY
@@ -34,36 +87,36 @@ func SearchTxs(cliCtx context.CLIContext, cdc *codec.Codec, tags []string, page,
 
 	node, err := cliCtx.GetNode()
 	if err != nil {
-		return nil, err
+		return sdk.SearchTxsResult{}, err
 	}
 
 	prove := !cliCtx.TrustNode
 
 	resTxs, err := node.TxSearch(query, prove, page, limit)
 	if err != nil {
-		return nil, err
+		return sdk.SearchTxsResult{}, err
 	}
 
 	if prove {
 		for _, tx := range resTxs.Txs {
 			err := ValidateTxResult(cliCtx, tx)
 			if err != nil {
-				return nil, err
+				return sdk.SearchTxsResult{}, err
 			}
 		}
 	}
 
 	resBlocks, err := getBlocksForTxResults(cliCtx, resTxs.Txs)
 	if err != nil {
-		return nil, err
+		return sdk.SearchTxsResult{}, err
 	}
 
 	txs, err := formatTxResults(cdc, resTxs.Txs, resBlocks)
 	if err != nil {
-		return nil, err
+		return sdk.SearchTxsResult{}, err
 	}
 
-	return txs, nil
+	return sdk.NewSearchTxsResult(resTxs.TotalCount, page, limit, txs), nil
 }
 
 // formatTxResults parses the indexed txs into a slice of TxResponse objects.
@@ -129,10 +182,11 @@ func formatTxResult(cdc *codec.Codec, resTx *ctypes.ResultTx, resBlock *ctypes.R
 func parseTx(cdc *codec.Codec, txBytes []byte) (sdk.Tx, error) {
 	var tx auth.StdTx
 
-	// nikolas
-	err := cdc.UnmarshalJSON(txBytes, &tx)
-	if err != nil {
-		return nil, err
+	// transactions are JSON unless the chain was started with the binary encoding
+	if err := cdc.UnmarshalJSON(txBytes, &tx); err != nil {
+		if err := cdc.UnmarshalBinaryLengthPrefixed(txBytes, &tx); err != nil {
+			return nil, err
+		}
 	}
 
 	return tx, nil
@@ -0,0 +1,38 @@
+package tx
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFilterCondition(t *testing.T) {
+	tests := []struct {
+		key, value string
+		expected   string
+		expectErr  bool
+	}{
+		{FilterMessageAction, "send", "action='send'", false},
+		{FilterMessageModule, "bank", "module='bank'", false},
+		{FilterMessageSender, "adr1", "sender='adr1'", false},
+		{FilterTransferRecipient, "adr2", "recipient='adr2'", false},
+		{FilterMinHeight, "10", "tx.height>=10", false},
+		{FilterMaxHeight, "20", "tx.height<=20", false},
+		{"tx.height", "15", "tx.height=15", false},
+		{"denom", "mytoken", "denom='mytoken'", false},
+		{FilterMinHeight, "ten", "", true},
+		{FilterMaxHeight, "-1", "", true},
+		{"denom", "x' OR denom='y", "", true},
+		{"", "value", "", true},
+	}
+
+	for _, tc := range tests {
+		cond, err := FilterCondition(tc.key, tc.value)
+		if tc.expectErr {
+			require.Error(t, err, "%s=%s", tc.key, tc.value)
+			continue
+		}
+		require.NoError(t, err, "%s=%s", tc.key, tc.value)
+		require.Equal(t, tc.expected, cond)
+	}
+}
@@ -2,6 +2,7 @@ package utils
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"os"
@@ -141,26 +142,47 @@ func CalculateGas(queryFunc func(string, common.HexBytes) ([]byte, error),
 }
 
 // PrintUnsignedStdTx builds an unsigned StdTx and prints it to os.Stdout.
-// Don't perform online validation or lookups if offline is true.
+// Don't perform online validation or lookups if offline is true. If a sign
+// request format is set through --sign-request, the StdTx is wrapped in a
+// StdSignRequest carrying the chain ID and the signer sequence.
 func PrintUnsignedStdTx(
 	txBldr authtxb.TxBuilder, cliCtx context.CLIContext, msgs []sdk.Msg, offline bool,
 ) (err error) {
 
-	var stdTx auth.StdTx
-
-	if offline {
-		stdTx, err = buildUnsignedStdTxOffline(txBldr, cliCtx, msgs)
-	} else {
-		stdTx, err = buildUnsignedStdTx(txBldr, cliCtx, msgs)
+	if !offline {
+		txBldr, err = PrepareTxBuilder(txBldr, cliCtx)
+		if err != nil {
+			return
+		}
 	}
 
+	stdTx, err := buildUnsignedStdTxOffline(txBldr, cliCtx, msgs)
 	if err != nil {
 		return
 	}
 
-	json, err := cliCtx.Codec.MarshalJSON(stdTx)
+	var out []byte
+	switch viper.GetString(client.FlagSignRequest) {
+	case "":
+		out, err = cliCtx.Codec.MarshalJSON(stdTx)
+
+	case client.SignRequestJSON:
+		signReq := authtxb.NewStdSignRequest(txBldr.ChainID(), cliCtx.GetFromAddress(), txBldr.Sequence(), stdTx)
+		out, err = cliCtx.Codec.MarshalJSON(signReq)
+
+	case client.SignRequestCompact:
+		signReq := authtxb.NewStdSignRequest(txBldr.ChainID(), cliCtx.GetFromAddress(), txBldr.Sequence(), stdTx)
+
+		var compact string
+		compact, err = signReq.MarshalCompact(cliCtx.Codec)
+		out = []byte(compact)
+
+	default:
+		err = fmt.Errorf("invalid sign request format: %s", viper.GetString(client.FlagSignRequest))
+	}
+
 	if err == nil {
-		fmt.Fprintf(cliCtx.Output, "%s\n", json)
+		fmt.Fprintf(cliCtx.Output, "%s\n", out)
 	}
 
 	return
@@ -232,12 +254,7 @@ func SignStdTxWithSignerAddress(txBldr authtxb.TxBuilder, cliCtx context.CLICont
 
 // Read and decode a StdTx from the given filename.  Can pass "-" to read from stdin.
 func ReadStdTxFromFile(cdc *amino.Codec, filename string) (stdTx auth.StdTx, err error) {
-	var bytes []byte
-	if filename == "-" {
-		bytes, err = ioutil.ReadAll(os.Stdin)
-	} else {
-		bytes, err = ioutil.ReadFile(filename)
-	}
+	bytes, err := readFileOrStdin(filename)
 	if err != nil {
 		return
 	}
@@ -247,6 +264,59 @@ func ReadStdTxFromFile(cdc *amino.Codec, filename string) (stdTx auth.StdTx, err
 	return
 }
 
+// ReadStdTxOrSignRequestFromFile reads either a plain StdTx or a sign request,
+// in JSON or compact encoding, from the given filename. The returned sign
+// request is nil if the file holds a plain StdTx. Can pass "-" to read from
+// stdin.
+func ReadStdTxOrSignRequestFromFile(cdc *amino.Codec, filename string) (
+	stdTx auth.StdTx, signReq *authtxb.StdSignRequest, err error) {
+
+	bytes, err := readFileOrStdin(filename)
+	if err != nil {
+		return
+	}
+
+	var req authtxb.StdSignRequest
+	switch {
+	case authtxb.IsCompactStdSignRequest(bytes):
+		req, err = authtxb.UnmarshalCompactStdSignRequest(cdc, string(bytes))
+
+	case isJSONSignRequest(bytes):
+		err = cdc.UnmarshalJSON(bytes, &req)
+
+	default:
+		err = cdc.UnmarshalJSON(bytes, &stdTx)
+		return
+	}
+	if err != nil {
+		return
+	}
+
+	if err = req.ValidateBasic(); err != nil {
+		return
+	}
+
+	return req.Tx, &req, nil
+}
+
+// isJSONSignRequest tells a JSON sign request apart from an amino JSON
+// encoded StdTx, which is wrapped in a type/value object.
+func isJSONSignRequest(bz []byte) bool {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(bz, &fields); err != nil {
+		return false
+	}
+	_, ok := fields["chain_id"]
+	return ok
+}
+
+func readFileOrStdin(filename string) ([]byte, error) {
+	if filename == "-" {
+		return ioutil.ReadAll(os.Stdin)
+	}
+	return ioutil.ReadFile(filename)
+}
+
 func populateAccountFromState(
 	txBldr authtxb.TxBuilder, cliCtx context.CLIContext, addr sdk.AccAddress,
 ) (authtxb.TxBuilder, error) {
@@ -260,15 +330,32 @@ func populateAccountFromState(
 }
 
 // GetTxEncoder return tx encoder from global sdk configuration if ones is defined.
-// Otherwise returns encoder with default logic.
+// Otherwise returns an encoder serializing transactions in the encoding of the
+// chain of the connected node.
 func GetTxEncoder(cdc *codec.Codec) (encoder sdk.TxEncoder) {
 	encoder = sdk.GetConfig().GetTxEncoder()
 	if encoder == nil {
-		encoder = auth.DefaultTxEncoder(cdc)
+		encoder = NewNodeTxEncoder(cdc)
 	}
 	return
 }
 
+// NewNodeTxEncoder returns a tx encoder that queries the encoding of the chain
+// of the connected node the first time a transaction is encoded.
+func NewNodeTxEncoder(cdc *codec.Codec) sdk.TxEncoder {
+	var encoder *codec.Encoder
+	return func(tx sdk.Tx) ([]byte, error) {
+		if encoder == nil {
+			encoding, err := context.NewCLIContext().WithCodec(cdc).GetEncoding()
+			if err != nil {
+				return nil, err
+			}
+			encoder = codec.NewEncoder(cdc, encoding)
+		}
+		return auth.NewTxEncoder(encoder)(tx)
+	}
+}
+
 // nolint
 // SimulateMsgs simulates the transaction and returns the gas estimate and the adjusted value.
 func simulateMsgs(txBldr authtxb.TxBuilder, cliCtx context.CLIContext, msgs []sdk.Msg) (estimated, adjusted uint64, err error) {
@@ -286,8 +373,7 @@ func adjustGasEstimate(estimate uint64, adjustment float64) uint64 {
 
 func parseQueryResponse(cdc *amino.Codec, rawRes []byte) (uint64, error) {
 	var simulationResult sdk.Result
-	// nikolas
-	if err := cdc.UnmarshalJSON(rawRes, &simulationResult); err != nil {
+	if err := cdc.UnmarshalBinaryLengthPrefixed(rawRes, &simulationResult); err != nil {
 		return 0, err
 	}
 	return simulationResult.GasUsed, nil
@@ -313,16 +399,6 @@ func PrepareTxBuilder(txBldr authtxb.TxBuilder, cliCtx context.CLIContext) (auth
 	return txBldr, nil
 }
 
-// buildUnsignedStdTx builds a StdTx as per the parameters passed in the
-// contexts. Gas is automatically estimated if gas wanted is set to 0.
-func buildUnsignedStdTx(txBldr authtxb.TxBuilder, cliCtx context.CLIContext, msgs []sdk.Msg) (stdTx auth.StdTx, err error) {
-	txBldr, err = PrepareTxBuilder(txBldr, cliCtx)
-	if err != nil {
-		return
-	}
-	return buildUnsignedStdTxOffline(txBldr, cliCtx, msgs)
-}
-
 func buildUnsignedStdTxOffline(txBldr authtxb.TxBuilder, cliCtx context.CLIContext, msgs []sdk.Msg) (stdTx auth.StdTx, err error) {
 	if txBldr.SimulateAndExecute() {
 		txBldr, err = EnrichWithGas(txBldr, cliCtx, msgs)
@@ -0,0 +1,83 @@
+package utils
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	"github.com/PhenixChain/PhenixChain/codec"
+	sdk "github.com/PhenixChain/PhenixChain/types"
+	"github.com/PhenixChain/PhenixChain/x/auth"
+	authtxb "github.com/PhenixChain/PhenixChain/x/auth/client/txbuilder"
+	"github.com/PhenixChain/PhenixChain/x/bank"
+)
+
+func TestReadStdTxOrSignRequestFromFile(t *testing.T) {
+	cdc := codec.New()
+	sdk.RegisterCodec(cdc)
+	auth.RegisterCodec(cdc)
+	bank.RegisterCodec(cdc)
+	codec.RegisterCrypto(cdc)
+
+	signer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	to := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	msg := bank.NewMsgSend(signer, to, sdk.NewCoins(sdk.NewInt64Coin("upnx", 1000)))
+	fee := auth.NewStdFee(200000, sdk.NewCoins(sdk.NewInt64Coin("upnx", 5)))
+	stdTx := auth.NewStdTx([]sdk.Msg{msg}, fee, nil, "memo")
+	req := authtxb.NewStdSignRequest("phenix", signer, 7, stdTx)
+
+	compact, err := req.MarshalCompact(cdc)
+	require.NoError(t, err)
+	emptyReq := authtxb.NewStdSignRequest("phenix", signer, 7, auth.NewStdTx(nil, fee, nil, ""))
+
+	dir, err := ioutil.TempDir("", "utils")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	cases := []struct {
+		name     string
+		contents []byte
+		jsonReq  bool // told apart as a JSON sign request
+		signReq  bool // read as a sign request
+		wantErr  bool
+	}{
+		{"plain tx", cdc.MustMarshalJSON(stdTx), false, false, false},
+		{"json sign request", cdc.MustMarshalJSON(req), true, true, false},
+		{"compact sign request", []byte(compact + "\n"), false, true, false},
+		{"sign request without msgs", cdc.MustMarshalJSON(emptyReq), true, true, true},
+		{"malformed json", []byte(`{"chain_id":`), false, false, true},
+		{"malformed compact", []byte(authtxb.SignRequestCompactPrefix + "!!"), false, true, true},
+		{"not json", []byte("garbage"), false, false, true},
+	}
+
+	for i, tc := range cases {
+		require.Equal(t, tc.jsonReq, isJSONSignRequest(tc.contents), tc.name)
+
+		filename := filepath.Join(dir, fmt.Sprintf("tx%d.json", i))
+		require.NoError(t, ioutil.WriteFile(filename, tc.contents, 0600))
+		readTx, readReq, err := ReadStdTxOrSignRequestFromFile(cdc, filename)
+		if tc.wantErr {
+			require.Error(t, err, tc.name)
+			continue
+		}
+		require.NoError(t, err, tc.name)
+		require.Equal(t, stdTx.Memo, readTx.Memo, tc.name)
+		require.Equal(t, stdTx.GetMsgs(), readTx.GetMsgs(), tc.name)
+		if !tc.signReq {
+			require.Nil(t, readReq, tc.name)
+			continue
+		}
+		require.NotNil(t, readReq, tc.name)
+		require.Equal(t, req.ChainID, readReq.ChainID, tc.name)
+		require.Equal(t, req.Signer, readReq.Signer, tc.name)
+		require.Equal(t, req.Sequence, readReq.Sequence, tc.name)
+	}
+
+	_, _, err = ReadStdTxOrSignRequestFromFile(cdc, filepath.Join(dir, "missing"))
+	require.Error(t, err)
+}
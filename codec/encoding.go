@@ -0,0 +1,126 @@
+package codec
+
+import (
+	"fmt"
+	"sync"
+)
+
+// Encoding is the format transactions and state values are serialized in
+type Encoding string
+
+const (
+	// EncodingJSON serializes values as Amino JSON. State written before the
+	// encoding could be chosen uses it, so it is also what an empty Encoding
+	// stands for.
+	EncodingJSON Encoding = "json"
+
+	// EncodingBinary serializes values with the Amino binary encoding, which
+	// is several times smaller and faster than JSON.
+	EncodingBinary Encoding = "binary"
+)
+
+// Validate returns an error for unknown encodings
+func (e Encoding) Validate() error {
+	switch e {
+	case "", EncodingJSON, EncodingBinary:
+		return nil
+	default:
+		return fmt.Errorf("unknown encoding %q, expected %q or %q", string(e), EncodingJSON, EncodingBinary)
+	}
+}
+
+// IsBinary returns whether values are serialized with the Amino binary encoding
+func (e Encoding) IsBinary() bool {
+	return e == EncodingBinary
+}
+
+// String implements fmt.Stringer
+func (e Encoding) String() string {
+	if e == "" {
+		return string(EncodingJSON)
+	}
+	return string(e)
+}
+
+// Encoder serializes values with a codec in an Encoding that may be switched
+// after it is created, e.g. once the genesis file of a chain is read. An app
+// shares a single Encoder between its tx decoder and keepers so that they all
+// follow the same Encoding.
+type Encoder struct {
+	cdc *Codec
+
+	mtx      sync.RWMutex
+	encoding Encoding
+}
+
+// NewEncoder returns an Encoder serializing values with cdc in the given encoding
+func NewEncoder(cdc *Codec, encoding Encoding) *Encoder {
+	return &Encoder{cdc: cdc, encoding: encoding}
+}
+
+// Codec returns the codec values are serialized with
+func (e *Encoder) Codec() *Codec {
+	return e.cdc
+}
+
+// Encoding returns the current encoding
+func (e *Encoder) Encoding() Encoding {
+	e.mtx.RLock()
+	defer e.mtx.RUnlock()
+	return e.encoding
+}
+
+// SetEncoding switches the encoding of all subsequently serialized values
+func (e *Encoder) SetEncoding(encoding Encoding) {
+	e.mtx.Lock()
+	defer e.mtx.Unlock()
+	e.encoding = encoding
+}
+
+// Marshal serializes o in the current encoding
+func (e *Encoder) Marshal(o interface{}) ([]byte, error) {
+	if e.Encoding().IsBinary() {
+		return e.cdc.MarshalBinaryBare(o)
+	}
+	return e.cdc.MarshalJSON(o)
+}
+
+// Unmarshal deserializes bz in the current encoding into ptr
+func (e *Encoder) Unmarshal(bz []byte, ptr interface{}) error {
+	if e.Encoding().IsBinary() {
+		return e.cdc.UnmarshalBinaryBare(bz, ptr)
+	}
+	return e.cdc.UnmarshalJSON(bz, ptr)
+}
+
+// MarshalLengthPrefixed serializes o in the current encoding, prefixing the
+// binary encoding with its length as transactions are on the wire
+func (e *Encoder) MarshalLengthPrefixed(o interface{}) ([]byte, error) {
+	if e.Encoding().IsBinary() {
+		return e.cdc.MarshalBinaryLengthPrefixed(o)
+	}
+	return e.cdc.MarshalJSON(o)
+}
+
+// UnmarshalLengthPrefixed deserializes bz written by MarshalLengthPrefixed
+// into ptr
+func (e *Encoder) UnmarshalLengthPrefixed(bz []byte, ptr interface{}) error {
+	if e.Encoding().IsBinary() {
+		return e.cdc.UnmarshalBinaryLengthPrefixed(bz, ptr)
+	}
+	return e.cdc.UnmarshalJSON(bz, ptr)
+}
+
+// UnmarshalAnyEncoding deserializes bz written with Marshal in either encoding
+// into ptr, for readers that don't know the encoding of the chain
+func UnmarshalAnyEncoding(cdc *Codec, bz []byte, ptr interface{}) error {
+	jsonErr := cdc.UnmarshalJSON(bz, ptr)
+	if jsonErr == nil {
+		return nil
+	}
+	binaryErr := cdc.UnmarshalBinaryBare(bz, ptr)
+	if binaryErr == nil {
+		return nil
+	}
+	return fmt.Errorf("failed to decode as JSON (%v) or binary (%v)", jsonErr, binaryErr)
+}
@@ -0,0 +1,5 @@
+#!/bin/bash
+cd ../phenix
+go run . testnet --v 4 --output-dir ../mytestnet --chain-id phenix-testnet
+cd ../docker
+docker-compose -f docker-compose-testnet.yml up -d
@@ -0,0 +1,195 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/tendermint/tendermint/libs/cli"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/PhenixChain/PhenixChain/app"
+	"github.com/PhenixChain/PhenixChain/client"
+	"github.com/PhenixChain/PhenixChain/codec"
+	"github.com/PhenixChain/PhenixChain/server"
+	sdk "github.com/PhenixChain/PhenixChain/types"
+	"github.com/PhenixChain/PhenixChain/x/auth"
+)
+
+// ValidateGenesisCmd validates the genesis file of the node or a given file
+func ValidateGenesisCmd(ctx *server.Context, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "validate-genesis [file]",
+		Short: "Validates the genesis file at the default location or at the location passed as an arg",
+		Args:  cobra.RangeArgs(0, 1),
+		RunE: func(_ *cobra.Command, args []string) error {
+			// Load default if passed no args, otherwise load passed file
+			var genFile string
+			if len(args) == 0 {
+				ctx.Config.SetRoot(viper.GetString(cli.HomeFlag))
+				genFile = ctx.Config.GenesisFile()
+			} else {
+				genFile = args[0]
+			}
+
+			fmt.Printf("validating genesis file at %s\n", genFile)
+
+			genDoc, err := tmtypes.GenesisDocFromFile(genFile)
+			if err != nil {
+				return fmt.Errorf("error loading genesis doc from %s: %s", genFile, err.Error())
+			}
+
+			var genState app.GenesisState
+			if err = cdc.UnmarshalJSON(genDoc.AppState, &genState); err != nil {
+				return fmt.Errorf("error unmarshaling genesis doc %s: %s", genFile, err.Error())
+			}
+
+			if err = app.ValidateGenesis(genState); err != nil {
+				return fmt.Errorf("error validating genesis file %s: %s", genFile, err.Error())
+			}
+
+			fmt.Printf("File at %s is a valid genesis file for phenix\n", genFile)
+			return nil
+		},
+	}
+}
+
+// MigrateGenesisCmd rewrites an exported genesis file to the layout of a newer
+// version of the app and prints it
+func MigrateGenesisCmd(_ *server.Context, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate [target-version] [genesis-file]",
+		Short: "Migrate genesis to a specified target version",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+Migrate the source genesis into the target version and print to STDOUT.
+The migrations of every version up to the target are applied in order.
+Supported target versions: %s
+
+$ phenix migrate v0.2 /path/to/genesis.json --chain-id=phenix-2
+`, strings.Join(app.MigrationVersions(), ", "))),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			target, importGenesis := args[0], args[1]
+
+			genContents, err := ioutil.ReadFile(importGenesis)
+			if err != nil {
+				return err
+			}
+
+			var genDoc tmtypes.GenesisDoc
+			if err = cdc.UnmarshalJSON(genContents, &genDoc); err != nil {
+				return fmt.Errorf("failed to read genesis document from file %s: %v", importGenesis, err)
+			}
+
+			var appState app.AppMap
+			if err = json.Unmarshal(genDoc.AppState, &appState); err != nil {
+				return fmt.Errorf("failed to unmarshal app state: %v", err)
+			}
+
+			appState, err = app.Migrate(target, appState)
+			if err != nil {
+				return err
+			}
+
+			genDoc.AppState, err = json.Marshal(appState)
+			if err != nil {
+				return err
+			}
+
+			chainID, _ := cmd.Flags().GetString(client.FlagChainID)
+			if chainID != "" {
+				genDoc.ChainID = chainID
+			}
+
+			out, err := codec.MarshalJSONIndent(cdc, genDoc)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(out))
+			return nil
+		},
+	}
+
+	cmd.Flags().String(client.FlagChainID, "", "override the chain-id of the migrated genesis")
+	return cmd
+}
+
+// newGenesisAccount builds the genesis account of addr holding coins from the
+// vesting flags of add-genesis-account. Either a plain or a vesting account is
+// returned.
+func newGenesisAccount(cdc *codec.Codec, addr sdk.AccAddress, coins sdk.Coins) (
+	*auth.BaseAccount, auth.VestingAccount, error) {
+
+	acc := auth.NewBaseAccountWithAddress(addr)
+	acc.Coins = coins
+
+	vestingStart := viper.GetInt64(flagVestingStart)
+	vestingEnd := viper.GetInt64(flagVestingEnd)
+
+	var periods auth.Periods
+	if periodsFile := viper.GetString(flagVestingPeriods); periodsFile != "" {
+		bz, err := ioutil.ReadFile(periodsFile)
+		if err != nil {
+			return nil, nil, err
+		}
+		if err = json.Unmarshal(bz, &periods); err != nil {
+			return nil, nil, fmt.Errorf("failed to parse vesting periods: %v", err)
+		}
+		if err = periods.Validate(); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	vestingAmt, err := sdk.ParseCoins(viper.GetString(flagVestingAmount))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to parse vesting amount: %v", err)
+	}
+	if vestingAmt.Empty() {
+		vestingAmt = periods.TotalAmount()
+	}
+	if vestingAmt.Empty() {
+		return &acc, nil, nil
+	}
+
+	if !coins.IsAllGTE(vestingAmt) {
+		return nil, nil, fmt.Errorf("vesting amount %s exceeds the account coins %s", vestingAmt, coins)
+	}
+
+	var vacc auth.VestingAccount
+	switch {
+	case len(periods) > 0:
+		if !periods.TotalAmount().IsEqual(vestingAmt) {
+			return nil, nil, fmt.Errorf("vesting periods add up to %s instead of the vesting amount %s",
+				periods.TotalAmount(), vestingAmt)
+		}
+		pva := auth.NewPeriodicVestingAccount(&acc, vestingStart, periods)
+		if vestingEnd != 0 && vestingEnd != pva.EndTime {
+			return nil, nil, fmt.Errorf("vesting periods end at %d instead of the vesting end time %d",
+				pva.EndTime, vestingEnd)
+		}
+		pva.OriginalVesting = vestingAmt
+		vacc = pva
+
+	case vestingEnd == 0:
+		return nil, nil, fmt.Errorf("a vesting end time is required for vesting accounts")
+
+	case vestingStart != 0:
+		if vestingStart >= vestingEnd {
+			return nil, nil, fmt.Errorf("vesting start time must be before the end time")
+		}
+		cva := auth.NewContinuousVestingAccount(&acc, vestingStart, vestingEnd)
+		cva.OriginalVesting = vestingAmt
+		vacc = cva
+
+	default:
+		dva := auth.NewDelayedVestingAccount(&acc, vestingEnd)
+		dva.OriginalVesting = vestingAmt
+		vacc = dva
+	}
+
+	return nil, vacc, nil
+}
@@ -12,8 +12,7 @@ import (
 	"github.com/PhenixChain/PhenixChain/client"
 	"github.com/PhenixChain/PhenixChain/codec"
 	"github.com/PhenixChain/PhenixChain/server"
-	"github.com/PhenixChain/PhenixChain/x/auth"
-	"github.com/PhenixChain/PhenixChain/x/bank"
+	"github.com/PhenixChain/PhenixChain/store"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 	"github.com/tendermint/tendermint/crypto"
@@ -22,6 +21,7 @@ import (
 	"github.com/tendermint/tendermint/libs/log"
 
 	"github.com/PhenixChain/PhenixChain/app"
+	bam "github.com/PhenixChain/PhenixChain/baseapp"
 	sdk "github.com/PhenixChain/PhenixChain/types"
 	abci "github.com/tendermint/tendermint/abci/types"
 	cfg "github.com/tendermint/tendermint/config"
@@ -34,6 +34,11 @@ var DefaultNodeHome = os.ExpandEnv("$HOME/.phenix")
 
 const (
 	flagOverwrite = "overwrite"
+
+	flagVestingAmount  = "vesting-amount"
+	flagVestingStart   = "vesting-start-time"
+	flagVestingEnd     = "vesting-end-time"
+	flagVestingPeriods = "vesting-periods"
 )
 
 func main() {
@@ -50,8 +55,12 @@ func main() {
 
 	rootCmd.AddCommand(InitCmd(ctx, cdc))
 	rootCmd.AddCommand(AddGenesisAccountCmd(ctx, cdc))
+	rootCmd.AddCommand(ValidateGenesisCmd(ctx, cdc))
+	rootCmd.AddCommand(MigrateGenesisCmd(ctx, cdc))
+	rootCmd.AddCommand(TestnetFilesCmd(ctx, cdc))
 
 	server.AddCommands(ctx, cdc, rootCmd, newApp, appExporter())
+	rootCmd.AddCommand(server.DebugCmd(ctx, cdc, app.StoreDecoders()))
 
 	// prepare and add flags
 	executor := cli.PrepareBaseCmd(rootCmd, "PC", DefaultNodeHome)
@@ -63,14 +72,28 @@ func main() {
 }
 
 func newApp(logger log.Logger, db dbm.DB, traceStore io.Writer) abci.Application {
-	return app.NewNameServiceApp(logger, db)
+	return app.NewNameServiceApp(logger, db, true,
+		bam.SetPruning(store.NewPruningOptionsFromString(viper.GetString("pruning"))),
+		bam.SetMetrics(server.AppMetrics()),
+		bam.SetHaltHeight(uint64(viper.GetInt64(server.FlagHaltHeight))),
+		bam.SetHaltTime(uint64(viper.GetInt64(server.FlagHaltTime))),
+	)
 }
 
 func appExporter() server.AppExporter {
-	return func(logger log.Logger, db dbm.DB, _ io.Writer, _ int64, _ bool, _ []string) (
+	return func(logger log.Logger, db dbm.DB, _ io.Writer, height int64, forZeroHeight bool, jailWhiteList []string) (
 		json.RawMessage, []tmtypes.GenesisValidator, error) {
-		dapp := app.NewNameServiceApp(logger, db)
-		return dapp.ExportAppStateAndValidators()
+
+		if height != -1 {
+			dapp := app.NewNameServiceApp(logger, db, false)
+			if err := dapp.LoadHeight(height); err != nil {
+				return nil, nil, err
+			}
+			return dapp.ExportAppStateAndValidators(forZeroHeight, jailWhiteList)
+		}
+
+		dapp := app.NewNameServiceApp(logger, db, true)
+		return dapp.ExportAppStateAndValidators(forZeroHeight, jailWhiteList)
 	}
 }
 
@@ -101,10 +124,7 @@ func InitCmd(ctx *server.Context, cdc *codec.Codec) *cobra.Command {
 				return fmt.Errorf("genesis.json file already exists: %v", genFile)
 			}
 
-			genesis := app.GenesisState{
-				AuthData: auth.DefaultGenesisState(),
-				BankData: bank.DefaultGenesisState(),
-			}
+			genesis := app.NewDefaultGenesisState()
 
 			appState, err = codec.MarshalJSONIndent(cdc, genesis)
 			if err != nil {
@@ -176,6 +196,14 @@ func AddGenesisAccountCmd(ctx *server.Context, cdc *codec.Codec) *cobra.Command
 		Long: strings.TrimSpace(`
 Adds accounts to the genesis file so that you can start a chain with coins in the CLI:
 $ phenix add-genesis-account adr1tse7r2fadvlrrgau3pa0ss7cqh55wrv6y9alwh 1000STAKE,1000mycoin
+
+Part of the coins can be locked in a vesting schedule. They vest linearly between
+--vesting-start-time and --vesting-end-time, all at once at --vesting-end-time when
+no start time is given, or period by period following a JSON file of periods
+([{"length": seconds, "amount": [{"denom": "stake", "amount": "100"}]}, ...])
+starting at --vesting-start-time:
+$ phenix add-genesis-account adr1tse7r2fadvlrrgau3pa0ss7cqh55wrv6y9alwh 1000stake \
+	--vesting-amount 600stake --vesting-start-time 1577836800 --vesting-end-time 1609459200
 `),
 		RunE: func(_ *cobra.Command, args []string) error {
 			addr, err := sdk.AccAddressFromBech32(args[0])
@@ -212,10 +240,21 @@ $ phenix add-genesis-account adr1tse7r2fadvlrrgau3pa0ss7cqh55wrv6y9alwh 1000STAK
 					return fmt.Errorf("the application state already contains account %v", addr)
 				}
 			}
+			for _, stateAcc := range appState.VestingAccounts {
+				if stateAcc.GetAddress().Equals(addr) {
+					return fmt.Errorf("the application state already contains account %v", addr)
+				}
+			}
 
-			acc := auth.NewBaseAccountWithAddress(addr)
-			acc.Coins = coins
-			appState.Accounts = append(appState.Accounts, &acc)
+			acc, vacc, err := newGenesisAccount(cdc, addr, coins)
+			if err != nil {
+				return err
+			}
+			if vacc != nil {
+				appState.VestingAccounts = append(appState.VestingAccounts, vacc)
+			} else {
+				appState.Accounts = append(appState.Accounts, acc)
+			}
 			appStateJSON, err := cdc.MarshalJSON(appState)
 			if err != nil {
 				return err
@@ -224,5 +263,10 @@ $ phenix add-genesis-account adr1tse7r2fadvlrrgau3pa0ss7cqh55wrv6y9alwh 1000STAK
 			return ExportGenesisFile(genFile, genDoc.ChainID, genDoc.Validators, appStateJSON)
 		},
 	}
+
+	cmd.Flags().String(flagVestingAmount, "", "amount of coins for vesting accounts")
+	cmd.Flags().Int64(flagVestingStart, 0, "schedule start time (unix epoch) for vesting accounts")
+	cmd.Flags().Int64(flagVestingEnd, 0, "schedule end time (unix epoch) for vesting accounts")
+	cmd.Flags().String(flagVestingPeriods, "", "JSON file with the vesting periods of a periodic vesting account")
 	return cmd
 }
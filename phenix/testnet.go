@@ -0,0 +1,205 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	tmconfig "github.com/tendermint/tendermint/config"
+	"github.com/tendermint/tendermint/libs/common"
+	"github.com/tendermint/tendermint/p2p"
+	tmtypes "github.com/tendermint/tendermint/types"
+	tmtime "github.com/tendermint/tendermint/types/time"
+
+	"github.com/PhenixChain/PhenixChain/app"
+	"github.com/PhenixChain/PhenixChain/client"
+	"github.com/PhenixChain/PhenixChain/codec"
+	"github.com/PhenixChain/PhenixChain/server"
+	sdk "github.com/PhenixChain/PhenixChain/types"
+	"github.com/PhenixChain/PhenixChain/x/auth"
+)
+
+const (
+	flagNodeDirPrefix  = "node-dir-prefix"
+	flagNumValidators  = "v"
+	flagOutputDir      = "output-dir"
+	flagNodeDaemonHome = "node-daemon-home"
+	flagNodeCliHome    = "node-cli-home"
+	flagStartingPort   = "starting-port"
+	flagHostAddress    = "host-address"
+	flagAccountCoins   = "coins"
+
+	// ports taken by every node, counted from its P2P port
+	portsPerNode = 10
+
+	// password of the generated node keys
+	nodeKeyPassword = "12345678"
+)
+
+// TestnetFilesCmd initializes the files of a local multi-validator testnet
+func TestnetFilesCmd(_ *server.Context, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "testnet",
+		Short: "Initialize files for a phenix local testnet",
+		Long: `testnet will create "v" number of directories and populate each with
+the node and validator keys, a funded account key and a configuration.
+Every node shares the same genesis file listing all of them as validators of equal
+power. Phenix runs without a staking module, so the validators are written to the
+genesis validator set directly rather than created by gentxs. Every node also lists
+the others as persistent peers and listens on its own ports so that all nodes can
+run on a single host.
+
+Example:
+	phenix testnet --v 4 --output-dir ./mytestnet --starting-port 26656
+	`,
+		Args: cobra.NoArgs,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			return initTestnet(cdc)
+		},
+	}
+
+	cmd.Flags().Int(flagNumValidators, 4, "Number of validators to initialize the testnet with")
+	cmd.Flags().StringP(flagOutputDir, "o", "./mytestnet", "Directory to store initialization data for the testnet")
+	cmd.Flags().String(flagNodeDirPrefix, "node", "Prefix the directory name for each node with (node results in node0, node1, ...)")
+	cmd.Flags().String(flagNodeDaemonHome, "phenix", "Home directory of the node's daemon configuration")
+	cmd.Flags().String(flagNodeCliHome, "phenixcli", "Home directory of the node's cli configuration")
+	cmd.Flags().Int(flagStartingPort, 26656, "P2P port of the first node, every next node uses the ports "+
+		fmt.Sprintf("%d higher", portsPerNode))
+	cmd.Flags().String(flagHostAddress, "127.0.0.1", "Address the nodes reach each other on")
+	cmd.Flags().String(client.FlagChainID, "", "genesis file chain-id, if left blank will be randomly created")
+	cmd.Flags().String(flagAccountCoins, fmt.Sprintf("1000000000%s", sdk.DefaultBondDenom),
+		"Coins given to the account of every node")
+	return cmd
+}
+
+func initTestnet(cdc *codec.Codec) (err error) {
+	numValidators := viper.GetInt(flagNumValidators)
+	if numValidators < 1 {
+		return fmt.Errorf("a testnet needs at least one validator")
+	}
+
+	outDir := viper.GetString(flagOutputDir)
+	startingPort := viper.GetInt(flagStartingPort)
+	hostAddress := viper.GetString(flagHostAddress)
+
+	chainID := viper.GetString(client.FlagChainID)
+	if chainID == "" {
+		chainID = "chain-" + common.RandStr(6)
+	}
+
+	coins, err := sdk.ParseCoins(viper.GetString(flagAccountCoins))
+	if err != nil {
+		return err
+	}
+
+	// never mix the keys of an earlier testnet in, nor remove its files below
+	if _, err := os.Stat(outDir); !os.IsNotExist(err) {
+		return fmt.Errorf("output directory %s already exists", outDir)
+	}
+	defer func() {
+		// don't leave a half-built testnet behind
+		if err != nil {
+			_ = os.RemoveAll(outDir)
+		}
+	}()
+
+	nodeIDs := make([]string, numValidators)
+	validators := make([]tmtypes.GenesisValidator, numValidators)
+	accounts := make([]*auth.BaseAccount, numValidators)
+	configs := make([]*tmconfig.Config, numValidators)
+
+	for i := 0; i < numValidators; i++ {
+		nodeDirName := fmt.Sprintf("%s%d", viper.GetString(flagNodeDirPrefix), i)
+		nodeDir := filepath.Join(outDir, nodeDirName, viper.GetString(flagNodeDaemonHome))
+		clientDir := filepath.Join(outDir, nodeDirName, viper.GetString(flagNodeCliHome))
+
+		if err := os.MkdirAll(filepath.Join(nodeDir, "config"), 0755); err != nil {
+			return err
+		}
+		if err := os.MkdirAll(clientDir, 0755); err != nil {
+			return err
+		}
+
+		nodeConfig := tmconfig.DefaultConfig()
+		nodeConfig.SetRoot(nodeDir)
+		nodeConfig.Moniker = nodeDirName
+
+		port := startingPort + i*portsPerNode
+		nodeConfig.P2P.ListenAddress = fmt.Sprintf("tcp://0.0.0.0:%d", port)
+		nodeConfig.RPC.ListenAddress = fmt.Sprintf("tcp://0.0.0.0:%d", port+1)
+		nodeConfig.ProxyApp = fmt.Sprintf("tcp://127.0.0.1:%d", port+2)
+		nodeConfig.RPC.GRPCListenAddress = ""
+		nodeConfig.P2P.AddrBookStrict = false
+		nodeConfig.P2P.AllowDuplicateIP = true
+
+		nodeIDs[i], validators[i].PubKey, err = InitializeNodeValidatorFiles(nodeConfig)
+		if err != nil {
+			return err
+		}
+		validators[i].Power = 10000
+		validators[i].Name = nodeDirName
+		configs[i] = nodeConfig
+
+		addr, secret, err := server.GenerateSaveCoinKey(clientDir, nodeDirName, nodeKeyPassword, true)
+		if err != nil {
+			return err
+		}
+
+		info := map[string]string{"address": addr.String(), "secret": secret, "password": nodeKeyPassword}
+		cliPrint, err := json.Marshal(info)
+		if err != nil {
+			return err
+		}
+
+		// save private key seed words
+		if err := ioutil.WriteFile(filepath.Join(clientDir, "key_seed.json"), cliPrint, 0600); err != nil {
+			return err
+		}
+
+		acc := auth.NewBaseAccountWithAddress(addr)
+		acc.Coins = coins
+		accounts[i] = &acc
+	}
+
+	genesis := app.NewDefaultGenesisState()
+	genesis.Accounts = accounts
+	appState, err := codec.MarshalJSONIndent(cdc, genesis)
+	if err != nil {
+		return err
+	}
+
+	genDoc := tmtypes.GenesisDoc{
+		ChainID:     chainID,
+		GenesisTime: tmtime.Now(),
+		Validators:  validators,
+		AppState:    appState,
+	}
+	if err := genDoc.ValidateAndComplete(); err != nil {
+		return err
+	}
+
+	for i, nodeConfig := range configs {
+		peers := []string{}
+		for j, nodeID := range nodeIDs {
+			if j == i {
+				continue
+			}
+			peers = append(peers, p2p.IDAddressString(p2p.ID(nodeID),
+				fmt.Sprintf("%s:%d", hostAddress, startingPort+j*portsPerNode)))
+		}
+		nodeConfig.P2P.PersistentPeers = strings.Join(peers, ",")
+
+		if err := genDoc.SaveAs(nodeConfig.GenesisFile()); err != nil {
+			return err
+		}
+		tmconfig.WriteConfigFile(filepath.Join(nodeConfig.RootDir, "config", "config.toml"), nodeConfig)
+	}
+
+	fmt.Printf("Successfully initialized %d node directories in %s\n", numValidators, outDir)
+	return nil
+}
@@ -0,0 +1,79 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/PhenixChain/PhenixChain/app"
+	"github.com/PhenixChain/PhenixChain/client"
+)
+
+func setupTestnetFlags(t *testing.T, outDir string) {
+	cmd := TestnetFilesCmd(nil, app.MakeCodec())
+	require.NoError(t, viper.BindPFlags(cmd.Flags()))
+	viper.Set(flagNumValidators, 1)
+	viper.Set(flagOutputDir, outDir)
+	viper.Set(client.FlagChainID, "phenix-testnet")
+}
+
+func TestInitTestnet(t *testing.T) {
+	dir, err := ioutil.TempDir("", "testnet")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	outDir := filepath.Join(dir, "mytestnet")
+	setupTestnetFlags(t, outDir)
+	require.NoError(t, initTestnet(app.MakeCodec()))
+
+	nodeDir := filepath.Join(outDir, "node0", "phenix")
+	for _, file := range []string{"genesis.json", "config.toml", "node_key.json", "priv_validator_key.json"} {
+		require.FileExists(t, filepath.Join(nodeDir, "config", file))
+	}
+	require.FileExists(t, filepath.Join(outDir, "node0", "phenixcli", "key_seed.json"))
+
+	// the node is the only validator and holds a funded account
+	genDoc, err := tmtypes.GenesisDocFromFile(filepath.Join(nodeDir, "config", "genesis.json"))
+	require.NoError(t, err)
+	require.Equal(t, "phenix-testnet", genDoc.ChainID)
+	require.Len(t, genDoc.Validators, 1)
+	require.Equal(t, "node0", genDoc.Validators[0].Name)
+
+	var genesis app.GenesisState
+	require.NoError(t, app.MakeCodec().UnmarshalJSON(genDoc.AppState, &genesis))
+	require.NoError(t, app.ValidateGenesis(genesis))
+	require.Len(t, genesis.Accounts, 1)
+	require.False(t, genesis.Accounts[0].Coins.Empty())
+
+	// a lone node has no peers and listens on the starting ports
+	config, err := ioutil.ReadFile(filepath.Join(nodeDir, "config", "config.toml"))
+	require.NoError(t, err)
+	require.Contains(t, string(config), `persistent_peers = ""`)
+	require.Contains(t, string(config), `laddr = "tcp://0.0.0.0:26656"`)
+
+	// an existing testnet is neither overwritten nor removed
+	setupTestnetFlags(t, outDir)
+	require.Error(t, initTestnet(app.MakeCodec()))
+	require.FileExists(t, filepath.Join(nodeDir, "config", "genesis.json"))
+}
+
+func TestInitTestnetCleansUpOnError(t *testing.T) {
+	dir, err := ioutil.TempDir("", "testnet")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	// the node files are written before the invalid chain ID fails the genesis
+	outDir := filepath.Join(dir, "mytestnet")
+	setupTestnetFlags(t, outDir)
+	viper.Set(client.FlagChainID, strings.Repeat("x", 100))
+	require.Error(t, initTestnet(app.MakeCodec()))
+
+	_, err = os.Stat(outDir)
+	require.True(t, os.IsNotExist(err))
+}
@@ -24,6 +24,11 @@ import (
 	auth "github.com/PhenixChain/PhenixChain/x/auth/client/rest"
 	bankcmd "github.com/PhenixChain/PhenixChain/x/bank/client/cli"
 	bank "github.com/PhenixChain/PhenixChain/x/bank/client/rest"
+	"github.com/PhenixChain/PhenixChain/x/crisis"
+	crisisclient "github.com/PhenixChain/PhenixChain/x/crisis/client"
+	"github.com/PhenixChain/PhenixChain/x/token"
+	tokenclient "github.com/PhenixChain/PhenixChain/x/token/client"
+	tokenrest "github.com/PhenixChain/PhenixChain/x/token/client/rest"
 )
 
 const (
@@ -88,8 +93,15 @@ func queryCmd(cdc *amino.Codec) *cobra.Command {
 		rpc.BlockCommand(),
 		tx.SearchTxCmd(cdc),
 		tx.QueryTxCmd(cdc),
+		rpc.StoreCommand(cdc, app.StoreDecoders()),
 		client.LineBreak,
 		authcmd.GetAccountCmd(storeAcc, cdc),
+		authcmd.GetVestingCmd(cdc),
+		bankcmd.GetBalanceCmd(cdc),
+		bankcmd.GetDenomMetadataCmd(cdc),
+		bankcmd.GetSupplyCmd(cdc),
+		client.LineBreak,
+		tokenclient.NewModuleClient(token.StoreKey, cdc).GetQueryCmd(),
 	)
 
 	return queryCmd
@@ -103,10 +115,14 @@ func txCmd(cdc *amino.Codec) *cobra.Command {
 
 	txCmd.AddCommand(
 		bankcmd.SendTxCmd(cdc),
+		bankcmd.CreateVestingAccountCmd(cdc),
 		client.LineBreak,
 		authcmd.GetSignCommand(cdc),
+		authcmd.GetMultiSignCommand(cdc),
 		tx.GetBroadcastCommand(cdc),
 		client.LineBreak,
+		tokenclient.NewModuleClient(token.StoreKey, cdc).GetTxCmd(),
+		crisisclient.NewModuleClient(crisis.ModuleName, cdc).GetTxCmd(),
 	)
 
 	return txCmd
@@ -141,4 +157,5 @@ func registerRoutes(rs *lcd.RestServer) {
 	tx.RegisterRoutes(rs.CliCtx, rs.Mux, rs.Cdc)
 	auth.RegisterRoutes(rs.CliCtx, rs.Mux, rs.Cdc, storeAcc)
 	bank.RegisterRoutes(rs.CliCtx, rs.Mux, rs.Cdc, rs.KeyBase)
+	tokenrest.RegisterRoutes(rs.CliCtx, rs.Mux, rs.Cdc)
 }
@@ -8,7 +8,9 @@ import (
 )
 
 const (
-	defaultMinGasPrices = ""
+	defaultMinGasPrices         = ""
+	defaultPrometheusListenAddr = ":26670"
+	defaultMetricsNamespace     = "phenix"
 )
 
 // BaseConfig defines the server's basic configuration
@@ -17,11 +19,35 @@ type BaseConfig struct {
 	// transaction. A transaction's fees must meet the minimum of any denomination
 	// specified in this config (e.g. 0.25token1;0.0001token2).
 	MinGasPrices string `mapstructure:"minimum-gas-prices"`
+
+	// HaltHeight contains a non-zero block height at which the node halts
+	// gracefully once the block is committed, e.g. to export the state for an
+	// upgrade.
+	HaltHeight uint64 `mapstructure:"halt-height"`
+
+	// HaltTime contains a non-zero time, in unix seconds, at which the node
+	// halts gracefully once the first block at or after it is committed.
+	HaltTime uint64 `mapstructure:"halt-time"`
+}
+
+// TelemetryConfig defines the configuration of the application's metrics
+type TelemetryConfig struct {
+	// When true, the metrics of the application and its modules are served to
+	// Prometheus on PrometheusListenAddr.
+	Prometheus bool `mapstructure:"prometheus"`
+
+	// Address to listen for Prometheus collector(s) connections.
+	PrometheusListenAddr string `mapstructure:"prometheus-listen-addr"`
+
+	// Prefix of the names of all metrics.
+	Namespace string `mapstructure:"namespace"`
 }
 
 // Config defines the server's top level configuration
 type Config struct {
 	BaseConfig `mapstructure:",squash"`
+
+	Telemetry TelemetryConfig `mapstructure:"telemetry"`
 }
 
 // SetMinGasPrices sets the validator's minimum gas prices.
@@ -54,8 +80,13 @@ func (c *Config) GetMinGasPrices() sdk.DecCoins {
 // DefaultConfig returns server's default configuration.
 func DefaultConfig() *Config {
 	return &Config{
-		BaseConfig{
+		BaseConfig: BaseConfig{
 			MinGasPrices: defaultMinGasPrices,
 		},
+		Telemetry: TelemetryConfig{
+			Prometheus:           false,
+			PrometheusListenAddr: defaultPrometheusListenAddr,
+			Namespace:            defaultMetricsNamespace,
+		},
 	}
 }
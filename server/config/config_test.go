@@ -11,6 +11,10 @@ import (
 func TestDefaultConfig(t *testing.T) {
 	cfg := DefaultConfig()
 	require.True(t, cfg.GetMinGasPrices().IsZero())
+	require.Zero(t, cfg.HaltHeight)
+	require.Zero(t, cfg.HaltTime)
+	require.False(t, cfg.Telemetry.Prometheus)
+	require.NotEmpty(t, cfg.Telemetry.PrometheusListenAddr)
 }
 
 func TestSetMinimumFees(t *testing.T) {
@@ -17,6 +17,27 @@ const defaultConfigTemplate = `# This is a TOML config file.
 # transaction. A transaction's fees must meet the minimum of any denomination
 # specified in this config (e.g. 0.25token1;0.0001token2).
 minimum-gas-prices = "{{ .BaseConfig.MinGasPrices }}"
+
+# A non-zero block height at which the node halts gracefully once the block is
+# committed, e.g. to export the state for an upgrade. Zero disables it.
+halt-height = {{ .BaseConfig.HaltHeight }}
+
+# A non-zero time, in unix seconds, at which the node halts gracefully once the
+# first block at or after it is committed. Zero disables it.
+halt-time = {{ .BaseConfig.HaltTime }}
+
+##### telemetry configuration options #####
+[telemetry]
+
+# When true, the metrics of the application and its modules are served to
+# Prometheus on prometheus-listen-addr.
+prometheus = {{ .Telemetry.Prometheus }}
+
+# Address to listen for Prometheus collector(s) connections.
+prometheus-listen-addr = "{{ .Telemetry.PrometheusListenAddr }}"
+
+# Prefix of the names of all metrics.
+namespace = "{{ .Telemetry.Namespace }}"
 `
 
 var configTemplate *template.Template
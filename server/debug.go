@@ -0,0 +1,326 @@
+package server
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/syndtr/goleveldb/leveldb/opt"
+
+	"github.com/tendermint/tendermint/libs/cli"
+	cmn "github.com/tendermint/tendermint/libs/common"
+	dbm "github.com/tendermint/tendermint/libs/db"
+
+	"github.com/PhenixChain/PhenixChain/codec"
+	"github.com/PhenixChain/PhenixChain/store/rootmulti"
+	sdk "github.com/PhenixChain/PhenixChain/types"
+)
+
+// DebugCmd groups the commands inspecting the raw application state, decoding
+// the values of each store with its decoder in decoders.
+func DebugCmd(ctx *Context, cdc *codec.Codec, decoders map[string]sdk.StoreDecoder) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "debug",
+		Short: "Inspect the raw application state",
+		Long: `debug opens the application database read-only, so the node must be stopped,
+and inspects the state it committed at a height, the latest one by default.
+Keys and prefixes are given and printed in hex.`,
+	}
+
+	cmd.AddCommand(
+		debugStoresCmd(ctx),
+		debugCommitInfoCmd(ctx),
+		debugDumpCmd(ctx, cdc, decoders),
+		debugDiffCmd(ctx, cdc, decoders),
+	)
+	return cmd
+}
+
+// debugStoreVersions lists a store of a height with the versions it still
+// holds, as ranges of consecutive versions
+type debugStoreVersions struct {
+	Name     string   `json:"name"`
+	Versions []string `json:"versions"`
+}
+
+func debugStoresCmd(ctx *Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "stores",
+		Short: "List the stores committed at a height with the versions pruning kept",
+		Args:  cobra.NoArgs,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			return withReadOnlyDB(ctx, func(db dbm.DB) error {
+				commit, err := rootmulti.GetCommit(db, debugHeight(db))
+				if err != nil {
+					return err
+				}
+
+				stores := make([]debugStoreVersions, len(commit.Stores))
+				for i, store := range commit.Stores {
+					stores[i] = debugStoreVersions{
+						Name:     store.Name,
+						Versions: versionRanges(rootmulti.GetStoredVersions(db, store.Name)),
+					}
+				}
+				return printJSON(stores)
+			})
+		},
+	}
+	cmd.Flags().Int64(flagHeight, 0, "Height to inspect, the latest one if 0")
+	return cmd
+}
+
+func debugCommitInfoCmd(ctx *Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "commit-info",
+		Short: "Show the app hash of a height and the commit hash of each store",
+		Args:  cobra.NoArgs,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			return withReadOnlyDB(ctx, func(db dbm.DB) error {
+				commit, err := rootmulti.GetCommit(db, debugHeight(db))
+				if err != nil {
+					return err
+				}
+				return printJSON(commit)
+			})
+		},
+	}
+	cmd.Flags().Int64(flagHeight, 0, "Height to inspect, the latest one if 0")
+	return cmd
+}
+
+func debugDumpCmd(ctx *Context, cdc *codec.Codec, decoders map[string]sdk.StoreDecoder) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dump <store> [prefix]",
+		Short: "Print the key/value pairs of a store at a height, one JSON object per line",
+		Long: `dump iterates over the keys of a store at a height having the given hex prefix,
+all keys by default, and prints every pair as a JSON object on its own line. Values
+are decoded with the codec of the module owning the store; the raw value is
+printed instead, with the reason, when they cannot be decoded.
+
+Example:
+	phenix debug dump acc 01 --height 1000
+`,
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(_ *cobra.Command, args []string) error {
+			prefix, err := parsePrefix(args[1:])
+			if err != nil {
+				return err
+			}
+
+			return withReadOnlyDB(ctx, func(db dbm.DB) error {
+				store, err := loadDebugStore(db, debugHeight(db), args[0])
+				if err != nil {
+					return err
+				}
+
+				iter := sdk.KVStorePrefixIterator(store, prefix)
+				defer iter.Close()
+				for ; iter.Valid(); iter.Next() {
+					pair := sdk.DecodeKVPair(cdc, decoders[args[0]], iter.Key(), iter.Value())
+					bz, err := json.Marshal(pair)
+					if err != nil {
+						return err
+					}
+					fmt.Println(string(bz))
+				}
+				return nil
+			})
+		},
+	}
+	cmd.Flags().Int64(flagHeight, 0, "Height to inspect, the latest one if 0")
+	return cmd
+}
+
+// debugKVDiff is a key whose value differs between two heights of a store,
+// with its pair at each height or null where it is absent
+type debugKVDiff struct {
+	Key  cmn.HexBytes       `json:"key"`
+	From *sdk.DecodedKVPair `json:"from"`
+	To   *sdk.DecodedKVPair `json:"to"`
+}
+
+func debugDiffCmd(ctx *Context, cdc *codec.Codec, decoders map[string]sdk.StoreDecoder) *cobra.Command {
+	return &cobra.Command{
+		Use:   "diff <store> <from-height> <to-height> [prefix]",
+		Short: "Print the keys of a store whose values differ between two heights, one JSON object per line",
+		Long: `diff compares the keys of a store having the given hex prefix, all keys by
+default, at two heights and prints every key added, removed or changed in between
+as a JSON object on its own line, with the decoded pair at each height.
+
+Example:
+	phenix debug diff acc 999 1000
+`,
+		Args: cobra.RangeArgs(3, 4),
+		RunE: func(_ *cobra.Command, args []string) error {
+			from, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid from height %q: %v", args[1], err)
+			}
+			to, err := strconv.ParseInt(args[2], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid to height %q: %v", args[2], err)
+			}
+			prefix, err := parsePrefix(args[3:])
+			if err != nil {
+				return err
+			}
+
+			return withReadOnlyDB(ctx, func(db dbm.DB) error {
+				fromStore, err := loadDebugStore(db, from, args[0])
+				if err != nil {
+					return err
+				}
+				toStore, err := loadDebugStore(db, to, args[0])
+				if err != nil {
+					return err
+				}
+
+				decoder := decoders[args[0]]
+				return diffKVStores(fromStore, toStore, prefix, func(key, fromValue, toValue []byte) error {
+					diff := debugKVDiff{Key: key}
+					if fromValue != nil {
+						pair := sdk.DecodeKVPair(cdc, decoder, key, fromValue)
+						diff.From = &pair
+					}
+					if toValue != nil {
+						pair := sdk.DecodeKVPair(cdc, decoder, key, toValue)
+						diff.To = &pair
+					}
+
+					bz, err := json.Marshal(diff)
+					if err != nil {
+						return err
+					}
+					fmt.Println(string(bz))
+					return nil
+				})
+			})
+		},
+	}
+}
+
+// withReadOnlyDB runs fn with the application database opened read-only
+func withReadOnlyDB(ctx *Context, fn func(dbm.DB) error) error {
+	config := ctx.Config
+	config.SetRoot(viper.GetString(cli.HomeFlag))
+
+	db, err := dbm.NewGoLevelDBWithOpts("application", filepath.Join(config.RootDir, "data"), &opt.Options{
+		ReadOnly:       true,
+		ErrorIfMissing: true,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to open the application database, is the node stopped? %v", err)
+	}
+	defer db.Close()
+
+	return fn(db)
+}
+
+// debugHeight returns the height given by the height flag, the latest one if 0
+func debugHeight(db dbm.DB) int64 {
+	if height := viper.GetInt64(flagHeight); height != 0 {
+		return height
+	}
+	return rootmulti.GetLatestVersion(db)
+}
+
+// loadDebugStore returns a store committed at a height
+func loadDebugStore(db dbm.DB, height int64, name string) (sdk.KVStore, error) {
+	stores, err := rootmulti.LoadStoresAtVersion(db, height)
+	if err != nil {
+		return nil, err
+	}
+
+	store, ok := stores[name]
+	if !ok {
+		names := make([]string, 0, len(stores))
+		for name := range stores {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		return nil, fmt.Errorf("no store %s at height %d, expected one of %s", name, height, strings.Join(names, ", "))
+	}
+	return store, nil
+}
+
+// parsePrefix parses the optional hex prefix argument of a command
+func parsePrefix(args []string) ([]byte, error) {
+	if len(args) == 0 {
+		return nil, nil
+	}
+	prefix, err := hex.DecodeString(args[0])
+	if err != nil {
+		return nil, fmt.Errorf("invalid hex prefix %q: %v", args[0], err)
+	}
+	return prefix, nil
+}
+
+// diffKVStores calls fn in key order for every key having the prefix whose
+// value differs between two stores, with nil values for absent keys
+func diffKVStores(a, b sdk.KVStore, prefix []byte, fn func(key, valueA, valueB []byte) error) error {
+	iterA := sdk.KVStorePrefixIterator(a, prefix)
+	defer iterA.Close()
+	iterB := sdk.KVStorePrefixIterator(b, prefix)
+	defer iterB.Close()
+
+	for iterA.Valid() || iterB.Valid() {
+		var err error
+		switch {
+		case !iterB.Valid() || (iterA.Valid() && bytes.Compare(iterA.Key(), iterB.Key()) < 0):
+			err = fn(iterA.Key(), iterA.Value(), nil)
+			iterA.Next()
+
+		case !iterA.Valid() || bytes.Compare(iterA.Key(), iterB.Key()) > 0:
+			err = fn(iterB.Key(), nil, iterB.Value())
+			iterB.Next()
+
+		default:
+			if !bytes.Equal(iterA.Value(), iterB.Value()) {
+				err = fn(iterA.Key(), iterA.Value(), iterB.Value())
+			}
+			iterA.Next()
+			iterB.Next()
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// versionRanges formats ascending versions as ranges of consecutive versions,
+// e.g. 1-3 and 5 for 1, 2, 3 and 5
+func versionRanges(versions []int64) []string {
+	ranges := []string{}
+	for i := 0; i < len(versions); {
+		j := i
+		for j+1 < len(versions) && versions[j+1] == versions[j]+1 {
+			j++
+		}
+		if i == j {
+			ranges = append(ranges, strconv.FormatInt(versions[i], 10))
+		} else {
+			ranges = append(ranges, fmt.Sprintf("%d-%d", versions[i], versions[j]))
+		}
+		i = j + 1
+	}
+	return ranges
+}
+
+// printJSON prints o as indented JSON
+func printJSON(o interface{}) error {
+	bz, err := json.MarshalIndent(o, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(bz))
+	return nil
+}
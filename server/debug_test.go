@@ -0,0 +1,41 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tendermint/libs/db"
+
+	"github.com/PhenixChain/PhenixChain/store/dbadapter"
+)
+
+func TestDiffKVStores(t *testing.T) {
+	a := dbadapter.Store{DB: dbm.NewMemDB()}
+	b := dbadapter.Store{DB: dbm.NewMemDB()}
+
+	a.Set([]byte("a1"), []byte("removed"))
+	a.Set([]byte("a2"), []byte("same"))
+	b.Set([]byte("a2"), []byte("same"))
+	a.Set([]byte("a3"), []byte("old"))
+	b.Set([]byte("a3"), []byte("new"))
+	b.Set([]byte("a4"), []byte("added"))
+	a.Set([]byte("b1"), []byte("other prefix"))
+
+	var diffs [][3]string
+	err := diffKVStores(a, b, []byte("a"), func(key, valueA, valueB []byte) error {
+		diffs = append(diffs, [3]string{string(key), string(valueA), string(valueB)})
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, [][3]string{
+		{"a1", "removed", ""},
+		{"a3", "old", "new"},
+		{"a4", "", "added"},
+	}, diffs)
+}
+
+func TestVersionRanges(t *testing.T) {
+	require.Equal(t, []string{}, versionRanges(nil))
+	require.Equal(t, []string{"4"}, versionRanges([]int64{4}))
+	require.Equal(t, []string{"1-3", "5", "7-8"}, versionRanges([]int64{1, 2, 3, 5, 7, 8}))
+}
@@ -74,7 +74,10 @@ func ExportCmd(ctx *Context, cdc *codec.Codec, appExporter AppExporter) *cobra.C
 			}
 
 			doc.AppState = appState
-			doc.Validators = validators
+			// apps without a staking module keep the genesis validator set
+			if validators != nil {
+				doc.Validators = validators
+			}
 
 			encoded, err := codec.MarshalJSONIndent(cdc, doc)
 			if err != nil {
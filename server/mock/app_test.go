@@ -1,13 +1,17 @@
 package mock
 
 import (
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/tendermint/tendermint/types"
 
 	"github.com/stretchr/testify/require"
 
 	abci "github.com/tendermint/tendermint/abci/types"
+
+	bam "github.com/PhenixChain/PhenixChain/baseapp"
 )
 
 // TestInitApp makes sure we can initialize this thing without an error
@@ -77,3 +81,70 @@ func TestDeliverTx(t *testing.T) {
 	require.Equal(t, uint32(0), qres.Code, qres.Log)
 	require.Equal(t, []byte(value), qres.Value)
 }
+
+// TestHalt ensures the app halts once the block at the halt height or time is
+// committed, after its state is written
+func TestHalt(t *testing.T) {
+	haltTime := time.Now().Unix()
+
+	cases := map[string]struct {
+		option     func(*bam.BaseApp)
+		haltHeight int64
+		blockTime  func(height int64) time.Time
+	}{
+		"halt height": {
+			option:     bam.SetHaltHeight(2),
+			haltHeight: 2,
+			blockTime:  func(int64) time.Time { return time.Unix(haltTime, 0) },
+		},
+		"halt time": {
+			option:     bam.SetHaltTime(uint64(haltTime)),
+			haltHeight: 3,
+			blockTime:  func(height int64) time.Time { return time.Unix(haltTime+height-3, 0) },
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			app, closer, err := SetupApp(tc.option)
+			if closer != nil {
+				defer closer()
+			}
+			require.NoError(t, err)
+			halted := app.(*bam.BaseApp).Halted()
+
+			for height := int64(1); height <= tc.haltHeight; height++ {
+				key := fmt.Sprintf("key-%d", height)
+				header := abci.Header{Height: height, Time: tc.blockTime(height)}
+				app.BeginBlock(abci.RequestBeginBlock{Header: header})
+				dres := app.DeliverTx(NewTx(key, "value").GetSignBytes())
+				require.Equal(t, uint32(0), dres.Code, dres.Log)
+				app.EndBlock(abci.RequestEndBlock{})
+				app.Commit()
+
+				if height < tc.haltHeight {
+					select {
+					case <-halted:
+						t.Fatalf("halted at height %d", height)
+					default:
+					}
+				}
+			}
+
+			select {
+			case <-halted:
+			default:
+				t.Fatalf("did not halt at height %d", tc.haltHeight)
+			}
+
+			// the block at the halt height is committed
+			qres := app.Query(abci.RequestQuery{
+				Path: "/store/main/key",
+				Data: []byte(fmt.Sprintf("key-%d", tc.haltHeight)),
+			})
+			require.Equal(t, uint32(0), qres.Code, qres.Log)
+			require.Equal(t, []byte("value"), qres.Value)
+			require.Equal(t, tc.haltHeight, app.Info(abci.RequestInfo{}).LastBlockHeight)
+		})
+	}
+}
@@ -7,11 +7,14 @@ import (
 
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/libs/log"
+
+	bam "github.com/PhenixChain/PhenixChain/baseapp"
 )
 
 // SetupApp returns an application as well as a clean-up function
-// to be used to quickly setup a test case with an app
-func SetupApp() (abci.Application, func(), error) {
+// to be used to quickly setup a test case with an app built with the given
+// BaseApp options
+func SetupApp(options ...func(*bam.BaseApp)) (abci.Application, func(), error) {
 	logger := log.NewTMLogger(log.NewSyncWriter(os.Stdout)).
 		With("module", "mock")
 	rootDir, err := ioutil.TempDir("", "mock-sdk")
@@ -26,6 +29,6 @@ func SetupApp() (abci.Application, func(), error) {
 		}
 	}
 
-	app, err := NewApp(rootDir, logger)
+	app, err := NewApp(rootDir, logger, options...)
 	return app, cleanup, err
 }
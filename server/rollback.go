@@ -0,0 +1,112 @@
+package server
+
+// DONTCOVER
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/blockchain"
+	cfg "github.com/tendermint/tendermint/config"
+	"github.com/tendermint/tendermint/libs/cli"
+	dbm "github.com/tendermint/tendermint/libs/db"
+	sm "github.com/tendermint/tendermint/state"
+)
+
+// Rollbacker is implemented by applications whose committed state can be
+// rolled back to an earlier height
+type Rollbacker interface {
+	abci.Application
+
+	RollbackToHeight(height int64) error
+}
+
+// RollbackCmd reverts the application state to an earlier height.
+func RollbackCmd(ctx *Context, appCreator AppCreator) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rollback",
+		Short: "Roll back the application state to an earlier height",
+		Long: `rollback deletes the application state committed after the given height, e.g.
+after a faulty binary committed a wrong app hash, so that the blocks after it
+can be executed again. It refuses to roll back to a pruned height.
+
+Tendermint keeps its own state, which this version of Tendermint cannot roll
+back. The command prints which of it must be reset to match the application.
+
+Example:
+	phenix rollback --height 1000
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			config := ctx.Config
+			config.SetRoot(viper.GetString(cli.HomeFlag))
+
+			db, err := openDB(config.RootDir)
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			app, ok := appCreator(ctx.Logger, db, nil).(Rollbacker)
+			if !ok {
+				return errors.New("the application does not support rollbacks")
+			}
+
+			height := viper.GetInt64(flagHeight)
+			latest := app.Info(abci.RequestInfo{}).LastBlockHeight
+			if err := app.RollbackToHeight(height); err != nil {
+				return err
+			}
+
+			info := app.Info(abci.RequestInfo{})
+			fmt.Printf("Rolled back the application state from height %d to %d with app hash %X\n",
+				latest, info.LastBlockHeight, info.LastBlockAppHash)
+
+			printTendermintRollback(config, info.LastBlockHeight, info.LastBlockAppHash)
+			return nil
+		},
+	}
+
+	cmd.Flags().Int64(flagHeight, 0, "Height to roll back the application state to")
+	return cmd
+}
+
+// printTendermintRollback prints which Tendermint state must be reset to match
+// an application state rolled back to the given height and app hash
+func printTendermintRollback(config *cfg.Config, height int64, appHash []byte) {
+	backend := dbm.DBBackendType(config.DBBackend)
+
+	stateDB := dbm.NewDB("state", backend, config.DBDir())
+	state := sm.LoadState(stateDB)
+	stateDB.Close()
+
+	blockStoreDB := dbm.NewDB("blockstore", backend, config.DBDir())
+	storeHeight := blockchain.NewBlockStore(blockStoreDB).Height()
+	blockStoreDB.Close()
+
+	if state.LastBlockHeight <= height && storeHeight <= height {
+		fmt.Printf("Tendermint is at height %d, no Tendermint state needs resetting\n", state.LastBlockHeight)
+		return
+	}
+
+	fmt.Printf(`Tendermint is at height %d with app hash %X and has blocks up to height %d.
+On start it replays blocks %d to %d, which fails unless they reproduce that app hash.
+To match the application, reset in %s:
+  - state.db to the state after block %d, with app hash %X
+  - blockstore.db by deleting the blocks after height %d
+  - %s by deleting it
+Keep %s, resetting it risks double signing.
+`,
+		state.LastBlockHeight, state.AppHash, storeHeight,
+		height+1, storeHeight,
+		config.DBDir(),
+		height, appHash,
+		height,
+		config.Consensus.WalFile(),
+		config.PrivValidatorStateFile(),
+	)
+}
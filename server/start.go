@@ -2,14 +2,19 @@ package server
 
 import (
 	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 
 	"github.com/tendermint/tendermint/abci/server"
+	abci "github.com/tendermint/tendermint/abci/types"
 
 	tcmd "github.com/tendermint/tendermint/cmd/tendermint/commands"
 	cmn "github.com/tendermint/tendermint/libs/common"
+	dbm "github.com/tendermint/tendermint/libs/db"
 	"github.com/tendermint/tendermint/node"
 	"github.com/tendermint/tendermint/p2p"
 	pvm "github.com/tendermint/tendermint/privval"
@@ -23,6 +28,8 @@ const (
 	flagTraceStore     = "trace-store"
 	flagPruning        = "pruning"
 	FlagMinGasPrices   = "minimum-gas-prices"
+	FlagHaltHeight     = "halt-height"
+	FlagHaltTime       = "halt-time"
 )
 
 // StartCmd runs the service passed in, either stand-alone or in-process with
@@ -54,6 +61,9 @@ func StartCmd(ctx *Context, appCreator AppCreator) *cobra.Command {
 		"Minimum gas prices to accept for transactions; Any fee in a tx must meet this minimum (e.g. 0.01photino;0.0001stake)",
 	)
 
+	cmd.Flags().Uint64(FlagHaltHeight, 0, "Block height at which to gracefully halt the chain and shutdown the node")
+	cmd.Flags().Uint64(FlagHaltTime, 0, "Minimum block time (in Unix seconds) at which to gracefully halt the chain and shutdown the node")
+
 	// add support for all Tendermint-specific command line options
 	tcmd.AddNodeFlags(cmd)
 	return cmd
@@ -75,6 +85,10 @@ func startStandAlone(ctx *Context, appCreator AppCreator) error {
 
 	app := appCreator(ctx.Logger, db, traceWriter)
 
+	if err := startTelemetry(ctx); err != nil {
+		return err
+	}
+
 	svr, err := server.NewServer(addr, "socket", app)
 	if err != nil {
 		return fmt.Errorf("error creating listener: %v", err)
@@ -87,17 +101,23 @@ func startStandAlone(ctx *Context, appCreator AppCreator) error {
 		cmn.Exit(err.Error())
 	}
 
-	// wait forever
-	cmn.TrapSignal(ctx.Logger, func() {
-		// cleanup
-		err = svr.Stop()
-		if err != nil {
-			cmn.Exit(err.Error())
-		}
-	})
+	// run until the application halts the node or the process is signalled
+	code := waitForShutdown(ctx, app)
+	if err = svr.Stop(); err != nil {
+		ctx.Logger.Error("Failed to stop the ABCI server", "err", err)
+	}
+	db.Close()
+
+	if code != 0 {
+		os.Exit(code)
+	}
 	return nil
 }
 
+// startInProcess runs the application with Tendermint in-process until the
+// application halts the node or the process is signalled. The node is then
+// stopped and the databases closed before returning, or exiting with the
+// signal's exit code.
 func startInProcess(ctx *Context, appCreator AppCreator) (*node.Node, error) {
 	cfg := ctx.Config
 	home := cfg.RootDir
@@ -114,11 +134,25 @@ func startInProcess(ctx *Context, appCreator AppCreator) (*node.Node, error) {
 
 	app := appCreator(ctx.Logger, db, traceWriter)
 
+	if err := startTelemetry(ctx); err != nil {
+		return nil, err
+	}
+
 	nodeKey, err := p2p.LoadOrGenNodeKey(cfg.NodeKeyFile())
 	if err != nil {
 		return nil, err
 	}
 
+	// keep the databases of the node, which it does not close when stopped
+	var nodeDBs []dbm.DB
+	dbProvider := func(dbCtx *node.DBContext) (dbm.DB, error) {
+		nodeDB, err := node.DefaultDBProvider(dbCtx)
+		if err == nil {
+			nodeDBs = append(nodeDBs, nodeDB)
+		}
+		return nodeDB, err
+	}
+
 	UpgradeOldPrivValFile(cfg)
 	// create & start tendermint node
 	tmNode, err := node.NewNode(
@@ -127,7 +161,7 @@ func startInProcess(ctx *Context, appCreator AppCreator) (*node.Node, error) {
 		nodeKey,
 		proxy.NewLocalClientCreator(app),
 		node.DefaultGenesisDocProviderFunc(cfg),
-		node.DefaultDBProvider,
+		dbProvider,
 		node.DefaultMetricsProvider(cfg.Instrumentation),
 		ctx.Logger.With("module", "node"),
 	)
@@ -140,12 +174,49 @@ func startInProcess(ctx *Context, appCreator AppCreator) (*node.Node, error) {
 		return nil, err
 	}
 
-	TrapSignal(func() {
-		if tmNode.IsRunning() {
-			_ = tmNode.Stop()
+	// run until the application halts the node or the process is signalled
+	code := waitForShutdown(ctx, app)
+	if tmNode.IsRunning() {
+		if err = tmNode.Stop(); err != nil {
+			ctx.Logger.Error("Failed to stop the node", "err", err)
 		}
-	})
+	}
+	for _, nodeDB := range nodeDBs {
+		nodeDB.Close()
+	}
+	db.Close()
+
+	if code != 0 {
+		os.Exit(code)
+	}
+	return tmNode, nil
+}
+
+// haltingApp is implemented by applications halting the node on their own,
+// e.g. once the block at a configured height is committed
+type haltingApp interface {
+	Halted() <-chan struct{}
+}
 
-	// run forever (the node will not be returned)
-	select {}
+// waitForShutdown blocks until the application halts the node or the process
+// receives SIGINT or SIGTERM, and returns the code the process must exit
+// with: zero once halted, 128 plus the signal number otherwise.
+func waitForShutdown(ctx *Context, app abci.Application) int {
+	var halted <-chan struct{}
+	if app, ok := app.(haltingApp); ok {
+		halted = app.Halted()
+	}
+
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
+	defer signal.Stop(sigs)
+
+	select {
+	case <-halted:
+		ctx.Logger.Info("Application halted the node, shutting down")
+		return 0
+	case sig := <-sigs:
+		ctx.Logger.Info("Captured signal, shutting down", "signal", sig)
+		return 128 + int(sig.(syscall.Signal))
+	}
 }
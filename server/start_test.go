@@ -0,0 +1,50 @@
+package server
+
+import (
+	"io"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmcfg "github.com/tendermint/tendermint/config"
+	dbm "github.com/tendermint/tendermint/libs/db"
+	"github.com/tendermint/tendermint/libs/log"
+
+	bam "github.com/PhenixChain/PhenixChain/baseapp"
+	"github.com/PhenixChain/PhenixChain/store/rootmulti"
+	sdk "github.com/PhenixChain/PhenixChain/types"
+)
+
+// TestStartInProcessHalt runs a node until its application halts it, which
+// must stop the node and close the databases instead of exiting the process
+func TestStartInProcessHalt(t *testing.T) {
+	cfg := tmcfg.ResetTestRoot(t.Name())
+	defer os.RemoveAll(cfg.RootDir)
+	cfg.P2P.ListenAddress = "tcp://127.0.0.1:0"
+	cfg.RPC.ListenAddress = ""
+
+	key := sdk.NewKVStoreKey(bam.MainStoreKey)
+	appCreator := func(logger log.Logger, db dbm.DB, _ io.Writer) abci.Application {
+		app := bam.NewBaseApp(t.Name(), logger, db, nil, bam.SetHaltHeight(2))
+		app.MountStores(key)
+		require.NoError(t, app.LoadLatestVersion(key))
+		return app
+	}
+
+	tmNode, err := startInProcess(NewContext(cfg, log.NewNopLogger()), appCreator)
+	require.NoError(t, err)
+	require.False(t, tmNode.IsRunning())
+
+	// the databases are unlocked, so they were closed, and the application
+	// committed the halt height
+	db, err := openDB(cfg.RootDir)
+	require.NoError(t, err)
+	defer db.Close()
+	require.Equal(t, int64(2), rootmulti.GetLatestVersion(db))
+
+	blockDB, err := dbm.NewGoLevelDB("blockstore", cfg.DBDir())
+	require.NoError(t, err)
+	blockDB.Close()
+}
@@ -0,0 +1,61 @@
+package server
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+
+	"github.com/PhenixChain/PhenixChain/server/config"
+	"github.com/PhenixChain/PhenixChain/telemetry"
+)
+
+var (
+	appMetrics     *telemetry.Metrics
+	appMetricsOnce sync.Once
+)
+
+// AppMetrics returns the metrics the application reports to: Prometheus
+// metrics when they are enabled in the server configuration, no-op metrics
+// otherwise. Metrics can only be registered with Prometheus once, so every
+// call returns the same metrics.
+func AppMetrics() *telemetry.Metrics {
+	appMetricsOnce.Do(func() {
+		conf, err := config.ParseConfig()
+		if err != nil || !conf.Telemetry.Prometheus {
+			appMetrics = telemetry.NopMetrics()
+			return
+		}
+		appMetrics = telemetry.PrometheusMetrics(conf.Telemetry.Namespace)
+	})
+	return appMetrics
+}
+
+// startTelemetry serves the metrics of the application to Prometheus when they
+// are enabled in the server configuration. The listen address is bound before
+// it returns, so that the node refuses to start when it is unavailable.
+func startTelemetry(ctx *Context) error {
+	conf, err := config.ParseConfig()
+	if err != nil {
+		return err
+	}
+	if !conf.Telemetry.Prometheus {
+		return nil
+	}
+
+	addr := conf.Telemetry.PrometheusListenAddr
+	ln, err := net.Listen("tcp", addr)
+	if err != nil {
+		return fmt.Errorf("failed to listen on the Prometheus address %s: %v", addr, err)
+	}
+
+	ctx.Logger.Info("Starting Prometheus metrics server", "addr", ln.Addr())
+	go func() {
+		if err := http.Serve(ln, promhttp.Handler()); err != nil {
+			ctx.Logger.Error("Prometheus metrics server stopped", "err", err)
+		}
+	}()
+	return nil
+}
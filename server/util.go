@@ -152,6 +152,7 @@ func AddCommands(
 		client.LineBreak,
 		tendermintCmd,
 		ExportCmd(ctx, cdc, appExport),
+		RollbackCmd(ctx, appCreator),
 		client.LineBreak,
 		version.VersionCmd,
 	)
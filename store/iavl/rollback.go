@@ -0,0 +1,141 @@
+package iavl
+
+import (
+	"fmt"
+
+	amino "github.com/tendermint/go-amino"
+	dbm "github.com/tendermint/tendermint/libs/db"
+)
+
+// DeleteVersionsAfter deletes all versions of the IAVL tree stored in db after
+// the given version, so that the tree loads at that version and next saves the
+// version following it. The deletions are added to batch, with the keys of db,
+// and only take effect once the caller writes it, so that nothing is deleted
+// when it fails. The tree must not be loaded while its versions are deleted.
+//
+// Unlike iavl.MutableTree.LoadVersionForOverwriting, it also deletes the orphan
+// records of the nodes the given version still references, which would
+// otherwise get them deleted when the version is pruned.
+func DeleteVersionsAfter(db dbm.DB, batch dbm.SetDeleter, version int64) error {
+	if !db.Has(rootKeyFormat.Key(version)) {
+		return fmt.Errorf("version %d of the tree does not exist", version)
+	}
+
+	deleted := make(map[string]bool)
+
+	// delete the later roots along with the nodes created after the version
+	// they reference
+	var roots [][]byte
+	itr := dbm.IteratePrefix(db, rootKeyFormat.Key())
+	for ; itr.Valid(); itr.Next() {
+		var rootVersion int64
+		rootKeyFormat.Scan(itr.Key(), &rootVersion)
+		if rootVersion > version {
+			batch.Delete(itr.Key())
+			roots = append(roots, itr.Value())
+		}
+	}
+	itr.Close()
+
+	for _, root := range roots {
+		if err := deleteNodesAfter(db, batch, root, version, deleted); err != nil {
+			return err
+		}
+	}
+
+	// Orphans created after the version are no longer referenced at all, while
+	// orphans living up to the version or later are referenced by it again.
+	itr = dbm.IteratePrefix(db, orphanKeyFormat.Key())
+	for ; itr.Valid(); itr.Next() {
+		var toVersion, fromVersion int64
+		orphanKeyFormat.Scan(itr.Key(), &toVersion, &fromVersion)
+		switch {
+		case fromVersion > version:
+			batch.Delete(itr.Key())
+			batch.Delete(nodeKeyFormat.Key(itr.Value()))
+		case toVersion >= version:
+			batch.Delete(itr.Key())
+		}
+	}
+	itr.Close()
+
+	return nil
+}
+
+// deleteNodesAfter deletes the node with the given hash and its descendants
+// when they were created after the version. The descendants of a node are never
+// newer than the node itself, so the traversal stops at older nodes.
+func deleteNodesAfter(db dbm.DB, batch dbm.SetDeleter, hash []byte, version int64, deleted map[string]bool) error {
+	if len(hash) == 0 || deleted[string(hash)] {
+		return nil
+	}
+
+	// nodes of pruned versions are already deleted
+	bz := db.Get(nodeKeyFormat.Key(hash))
+	if bz == nil {
+		return nil
+	}
+
+	nodeVersion, children, err := decodeNode(bz)
+	if err != nil {
+		return err
+	}
+	if nodeVersion <= version {
+		return nil
+	}
+
+	batch.Delete(nodeKeyFormat.Key(hash))
+	deleted[string(hash)] = true
+
+	for _, child := range children {
+		if err := deleteNodesAfter(db, batch, child, version, deleted); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// decodeNode returns the version and the hashes of the children of a node
+// encoded as in iavl.MakeNode
+func decodeNode(bz []byte) (version int64, children [][]byte, err error) {
+	height, n, err := amino.DecodeInt8(bz)
+	if err != nil {
+		return 0, nil, fmt.Errorf("decoding node height: %v", err)
+	}
+	bz = bz[n:]
+
+	// size
+	_, n, err = amino.DecodeVarint(bz)
+	if err != nil {
+		return 0, nil, fmt.Errorf("decoding node size: %v", err)
+	}
+	bz = bz[n:]
+
+	version, n, err = amino.DecodeVarint(bz)
+	if err != nil {
+		return 0, nil, fmt.Errorf("decoding node version: %v", err)
+	}
+	bz = bz[n:]
+
+	// key
+	_, n, err = amino.DecodeByteSlice(bz)
+	if err != nil {
+		return 0, nil, fmt.Errorf("decoding node key: %v", err)
+	}
+	bz = bz[n:]
+
+	// leaves hold a value instead of children
+	if height == 0 {
+		return version, nil, nil
+	}
+
+	for i := 0; i < 2; i++ {
+		child, n, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return 0, nil, fmt.Errorf("decoding node child: %v", err)
+		}
+		bz = bz[n:]
+		children = append(children, child)
+	}
+	return version, children, nil
+}
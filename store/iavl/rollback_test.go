@@ -0,0 +1,71 @@
+package iavl
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/iavl"
+	dbm "github.com/tendermint/tendermint/libs/db"
+)
+
+func TestDeleteVersionsAfter(t *testing.T) {
+	db := dbm.NewMemDB()
+	tree := iavl.NewMutableTree(db, cacheSize)
+	saveVersion := func(kvs ...string) {
+		for i := 0; i < len(kvs); i += 2 {
+			tree.Set([]byte(kvs[i]), []byte(kvs[i+1]))
+		}
+		_, _, err := tree.SaveVersion()
+		require.NoError(t, err)
+	}
+
+	saveVersion("a", "1", "b", "1", "c", "1")
+	saveVersion("a", "2")
+	nodes := countKeys(db, nodeKeyFormat.Key())
+	saveVersion("b", "3")
+	saveVersion("c", "4", "d", "4")
+
+	batch := db.NewBatch()
+	require.Error(t, DeleteVersionsAfter(db, batch, 5))
+	require.NoError(t, DeleteVersionsAfter(db, batch, 2))
+
+	// nothing is deleted until the batch is written, then the nodes created
+	// after version 2 are
+	require.True(t, db.Has(rootKeyFormat.Key(int64(4))))
+	batch.Write()
+	require.Equal(t, nodes, countKeys(db, nodeKeyFormat.Key()))
+
+	tree = iavl.NewMutableTree(db, cacheSize)
+	latest, err := tree.Load()
+	require.NoError(t, err)
+	require.Equal(t, int64(2), latest)
+	require.False(t, tree.VersionExists(3))
+
+	// pruning the versions before and after version 2 keeps the nodes it
+	// shares with the new latest version
+	saveVersion("e", "3")
+	require.NoError(t, tree.DeleteVersion(1))
+	require.NoError(t, tree.DeleteVersion(2))
+
+	tree = iavl.NewMutableTree(db, cacheSize)
+	latest, err = tree.Load()
+	require.NoError(t, err)
+	require.Equal(t, int64(3), latest)
+	expected := map[string]string{"a": "2", "b": "1", "c": "1", "d": "", "e": "3"}
+	for key, value := range expected {
+		_, got := tree.Get([]byte(key))
+		require.Equal(t, value, string(got), key)
+	}
+}
+
+func countKeys(db dbm.DB, prefix []byte) int {
+	itr := dbm.IteratePrefix(db, prefix)
+	defer itr.Close()
+
+	count := 0
+	for ; itr.Valid(); itr.Next() {
+		count++
+	}
+	return count
+}
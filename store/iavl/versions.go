@@ -0,0 +1,30 @@
+package iavl
+
+import (
+	"github.com/tendermint/iavl"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	dbm "github.com/tendermint/tendermint/libs/db"
+)
+
+// Key formats the IAVL tree stores its nodes, orphans and roots with, see
+// github.com/tendermint/iavl/nodedb.go
+var (
+	nodeKeyFormat   = iavl.NewKeyFormat('n', tmhash.Size)       // n<hash>
+	orphanKeyFormat = iavl.NewKeyFormat('o', 8, 8, tmhash.Size) // o<last-version><first-version><hash>
+	rootKeyFormat   = iavl.NewKeyFormat('r', 8)                 // r<version>
+)
+
+// StoredVersions returns the versions of the IAVL tree stored in db, which
+// pruning did not delete, in ascending order
+func StoredVersions(db dbm.DB) []int64 {
+	versions := []int64{}
+	itr := dbm.IteratePrefix(db, rootKeyFormat.Key())
+	defer itr.Close()
+
+	for ; itr.Valid(); itr.Next() {
+		var version int64
+		rootKeyFormat.Scan(itr.Key(), &version)
+		versions = append(versions, version)
+	}
+	return versions
+}
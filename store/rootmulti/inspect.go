@@ -0,0 +1,88 @@
+package rootmulti
+
+import (
+	"fmt"
+	"sort"
+
+	cmn "github.com/tendermint/tendermint/libs/common"
+	dbm "github.com/tendermint/tendermint/libs/db"
+
+	"github.com/PhenixChain/PhenixChain/store/iavl"
+	"github.com/PhenixChain/PhenixChain/store/types"
+)
+
+// The functions below read the state a multistore committed to a database
+// without knowing the stores it mounts, e.g. to inspect it for debugging. The
+// stores are found in the commit info of each version.
+
+// StoreCommit is the commit of a store in a version of a multistore
+type StoreCommit struct {
+	Name    string       `json:"name"`
+	Version int64        `json:"version"`
+	Hash    cmn.HexBytes `json:"hash"`
+}
+
+// Commit is a version of a multistore along with the commits of its stores
+// sorted by name. Its hash is the app hash of the version.
+type Commit struct {
+	Version int64         `json:"version"`
+	Hash    cmn.HexBytes  `json:"hash"`
+	Stores  []StoreCommit `json:"stores"`
+}
+
+// GetLatestVersion returns the latest version a multistore committed to db
+func GetLatestVersion(db dbm.DB) int64 {
+	return getLatestVersion(db)
+}
+
+// GetCommit returns a version a multistore committed to db
+func GetCommit(db dbm.DB, ver int64) (Commit, error) {
+	cInfo, err := getCommitInfo(db, ver)
+	if err != nil {
+		return Commit{}, fmt.Errorf("no commit info for version %d: %v", ver, err)
+	}
+
+	commit := Commit{
+		Version: ver,
+		Hash:    cInfo.Hash(),
+		Stores:  make([]StoreCommit, 0, len(cInfo.StoreInfos)),
+	}
+	for _, info := range cInfo.StoreInfos {
+		commit.Stores = append(commit.Stores, StoreCommit{
+			Name:    info.Name,
+			Version: info.Core.CommitID.Version,
+			Hash:    info.Core.CommitID.Hash,
+		})
+	}
+	sort.Slice(commit.Stores, func(i, j int) bool {
+		return commit.Stores[i].Name < commit.Stores[j].Name
+	})
+	return commit, nil
+}
+
+// GetStoredVersions returns the versions of an IAVL store of a multistore
+// kept in db, which pruning did not delete, in ascending order
+func GetStoredVersions(db dbm.DB, name string) []int64 {
+	return iavl.StoredVersions(substoreDB(db, name))
+}
+
+// LoadStoresAtVersion returns the stores a multistore committed in a version
+// to db by name, loaded at that version. The stores are expected to be IAVL
+// stores and must only be read.
+func LoadStoresAtVersion(db dbm.DB, ver int64) (map[string]types.KVStore, error) {
+	commit, err := GetCommit(db, ver)
+	if err != nil {
+		return nil, err
+	}
+
+	stores := make(map[string]types.KVStore, len(commit.Stores))
+	for _, info := range commit.Stores {
+		id := types.CommitID{Version: info.Version, Hash: info.Hash}
+		store, err := iavl.LoadStore(substoreDB(db, info.Name), id, types.PruneNothing)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load version %d of store %s, it may be pruned: %v", ver, info.Name, err)
+		}
+		stores[info.Name] = store.(types.KVStore)
+	}
+	return stores, nil
+}
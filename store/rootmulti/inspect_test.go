@@ -0,0 +1,68 @@
+package rootmulti
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tendermint/libs/db"
+
+	"github.com/PhenixChain/PhenixChain/store/types"
+)
+
+func TestInspectCommittedState(t *testing.T) {
+	db := dbm.NewMemDB()
+	store := newMultiStoreWithMounts(db)
+	store.SetPruning(types.PruneNothing)
+	err := store.LoadLatestVersion()
+	require.Nil(t, err)
+
+	// Make a few commits writing to a store.
+	key := []byte("key")
+	commitIDs := make([]types.CommitID, 3)
+	for i := range commitIDs {
+		store.GetKVStore(store.keysByName["store1"]).Set(key, []byte{byte(i)})
+		commitIDs[i] = store.Commit()
+	}
+	require.Equal(t, int64(3), GetLatestVersion(db))
+
+	// The commit matches the app hash and lists the stores by name.
+	commit, err := GetCommit(db, 2)
+	require.Nil(t, err)
+	require.Equal(t, int64(2), commit.Version)
+	require.Equal(t, commitIDs[1].Hash, []byte(commit.Hash))
+	require.Len(t, commit.Stores, 3)
+	for i, name := range []string{"store1", "store2", "store3"} {
+		require.Equal(t, name, commit.Stores[i].Name)
+		require.Equal(t, int64(2), commit.Stores[i].Version)
+	}
+	_, err = GetCommit(db, 4)
+	require.NotNil(t, err)
+
+	require.Equal(t, []int64{1, 2, 3}, GetStoredVersions(db, "store1"))
+
+	// Stores load at the version with the values committed then.
+	stores, err := LoadStoresAtVersion(db, 2)
+	require.Nil(t, err)
+	require.Len(t, stores, 3)
+	require.Equal(t, []byte{1}, stores["store1"].Get(key))
+	require.Nil(t, stores["store2"].Get(key))
+}
+
+func TestInspectPrunedState(t *testing.T) {
+	db := dbm.NewMemDB()
+	store := newMultiStoreWithMounts(db)
+	store.SetPruning(types.PruneEverything)
+	err := store.LoadLatestVersion()
+	require.Nil(t, err)
+
+	for i := 0; i < 3; i++ {
+		store.Commit()
+	}
+
+	// The commit info of pruned versions is kept but not their stores.
+	require.Equal(t, []int64{3}, GetStoredVersions(db, "store1"))
+	_, err = GetCommit(db, 1)
+	require.Nil(t, err)
+	_, err = LoadStoresAtVersion(db, 1)
+	require.NotNil(t, err)
+}
@@ -3,8 +3,10 @@ package rootmulti
 import (
 	"fmt"
 	"io"
+	"sort"
 	"strings"
 
+	gokitmetrics "github.com/go-kit/kit/metrics"
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/crypto/merkle"
 	"github.com/tendermint/tendermint/crypto/tmhash"
@@ -17,6 +19,7 @@ import (
 	"github.com/PhenixChain/PhenixChain/store/tracekv"
 	"github.com/PhenixChain/PhenixChain/store/transient"
 	"github.com/PhenixChain/PhenixChain/store/types"
+	"github.com/PhenixChain/PhenixChain/telemetry"
 )
 
 const (
@@ -37,6 +40,8 @@ type Store struct {
 
 	traceWriter  io.Writer
 	traceContext types.TraceContext
+
+	metrics *telemetry.Metrics
 }
 
 var _ types.CommitMultiStore = (*Store)(nil)
@@ -49,9 +54,16 @@ func NewStore(db dbm.DB) *Store {
 		storesParams: make(map[types.StoreKey]storeParams),
 		stores:       make(map[types.StoreKey]types.CommitStore),
 		keysByName:   make(map[string]types.StoreKey),
+		metrics:      telemetry.NopMetrics(),
 	}
 }
 
+// SetMetrics sets the metrics the commit durations of the stores are
+// reported to.
+func (rs *Store) SetMetrics(metrics *telemetry.Metrics) {
+	rs.metrics = metrics
+}
+
 // Implements CommitMultiStore
 func (rs *Store) SetPruning(pruningOpts types.PruningOptions) {
 	rs.pruningOpts = pruningOpts
@@ -153,6 +165,76 @@ func (rs *Store) LoadVersion(ver int64) error {
 	return nil
 }
 
+// Implements CommitMultiStore.
+func (rs *Store) RollbackToVersion(ver int64) error {
+	latest := getLatestVersion(rs.db)
+	if ver < 1 || ver >= latest {
+		return fmt.Errorf("cannot roll back to version %d, expected a version from 1 to %d", ver, latest-1)
+	}
+	if _, err := getCommitInfo(rs.db, ver); err != nil {
+		return err
+	}
+
+	// Check that every IAVL store still holds the version before deleting
+	// anything, so that a failed rollback leaves the store as it was.
+	if err := rs.LoadLatestVersion(); err != nil {
+		return err
+	}
+	for key, store := range rs.stores {
+		iavlStore, ok := store.(*iavl.Store)
+		if ok && !iavlStore.VersionExists(ver) {
+			return fmt.Errorf("cannot roll back to version %d, store %s pruned it", ver, key.Name())
+		}
+		if params := rs.storesParams[key]; ok && params.db != nil && params.db != rs.db {
+			return fmt.Errorf("cannot roll back store %s, it is kept in a database of its own", key.Name())
+		}
+	}
+
+	// Need to update atomically: the later versions of every store are
+	// deleted in a single batch along with their commit info.
+	batch := rs.db.NewBatch()
+	defer batch.Close()
+
+	names := make([]string, 0, len(rs.keysByName))
+	for name := range rs.keysByName {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		params := rs.storesParams[rs.keysByName[name]]
+		if params.typ != types.StoreTypeIAVL {
+			continue
+		}
+		storeBatch := prefixSetDeleter{prefix: rs.storePrefix(params), batch: batch}
+		if err := iavl.DeleteVersionsAfter(rs.storeDB(params), storeBatch, ver); err != nil {
+			return fmt.Errorf("failed to roll back store %s: %v", name, err)
+		}
+	}
+
+	for v := ver + 1; v <= latest; v++ {
+		batch.Delete([]byte(fmt.Sprintf(commitInfoKeyFmt, v)))
+	}
+	setLatestVersion(batch, ver)
+	batch.Write()
+
+	return rs.LoadVersion(ver)
+}
+
+// prefixSetDeleter sets and deletes the keys of a prefixed database in a
+// batch of the database it prefixes
+type prefixSetDeleter struct {
+	prefix []byte
+	batch  dbm.SetDeleter
+}
+
+func (p prefixSetDeleter) Set(key, value []byte) {
+	p.batch.Set(append(append([]byte{}, p.prefix...), key...), value)
+}
+
+func (p prefixSetDeleter) Delete(key []byte) {
+	p.batch.Delete(append(append([]byte{}, p.prefix...), key...))
+}
+
 // SetTracer sets the tracer for the MultiStore that the underlying
 // stores will utilize to trace operations. A MultiStore is returned.
 func (rs *Store) SetTracer(w io.Writer) types.MultiStore {
@@ -194,7 +276,7 @@ func (rs *Store) Commit() types.CommitID {
 
 	// Commit stores.
 	version := rs.lastCommitID.Version + 1
-	commitInfo := commitStores(version, rs.stores)
+	commitInfo := commitStores(version, rs.stores, rs.metrics)
 
 	// Need to update atomically.
 	batch := rs.db.NewBatch()
@@ -346,13 +428,34 @@ func parsePath(path string) (storeName string, subpath string, err errors.Error)
 
 //----------------------------------------
 
-func (rs *Store) loadCommitStoreFromParams(key types.StoreKey, id types.CommitID, params storeParams) (store types.CommitStore, err error) {
-	var db dbm.DB
+// storeDB returns the database a mounted store is kept in
+func (rs *Store) storeDB(params storeParams) dbm.DB {
 	if params.db != nil {
-		db = dbm.NewPrefixDB(params.db, []byte("s/_/"))
-	} else {
-		db = dbm.NewPrefixDB(rs.db, []byte("s/k:"+params.key.Name()+"/"))
+		return dbm.NewPrefixDB(params.db, rs.storePrefix(params))
 	}
+	return substoreDB(rs.db, params.key.Name())
+}
+
+// storePrefix returns the prefix of the keys of a store in its database
+func (rs *Store) storePrefix(params storeParams) []byte {
+	if params.db != nil {
+		return []byte("s/_/")
+	}
+	return substorePrefix(params.key.Name())
+}
+
+// substoreDB returns the database of a store kept in the database of the
+// multistore
+func substoreDB(db dbm.DB, name string) dbm.DB {
+	return dbm.NewPrefixDB(db, substorePrefix(name))
+}
+
+func substorePrefix(name string) []byte {
+	return []byte("s/k:" + name + "/")
+}
+
+func (rs *Store) loadCommitStoreFromParams(key types.StoreKey, id types.CommitID, params storeParams) (store types.CommitStore, err error) {
+	db := rs.storeDB(params)
 	switch params.typ {
 	case types.StoreTypeMulti:
 		panic("recursive MultiStores not yet supported")
@@ -484,12 +587,14 @@ func setLatestVersion(batch dbm.Batch, version int64) {
 }
 
 // Commits each store and returns a new commitInfo.
-func commitStores(version int64, storeMap map[types.StoreKey]types.CommitStore) commitInfo {
+func commitStores(version int64, storeMap map[types.StoreKey]types.CommitStore, metrics *telemetry.Metrics) commitInfo {
 	storeInfos := make([]storeInfo, 0, len(storeMap))
 
 	for key, store := range storeMap {
 		// Commit
+		timer := gokitmetrics.NewTimer(metrics.StoreCommitSeconds.With("store", key.Name()))
 		commitID := store.Commit()
+		timer.ObserveDuration()
 
 		if store.GetStoreType() == types.StoreTypeTransient {
 			continue
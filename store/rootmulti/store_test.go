@@ -100,6 +100,129 @@ func TestMultistoreCommitLoad(t *testing.T) {
 	checkStore(t, store, commitID, commitID)
 }
 
+func TestMultistoreRollback(t *testing.T) {
+	db := dbm.NewMemDB()
+	store := newMultiStoreWithMounts(db)
+	err := store.LoadLatestVersion()
+	require.Nil(t, err)
+
+	// Make a few commits writing to a store.
+	key := []byte("key")
+	commitIDs := make([]types.CommitID, 5)
+	for i := range commitIDs {
+		store.GetKVStore(store.keysByName["store1"]).Set(key, []byte{byte(i)})
+		commitIDs[i] = store.Commit()
+	}
+
+	// Only earlier versions can be rolled back to.
+	require.Error(t, store.RollbackToVersion(0))
+	require.Error(t, store.RollbackToVersion(5))
+
+	// Roll back and check the version and the value.
+	err = store.RollbackToVersion(3)
+	require.Nil(t, err)
+	checkStore(t, store, commitIDs[2], store.LastCommitID())
+	require.Equal(t, []byte{2}, store.GetKVStore(store.keysByName["store1"]).Get(key))
+	require.Equal(t, int64(3), getLatestVersion(db))
+	_, err = getCommitInfo(db, 4)
+	require.NotNil(t, err)
+
+	// The next commit follows the version rolled back to.
+	store.GetKVStore(store.keysByName["store1"]).Set(key, []byte{9})
+	commitID := store.Commit()
+	require.Equal(t, int64(4), commitID.Version)
+	require.NotEqual(t, commitIDs[3], commitID)
+
+	// Load the latest multistore again and check the version.
+	store = newMultiStoreWithMounts(db)
+	err = store.LoadLatestVersion()
+	require.Nil(t, err)
+	checkStore(t, store, commitID, store.LastCommitID())
+	require.Equal(t, []byte{9}, store.GetKVStore(store.keysByName["store1"]).Get(key))
+}
+
+func TestMultistoreRollbackPruned(t *testing.T) {
+	db := dbm.NewMemDB()
+	store := newMultiStoreWithMounts(db)
+	store.SetPruning(types.PruneEverything)
+	err := store.LoadLatestVersion()
+	require.Nil(t, err)
+
+	for i := 0; i < 3; i++ {
+		store.Commit()
+	}
+	commitID := store.LastCommitID()
+
+	// Version 1 is pruned, so the store is left as it was.
+	require.Error(t, store.RollbackToVersion(1))
+	require.Equal(t, int64(3), getLatestVersion(db))
+	checkStore(t, store, commitID, store.LastCommitID())
+}
+
+func TestMultistoreRollbackFailure(t *testing.T) {
+	db := dbm.NewMemDB()
+	store := newMultiStoreWithMounts(db)
+	err := store.LoadLatestVersion()
+	require.Nil(t, err)
+
+	// Write several keys to every store, so that their trees have inner nodes.
+	commitIDs := make([]types.CommitID, 5)
+	for i := range commitIDs {
+		for _, name := range []string{"store1", "store2", "store3"} {
+			for _, key := range []string{"a", "b", "c"} {
+				store.GetKVStore(store.keysByName[name]).Set([]byte(key), []byte{byte(i)})
+			}
+		}
+		commitIDs[i] = store.Commit()
+	}
+
+	// Corrupt the nodes of the last store below its roots, which the rollback
+	// only reads once the earlier stores are rolled back.
+	prefix := substorePrefix("store3")
+	roots := make(map[string]bool)
+	itr := dbm.IteratePrefix(db, append(append([]byte{}, prefix...), 'r'))
+	for ; itr.Valid(); itr.Next() {
+		roots[string(itr.Value())] = true
+	}
+	itr.Close()
+
+	nodePrefix := append(append([]byte{}, prefix...), 'n')
+	corrupted := make(map[string][]byte)
+	itr = dbm.IteratePrefix(db, nodePrefix)
+	for ; itr.Valid(); itr.Next() {
+		if !roots[string(itr.Key()[len(nodePrefix):])] {
+			corrupted[string(itr.Key())] = itr.Value()
+		}
+	}
+	itr.Close()
+	require.NotEmpty(t, corrupted)
+	for key := range corrupted {
+		db.Set([]byte(key), []byte{0})
+	}
+
+	// Nothing is rolled back.
+	require.Error(t, store.RollbackToVersion(3))
+	require.Equal(t, int64(5), getLatestVersion(db))
+	_, err = getCommitInfo(db, 5)
+	require.Nil(t, err)
+
+	for key, value := range corrupted {
+		db.Set([]byte(key), value)
+	}
+	store = newMultiStoreWithMounts(db)
+	err = store.LoadLatestVersion()
+	require.Nil(t, err)
+	checkStore(t, store, commitIDs[4], store.LastCommitID())
+	for _, name := range []string{"store1", "store2", "store3"} {
+		require.Equal(t, []byte{4}, store.GetKVStore(store.keysByName[name]).Get([]byte("a")))
+	}
+
+	// Once repaired, the rollback succeeds.
+	err = store.RollbackToVersion(3)
+	require.Nil(t, err)
+	checkStore(t, store, commitIDs[2], store.LastCommitID())
+}
+
 func TestParsePath(t *testing.T) {
 	_, _, err := parsePath("foo")
 	require.Error(t, err)
@@ -95,6 +95,12 @@ type CommitMultiStore interface {
 	// the next commit after loading must be idempotent (return the
 	// same commit id).  Otherwise the behavior is undefined.
 	LoadVersion(ver int64) error
+
+	// Delete all versions after a specific persisted version and load it, so
+	// that the next commit saves the version following it. The versions of all
+	// stores are deleted atomically, so it fails without deleting anything,
+	// e.g. when a store no longer holds the version.
+	RollbackToVersion(ver int64) error
 }
 
 //---------subsp-------------------------------
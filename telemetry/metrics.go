@@ -0,0 +1,174 @@
+package telemetry
+
+import (
+	"github.com/go-kit/kit/metrics"
+	"github.com/go-kit/kit/metrics/discard"
+
+	prometheus "github.com/go-kit/kit/metrics/prometheus"
+	stdprometheus "github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	// BaseAppSubsystem is the subsystem of the metrics of transaction and
+	// block processing.
+	BaseAppSubsystem = "baseapp"
+	// StoreSubsystem is the subsystem of the metrics of the multistore.
+	StoreSubsystem = "store"
+	// BankSubsystem is the subsystem of the metrics of the bank module.
+	BankSubsystem = "bank"
+	// GovSubsystem is the subsystem of the metrics of the gov module.
+	GovSubsystem = "gov"
+	// StakingSubsystem is the subsystem of the metrics of the staking module.
+	StakingSubsystem = "staking"
+)
+
+// Metrics contains the metrics exposed by the application and its modules.
+// Transactions are only measured when they are delivered, not when they are
+// checked or simulated, except for ante handler failures.
+type Metrics struct {
+	// Number of delivered transactions by the route of their messages. A
+	// transaction with messages of several routes is counted for each route.
+	Txs metrics.Counter
+	// Gas used by delivered transactions.
+	TxGasUsed metrics.Histogram
+	// Gas wanted by delivered transactions.
+	TxGasWanted metrics.Histogram
+	// Number of transactions rejected by the ante handler by mode, codespace
+	// and error code.
+	AnteFailures metrics.Counter
+
+	// Time spent in the BeginBlocker in seconds.
+	BeginBlockSeconds metrics.Histogram
+	// Time spent in the EndBlocker in seconds.
+	EndBlockSeconds metrics.Histogram
+	// Time spent committing a block in seconds.
+	CommitSeconds metrics.Histogram
+
+	// Time spent committing each store in seconds.
+	StoreCommitSeconds metrics.Histogram
+
+	// Amount of coins transferred by successful transactions by denomination.
+	BankTransferVolume metrics.Counter
+
+	// Number of proposals that entered each status. Only reported by apps
+	// mounting the gov module.
+	GovProposals metrics.Counter
+
+	// Ratio of bonded tokens to the total supply of staking tokens. Only
+	// reported by apps mounting the staking module.
+	StakingBondedRatio metrics.Gauge
+}
+
+// PrometheusMetrics returns Metrics build using Prometheus client library.
+// Optionally, labels can be provided along with their values ("foo",
+// "fooValue").
+func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
+	labels := []string{}
+	for i := 0; i < len(labelsAndValues); i += 2 {
+		labels = append(labels, labelsAndValues[i])
+	}
+	gasBuckets := stdprometheus.ExponentialBuckets(1000, 2, 12)
+	return &Metrics{
+		Txs: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: BaseAppSubsystem,
+			Name:      "txs",
+			Help:      "Number of delivered transactions by msg route.",
+		}, appendLabels(labels, "route")).With(labelsAndValues...),
+		TxGasUsed: prometheus.NewHistogramFrom(stdprometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: BaseAppSubsystem,
+			Name:      "tx_gas_used",
+			Help:      "Gas used by delivered transactions.",
+			Buckets:   gasBuckets,
+		}, labels).With(labelsAndValues...),
+		TxGasWanted: prometheus.NewHistogramFrom(stdprometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: BaseAppSubsystem,
+			Name:      "tx_gas_wanted",
+			Help:      "Gas wanted by delivered transactions.",
+			Buckets:   gasBuckets,
+		}, labels).With(labelsAndValues...),
+		AnteFailures: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: BaseAppSubsystem,
+			Name:      "ante_failures",
+			Help:      "Number of transactions rejected by the ante handler by mode and error code.",
+		}, appendLabels(labels, "mode", "codespace", "code")).With(labelsAndValues...),
+
+		BeginBlockSeconds: prometheus.NewHistogramFrom(stdprometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: BaseAppSubsystem,
+			Name:      "begin_block_seconds",
+			Help:      "Time spent in the BeginBlocker in seconds.",
+		}, labels).With(labelsAndValues...),
+		EndBlockSeconds: prometheus.NewHistogramFrom(stdprometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: BaseAppSubsystem,
+			Name:      "end_block_seconds",
+			Help:      "Time spent in the EndBlocker in seconds.",
+		}, labels).With(labelsAndValues...),
+		CommitSeconds: prometheus.NewHistogramFrom(stdprometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: BaseAppSubsystem,
+			Name:      "commit_seconds",
+			Help:      "Time spent committing a block in seconds.",
+		}, labels).With(labelsAndValues...),
+
+		StoreCommitSeconds: prometheus.NewHistogramFrom(stdprometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: StoreSubsystem,
+			Name:      "commit_seconds",
+			Help:      "Time spent committing each store in seconds.",
+		}, appendLabels(labels, "store")).With(labelsAndValues...),
+
+		BankTransferVolume: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: BankSubsystem,
+			Name:      "transfer_volume",
+			Help:      "Amount of coins transferred by denomination.",
+		}, appendLabels(labels, "denom")).With(labelsAndValues...),
+
+		GovProposals: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: GovSubsystem,
+			Name:      "proposals",
+			Help:      "Number of proposals that entered each status.",
+		}, appendLabels(labels, "status")).With(labelsAndValues...),
+
+		StakingBondedRatio: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: StakingSubsystem,
+			Name:      "bonded_ratio",
+			Help:      "Ratio of bonded tokens to the total supply of staking tokens.",
+		}, labels).With(labelsAndValues...),
+	}
+}
+
+// appendLabels returns a copy of labels with extra labels appended, so that the
+// label names of different metrics never share memory
+func appendLabels(labels []string, extra ...string) []string {
+	return append(append([]string{}, labels...), extra...)
+}
+
+// NopMetrics returns no-op Metrics.
+func NopMetrics() *Metrics {
+	return &Metrics{
+		Txs:          discard.NewCounter(),
+		TxGasUsed:    discard.NewHistogram(),
+		TxGasWanted:  discard.NewHistogram(),
+		AnteFailures: discard.NewCounter(),
+
+		BeginBlockSeconds: discard.NewHistogram(),
+		EndBlockSeconds:   discard.NewHistogram(),
+		CommitSeconds:     discard.NewHistogram(),
+
+		StoreCommitSeconds: discard.NewHistogram(),
+
+		BankTransferVolume: discard.NewCounter(),
+
+		GovProposals: discard.NewCounter(),
+
+		StakingBondedRatio: discard.NewGauge(),
+	}
+}
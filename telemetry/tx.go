@@ -0,0 +1,38 @@
+package telemetry
+
+import (
+	sdk "github.com/PhenixChain/PhenixChain/types"
+)
+
+// txMetricsKey is the context key of the metrics held back until the
+// transaction reporting them succeeded
+type txMetricsKey struct{}
+
+// WithTxMetrics returns a context in which the metrics reported with
+// RecordOnSuccess are held back, and the function reporting them. The caller
+// calls it once the transaction succeeded and its state is written, so the
+// metrics of failed transactions are dropped along with their state changes.
+func WithTxMetrics(ctx sdk.Context) (sdk.Context, func()) {
+	pending := new([]func())
+	flush := func() {
+		for _, record := range *pending {
+			record()
+		}
+		*pending = nil
+	}
+	return ctx.WithValue(txMetricsKey{}, pending), flush
+}
+
+// RecordOnSuccess reports metrics with record once the transaction of ctx
+// succeeded, or right away outside of transactions, e.g. in the EndBlocker.
+// Nothing is reported for transactions that are only checked or simulated.
+func RecordOnSuccess(ctx sdk.Context, record func()) {
+	if ctx.IsCheckTx() {
+		return
+	}
+	if pending, ok := ctx.Value(txMetricsKey{}).(*[]func()); ok {
+		*pending = append(*pending, record)
+		return
+	}
+	record()
+}
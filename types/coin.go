@@ -549,6 +549,11 @@ var (
 	reDecCoin   = regexp.MustCompile(fmt.Sprintf(`^(%s)%s(%s)$`, reDecAmt, reSpc, reDnmString))
 )
 
+// ValidateDenom returns an error if the denomination is invalid.
+func ValidateDenom(denom string) error {
+	return validateDenom(denom)
+}
+
 func validateDenom(denom string) error {
 	if !reDnm.MatchString(denom) {
 		return fmt.Errorf("invalid denom: %s", denom)
@@ -0,0 +1,43 @@
+package types
+
+import (
+	"encoding/json"
+
+	cmn "github.com/tendermint/tendermint/libs/common"
+
+	"github.com/PhenixChain/PhenixChain/codec"
+)
+
+// StoreDecoder decodes the value stored under a key of a module's store into
+// JSON, e.g. to inspect the raw state of a chain. It returns an error for keys
+// it doesn't know or values it cannot decode.
+type StoreDecoder func(cdc *codec.Codec, key, value []byte) (json.RawMessage, error)
+
+// DecodedKVPair is a key/value pair of a store with its value decoded into
+// JSON. The raw value is kept instead when it cannot be decoded, along with the
+// error of its decoder if any.
+type DecodedKVPair struct {
+	Key   cmn.HexBytes    `json:"key"`
+	Value json.RawMessage `json:"value,omitempty"`
+	Raw   cmn.HexBytes    `json:"raw,omitempty"`
+	Error string          `json:"error,omitempty"`
+}
+
+// DecodeKVPair decodes a key/value pair with the decoder of its store, which
+// may be nil for stores without one.
+func DecodeKVPair(cdc *codec.Codec, decoder StoreDecoder, key, value []byte) DecodedKVPair {
+	pair := DecodedKVPair{Key: key}
+	if decoder == nil {
+		pair.Raw = value
+		return pair
+	}
+
+	bz, err := decoder(cdc, key, value)
+	if err != nil {
+		pair.Raw = value
+		pair.Error = err.Error()
+		return pair
+	}
+	pair.Value = bz
+	return pair
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"strings"
 )
 
 // denomUnits contains a mapping of denomination mapped to their respective unit
@@ -62,3 +63,161 @@ func ConvertCoin(coin Coin, denom string) (Coin, error) {
 
 	return NewCoin(denom, coin.Amount.ToDec().Mul(srcUnit.Quo(dstUnit)).TruncateInt()), nil
 }
+
+// NativeDenom is the base denomination of the PhenixChain native token, shown
+// to users as pnx
+const NativeDenom = "upnx"
+
+// MaxDenomExponent is the largest exponent a display denomination may have,
+// bounded by the precision of Dec.
+const MaxDenomExponent = Precision
+
+// DenomMetadata describes how amounts of a base denomination, the one coins
+// are stored in, are shown to users (e.g. 1pnx = 10^6upnx).
+type DenomMetadata struct {
+	Base        string `json:"base"`        // denomination amounts are stored in
+	Display     string `json:"display"`     // denomination amounts are shown in
+	Exponent    uint8  `json:"exponent"`    // 1 display = 10^exponent base
+	Description string `json:"description"` // human readable description
+}
+
+// NewDenomMetadata returns a new DenomMetadata.
+func NewDenomMetadata(base, display string, exponent uint8, description string) DenomMetadata {
+	return DenomMetadata{
+		Base:        base,
+		Display:     display,
+		Exponent:    exponent,
+		Description: description,
+	}
+}
+
+// Validate performs basic validation of the denomination metadata.
+func (m DenomMetadata) Validate() error {
+	if err := validateDenom(m.Base); err != nil {
+		return err
+	}
+	if err := validateDenom(m.Display); err != nil {
+		return err
+	}
+	if m.Base == m.Display && m.Exponent != 0 {
+		return fmt.Errorf("denom %s cannot be displayed in itself with exponent %d", m.Base, m.Exponent)
+	}
+	if m.Exponent > MaxDenomExponent {
+		return fmt.Errorf("denom %s exponent %d is greater than %d", m.Display, m.Exponent, MaxDenomExponent)
+	}
+	return nil
+}
+
+// String implements the Stringer interface.
+func (m DenomMetadata) String() string {
+	return fmt.Sprintf(`Denom Metadata:
+  Base:        %s
+  Display:     %s
+  Exponent:    %d
+  Description: %s`, m.Base, m.Display, m.Exponent, m.Description)
+}
+
+// ValidateDenomMetadata validates a list of denomination metadata, rejecting
+// base or display denominations that are declared more than once.
+func ValidateDenomMetadata(metadata []DenomMetadata) error {
+	seen := make(map[string]bool)
+	for _, m := range metadata {
+		if err := m.Validate(); err != nil {
+			return err
+		}
+
+		if seen[m.Base] {
+			return fmt.Errorf("duplicate denom metadata for %s", m.Base)
+		}
+		seen[m.Base] = true
+
+		if m.Display != m.Base {
+			if seen[m.Display] {
+				return fmt.Errorf("duplicate denom metadata for %s", m.Display)
+			}
+			seen[m.Display] = true
+		}
+	}
+	return nil
+}
+
+// ParseCoinsWithMetadata parses a comma separated list of coins like
+// ParseCoins, but also accepts amounts in a display denomination, possibly
+// with a fractional part (e.g. 1.5pnx), which are converted to the base
+// denomination. Returned coins are sorted.
+func ParseCoinsWithMetadata(coinsStr string, metadata []DenomMetadata) (Coins, error) {
+	coinsStr = strings.TrimSpace(coinsStr)
+	if len(coinsStr) == 0 {
+		return nil, nil
+	}
+
+	coins := NewCoins()
+	for _, coinStr := range strings.Split(coinsStr, ",") {
+		coin, err := parseCoinWithMetadata(coinStr, metadata)
+		if err != nil {
+			return nil, err
+		}
+
+		coins = coins.Add(Coins{coin})
+	}
+
+	if !coins.IsValid() {
+		return nil, fmt.Errorf("parsed coins are invalid: %s", coinsStr)
+	}
+
+	return coins, nil
+}
+
+func parseCoinWithMetadata(coinStr string, metadata []DenomMetadata) (Coin, error) {
+	coinStr = strings.TrimSpace(coinStr)
+
+	matches := reCoin.FindStringSubmatch(coinStr)
+	if matches == nil {
+		matches = reDecCoin.FindStringSubmatch(coinStr)
+	}
+	if matches == nil {
+		return Coin{}, fmt.Errorf("invalid coin expression: %s", coinStr)
+	}
+
+	amount, err := NewDecFromStr(matches[1])
+	if err != nil {
+		return Coin{}, err
+	}
+
+	denom := matches[2]
+	for _, m := range metadata {
+		if m.Display == denom {
+			amount = amount.MulInt(NewIntWithDecimal(1, int(m.Exponent)))
+			denom = m.Base
+			break
+		}
+	}
+
+	if !amount.IsPositive() {
+		return Coin{}, fmt.Errorf("amount must be positive: %s", coinStr)
+	}
+	if !amount.IsInteger() {
+		return Coin{}, fmt.Errorf("amount %s is not a whole number of %s", coinStr, denom)
+	}
+
+	return NewCoin(denom, amount.TruncateInt()), nil
+}
+
+// ConvertCoinsToDisplay converts coins in a base denomination to their display
+// denomination. Coins without metadata are returned unchanged.
+func ConvertCoinsToDisplay(coins Coins, metadata []DenomMetadata) DecCoins {
+	res := make(DecCoins, 0, len(coins))
+	for _, coin := range coins {
+		decCoin := NewDecCoinFromCoin(coin)
+		for _, m := range metadata {
+			if m.Base == coin.Denom {
+				decCoin = NewDecCoinFromDec(m.Display, NewDecFromIntWithPrec(coin.Amount, int64(m.Exponent)))
+				break
+			}
+		}
+
+		res = append(res, decCoin)
+	}
+
+	return res.Sort()
+}
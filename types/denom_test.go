@@ -81,3 +81,56 @@ func TestConvertCoins(t *testing.T) {
 	// reset registration
 	denomUnits = map[string]Dec{}
 }
+
+func TestParseCoinsWithMetadata(t *testing.T) {
+	metadata := []DenomMetadata{
+		NewDenomMetadata("upnx", "pnx", 6, "PhenixChain native token"),
+		NewDenomMetadata("mfan", "fan", 3, "fan token"),
+	}
+	require.NoError(t, ValidateDenomMetadata(metadata))
+
+	testCases := []struct {
+		input    string
+		expected Coins
+		valid    bool
+	}{
+		{"", nil, true},
+		{"1.5pnx", NewCoins(NewInt64Coin("upnx", 1500000)), true},
+		{"2pnx", NewCoins(NewInt64Coin("upnx", 2000000)), true},
+		{"10upnx", NewCoins(NewInt64Coin("upnx", 10)), true},
+		{"0.000001pnx,1.25fan", NewCoins(NewInt64Coin("upnx", 1), NewInt64Coin("mfan", 1250)), true},
+		{"1pnx,5upnx", NewCoins(NewInt64Coin("upnx", 1000005)), true},
+		{"0.0000001pnx", nil, false}, // below the base unit
+		{"1.5upnx", nil, false},      // base units are whole numbers
+		{"0pnx", nil, false},
+		{"1.5", nil, false},
+	}
+
+	for _, tc := range testCases {
+		res, err := ParseCoinsWithMetadata(tc.input, metadata)
+		if !tc.valid {
+			require.Error(t, err, tc.input)
+			continue
+		}
+		require.NoError(t, err, tc.input)
+		require.True(t, tc.expected.IsEqual(res), "%s: expected %s, got %s", tc.input, tc.expected, res)
+	}
+
+	display := ConvertCoinsToDisplay(NewCoins(NewInt64Coin("upnx", 1500000), NewInt64Coin("atom", 3)), metadata)
+	require.Equal(t, DecCoins{
+		NewDecCoin("atom", NewInt(3)),
+		NewDecCoinFromDec("pnx", NewDecWithPrec(15, 1)),
+	}, display)
+}
+
+func TestValidateDenomMetadata(t *testing.T) {
+	require.NoError(t, ValidateDenomMetadata(nil))
+	require.NoError(t, ValidateDenomMetadata([]DenomMetadata{NewDenomMetadata("pnx", "pnx", 0, "")}))
+	require.Error(t, ValidateDenomMetadata([]DenomMetadata{NewDenomMetadata("pnx", "pnx", 6, "")}))
+	require.Error(t, ValidateDenomMetadata([]DenomMetadata{NewDenomMetadata("upnx", "pnx", 19, "")}))
+	require.Error(t, ValidateDenomMetadata([]DenomMetadata{NewDenomMetadata("upnx", "PNX", 6, "")}))
+	require.Error(t, ValidateDenomMetadata([]DenomMetadata{
+		NewDenomMetadata("upnx", "pnx", 6, ""),
+		NewDenomMetadata("npnx", "pnx", 9, ""),
+	}))
+}
@@ -16,6 +16,12 @@ func TestFromInt64(t *testing.T) {
 	}
 }
 
+func TestIntIsNil(t *testing.T) {
+	require.True(t, Int{}.IsNil())
+	require.False(t, ZeroInt().IsNil())
+	require.False(t, NewInt(1).IsNil())
+}
+
 func TestIntPanic(t *testing.T) {
 	// Max Int = 2^255-1 = 5.789e+76
 	// Min Int = -(2^255-1) = -5.789e+76
@@ -242,15 +242,22 @@ func ParseHTTPArgs(r *http.Request) (tags []string, page, limit int, err error)
 		tags = append(tags, tag)
 	}
 
+	page, limit, err = ParseHTTPPagination(r)
+	return tags, page, limit, err
+}
+
+// ParseHTTPPagination parses the page and limit of the request's URL, which
+// default to DefaultPage and DefaultLimit.
+func ParseHTTPPagination(r *http.Request) (page, limit int, err error) {
 	pageStr := r.FormValue("page")
 	if pageStr == "" {
 		page = DefaultPage
 	} else {
 		page, err = strconv.Atoi(pageStr)
 		if err != nil {
-			return tags, page, limit, err
+			return page, limit, err
 		} else if page <= 0 {
-			return tags, page, limit, errors.New("page must greater than 0")
+			return page, limit, errors.New("page must greater than 0")
 		}
 	}
 
@@ -260,11 +267,11 @@ func ParseHTTPArgs(r *http.Request) (tags []string, page, limit int, err error)
 	} else {
 		limit, err = strconv.Atoi(limitStr)
 		if err != nil {
-			return tags, page, limit, err
+			return page, limit, err
 		} else if limit <= 0 {
-			return tags, page, limit, errors.New("limit must greater than 0")
+			return page, limit, errors.New("limit must greater than 0")
 		}
 	}
 
-	return tags, page, limit, nil
+	return page, limit, nil
 }
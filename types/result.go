@@ -1,9 +1,11 @@
 package types
 
 import (
+	"bytes"
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"sort"
 	"strings"
 
 	ctypes "github.com/tendermint/tendermint/rpc/core/types"
@@ -233,6 +235,14 @@ func (r TxResponse) String() string {
 		sb.WriteString(fmt.Sprintf("  Tags: \n%s\n", r.Tags.String()))
 	}
 
+	if r.Tx != nil && len(r.Tx.GetMsgs()) > 0 {
+		sb.WriteString("  Messages:\n")
+		for _, msg := range r.Tx.GetMsgs() {
+			sb.WriteString(fmt.Sprintf("    - %s/%s\n", msg.Route(), msg.Type()))
+			writeMsgFields(&sb, msg, "      ")
+		}
+	}
+
 	if r.Codespace != "" {
 		sb.WriteString(fmt.Sprintf("  Codespace: %s\n", r.Codespace))
 	}
@@ -249,6 +259,163 @@ func (r TxResponse) Empty() bool {
 	return r.TxHash == "" && r.Logs == nil
 }
 
+// writeMsgFields writes the fields of a message as signed, one per line, with
+// coins and nested Amino values rendered compactly
+func writeMsgFields(sb *strings.Builder, msg Msg, indent string) {
+	dec := json.NewDecoder(bytes.NewReader(msg.GetSignBytes()))
+	dec.UseNumber()
+
+	var v interface{}
+	if err := dec.Decode(&v); err != nil {
+		sb.WriteString(fmt.Sprintf("%s%s\n", indent, msg.GetSignBytes()))
+		return
+	}
+
+	fields, ok := unwrapAminoValue(v).(map[string]interface{})
+	if !ok {
+		sb.WriteString(fmt.Sprintf("%s%s\n", indent, formatMsgValue(v)))
+		return
+	}
+	writeMsgObject(sb, fields, indent)
+}
+
+func writeMsgObject(sb *strings.Builder, fields map[string]interface{}, indent string) {
+	keys := make([]string, 0, len(fields))
+	for key := range fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		switch v := unwrapAminoValue(fields[key]).(type) {
+		case map[string]interface{}:
+			if _, ok := coinString(v); ok {
+				sb.WriteString(fmt.Sprintf("%s%s: %s\n", indent, key, formatMsgValue(v)))
+				continue
+			}
+			sb.WriteString(fmt.Sprintf("%s%s:\n", indent, key))
+			writeMsgObject(sb, v, indent+"  ")
+
+		case []interface{}:
+			if !hasObjects(v) {
+				sb.WriteString(fmt.Sprintf("%s%s: %s\n", indent, key, formatMsgValue(v)))
+				continue
+			}
+			sb.WriteString(fmt.Sprintf("%s%s:\n", indent, key))
+			for _, elem := range v {
+				sb.WriteString(fmt.Sprintf("%s  -\n", indent))
+				if obj, ok := unwrapAminoValue(elem).(map[string]interface{}); ok {
+					writeMsgObject(sb, obj, indent+"    ")
+				} else {
+					sb.WriteString(fmt.Sprintf("%s    %s\n", indent, formatMsgValue(elem)))
+				}
+			}
+
+		default:
+			sb.WriteString(fmt.Sprintf("%s%s: %s\n", indent, key, formatMsgValue(v)))
+		}
+	}
+}
+
+// formatMsgValue formats a scalar, a coin or a list of them on one line
+func formatMsgValue(v interface{}) string {
+	switch v := unwrapAminoValue(v).(type) {
+	case nil:
+		return "-"
+	case map[string]interface{}:
+		if coin, ok := coinString(v); ok {
+			return coin
+		}
+		bz, _ := json.Marshal(v)
+		return string(bz)
+	case []interface{}:
+		elems := make([]string, len(v))
+		for i, elem := range v {
+			elems[i] = formatMsgValue(elem)
+		}
+		return strings.Join(elems, ", ")
+	default:
+		return fmt.Sprintf("%v", v)
+	}
+}
+
+// hasObjects returns whether a list holds objects other than coins
+func hasObjects(list []interface{}) bool {
+	for _, elem := range list {
+		if obj, ok := unwrapAminoValue(elem).(map[string]interface{}); ok {
+			if _, ok := coinString(obj); !ok {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// coinString formats a coin or a decimal coin as e.g. 10stake
+func coinString(obj map[string]interface{}) (string, bool) {
+	denom, ok := obj["denom"].(string)
+	if !ok || len(obj) != 2 || obj["amount"] == nil {
+		return "", false
+	}
+	return fmt.Sprintf("%v%s", obj["amount"], denom), true
+}
+
+// unwrapAminoValue returns the value of an interface value encoded by Amino
+// JSON along with its type
+func unwrapAminoValue(v interface{}) interface{} {
+	obj, ok := v.(map[string]interface{})
+	if !ok || len(obj) != 2 {
+		return v
+	}
+	if _, ok := obj["type"].(string); !ok {
+		return v
+	}
+	if value, ok := obj["value"]; ok {
+		return value
+	}
+	return v
+}
+
+// SearchTxsResult is a page of the transactions matching a search
+type SearchTxsResult struct {
+	TotalCount int          `json:"total_count"` // Count of all txs
+	Count      int          `json:"count"`       // Count of txs in current page
+	PageNumber int          `json:"page_number"` // Index of current page, start from 1
+	PageTotal  int          `json:"page_total"`  // Count of total pages
+	Limit      int          `json:"limit"`       // Max count txs per page
+	Txs        []TxResponse `json:"txs"`         // List of txs in current page
+}
+
+// NewSearchTxsResult returns a page of the transactions matching a search out
+// of totalCount transactions
+func NewSearchTxsResult(totalCount, page, limit int, txs []TxResponse) SearchTxsResult {
+	pageTotal := 0
+	if limit > 0 {
+		pageTotal = (totalCount + limit - 1) / limit
+	}
+	if txs == nil {
+		txs = []TxResponse{}
+	}
+
+	return SearchTxsResult{
+		TotalCount: totalCount,
+		Count:      len(txs),
+		PageNumber: page,
+		PageTotal:  pageTotal,
+		Limit:      limit,
+		Txs:        txs,
+	}
+}
+
+func (r SearchTxsResult) String() string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("Page %d of %d, %d of %d transactions\n", r.PageNumber, r.PageTotal, r.Count, r.TotalCount))
+	for _, tx := range r.Txs {
+		sb.WriteString(fmt.Sprintf("\n%s\n", tx.String()))
+	}
+	return strings.TrimSpace(sb.String())
+}
+
 // ParseABCILogs attempts to parse a stringified ABCI tx log into a slice of
 // ABCIMessageLog types. It returns an error upon JSON decoding failure.
 func ParseABCILogs(logs string) (res ABCIMessageLogs, err error) {
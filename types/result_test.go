@@ -27,3 +27,52 @@ func TestParseABCILog(t *testing.T) {
 	require.Equal(t, res[0].MsgIndex, 1)
 	require.True(t, res[0].Success)
 }
+
+type testSignBytesMsg struct {
+	*TestMsg
+	signBytes string
+}
+
+func (msg testSignBytesMsg) GetSignBytes() []byte { return []byte(msg.signBytes) }
+
+type testTx struct{ msgs []Msg }
+
+func (tx testTx) GetMsgs() []Msg       { return tx.msgs }
+func (tx testTx) ValidateBasic() Error { return nil }
+
+func TestTxResponseStringMessages(t *testing.T) {
+	msg := testSignBytesMsg{
+		TestMsg: NewTestMsg(),
+		signBytes: `{"type":"test/Msg","value":{"amount":[{"amount":"10","denom":"stake"}],` +
+			`"inputs":[{"address":"adr1","coins":[{"amount":"1","denom":"atom"}]}],` +
+			`"pub_key":{"type":"tendermint/PubKeySecp256k1","value":"A0Cy"},"to":"adr2"}}`,
+	}
+	res := TxResponse{TxHash: "AB", Tx: testTx{msgs: []Msg{msg}}}
+
+	require.Equal(t, `Response:
+  TxHash: AB
+  Messages:
+    - TestMsg/Test message
+      amount: 10stake
+      inputs:
+        -
+          address: adr1
+          coins: 1atom
+      pub_key: A0Cy
+      to: adr2`, res.String())
+}
+
+func TestNewSearchTxsResult(t *testing.T) {
+	txs := []TxResponse{{TxHash: "A"}, {TxHash: "B"}}
+
+	res := NewSearchTxsResult(7, 2, 2, txs)
+	require.Equal(t, 7, res.TotalCount)
+	require.Equal(t, 2, res.Count)
+	require.Equal(t, 2, res.PageNumber)
+	require.Equal(t, 4, res.PageTotal)
+	require.Equal(t, 2, res.Limit)
+
+	res = NewSearchTxsResult(0, 1, 30, nil)
+	require.Equal(t, 0, res.PageTotal)
+	require.Equal(t, []TxResponse{}, res.Txs)
+}
@@ -69,8 +69,15 @@ func MakeTag(k string, v string) Tag {
 //__________________________________________________
 
 // common tags
+//
+// Every message of a transaction is tagged with its action (the message type),
+// its module (the message route) and a sender for each of its signers, followed
+// by the tags of its module.
 var (
 	TagAction       = "action"
+	TagModule       = "module"
+	TagSender       = "sender"
+	TagRecipient    = "recipient"
 	TagSrcValidator = "source-validator"
 	TagDstValidator = "destination-validator"
 	TagDelegator    = "delegator"
@@ -3,6 +3,7 @@ package auth
 import (
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/tendermint/tendermint/crypto"
@@ -493,3 +494,177 @@ func (dva *DelayedVestingAccount) GetStartTime() int64 {
 func (dva *DelayedVestingAccount) GetEndTime() int64 {
 	return dva.EndTime
 }
+
+//-----------------------------------------------------------------------------
+// Periodic Vesting Account
+
+var _ VestingAccount = (*PeriodicVestingAccount)(nil)
+
+// Period defines a length of time and an amount of coins that vest once the
+// period has elapsed.
+type Period struct {
+	Length int64     `json:"length"` // length of the period in seconds
+	Amount sdk.Coins `json:"amount"` // coins vesting at the end of the period
+}
+
+// String implements fmt.Stringer
+func (p Period) String() string {
+	return fmt.Sprintf("Length: %d, Amount: %s", p.Length, p.Amount)
+}
+
+// Periods is the vesting schedule of a periodic vesting account
+type Periods []Period
+
+// String implements fmt.Stringer
+func (periods Periods) String() string {
+	var out strings.Builder
+	for i, p := range periods {
+		if i > 0 {
+			out.WriteString("\n")
+		}
+		out.WriteString(fmt.Sprintf("    %s", p))
+	}
+	return out.String()
+}
+
+// TotalLength returns the summed length of the periods
+func (periods Periods) TotalLength() int64 {
+	var total int64
+	for _, p := range periods {
+		total += p.Length
+	}
+	return total
+}
+
+// TotalAmount returns the sum of the coins vesting over all periods
+func (periods Periods) TotalAmount() sdk.Coins {
+	total := sdk.NewCoins()
+	for _, p := range periods {
+		total = total.Add(p.Amount)
+	}
+	return total
+}
+
+// Validate checks that every period has a positive length and a valid,
+// positive amount.
+func (periods Periods) Validate() error {
+	if len(periods) == 0 {
+		return fmt.Errorf("vesting schedule has no periods")
+	}
+	for i, p := range periods {
+		if p.Length <= 0 {
+			return fmt.Errorf("vesting period %d has a non-positive length %d", i, p.Length)
+		}
+		if !p.Amount.IsValid() || !p.Amount.IsAllPositive() {
+			return fmt.Errorf("vesting period %d has an invalid amount %s", i, p.Amount)
+		}
+	}
+	return nil
+}
+
+// PeriodicVestingAccount implements the VestingAccount interface. It vests the
+// coins of each period of a custom schedule once that period has elapsed.
+type PeriodicVestingAccount struct {
+	*BaseVestingAccount
+
+	StartTime      int64   `json:"start_time"`      // when the first period starts
+	VestingPeriods Periods `json:"vesting_periods"` // consecutive periods of the schedule
+}
+
+// NewPeriodicVestingAccount returns a new PeriodicVestingAccount vesting the
+// account's coins over the given periods. The periods are expected to add up to
+// the account's coins.
+func NewPeriodicVestingAccount(
+	baseAcc *BaseAccount, StartTime int64, periods Periods,
+) *PeriodicVestingAccount {
+
+	baseVestingAcc := &BaseVestingAccount{
+		BaseAccount:     baseAcc,
+		OriginalVesting: baseAcc.Coins,
+		EndTime:         StartTime + periods.TotalLength(),
+	}
+
+	return &PeriodicVestingAccount{
+		BaseVestingAccount: baseVestingAcc,
+		StartTime:          StartTime,
+		VestingPeriods:     periods,
+	}
+}
+
+func (pva PeriodicVestingAccount) String() string {
+	var pubkey string
+
+	if pva.PubKey != nil {
+		pubkey = sdk.MustBech32ifyAccPub(pva.PubKey)
+	}
+
+	return fmt.Sprintf(`Periodic Vesting Account:
+  Address:          %s
+  Pubkey:           %s
+  Coins:            %s
+  Sequence:         %d
+  OriginalVesting:  %s
+  DelegatedFree:    %s
+  DelegatedVesting: %s
+  StartTime:        %d
+  EndTime:          %d
+  VestingPeriods:
+%s`,
+		pva.Address, pubkey, pva.Coins, pva.Sequence,
+		pva.OriginalVesting, pva.DelegatedFree, pva.DelegatedVesting,
+		pva.StartTime, pva.EndTime, pva.VestingPeriods,
+	)
+}
+
+// GetVestedCoins returns the total number of vested coins, the coins of every
+// period that has fully elapsed. If no coins are vested, nil is returned.
+func (pva PeriodicVestingAccount) GetVestedCoins(blockTime time.Time) sdk.Coins {
+	var vestedCoins sdk.Coins
+
+	if blockTime.Unix() <= pva.StartTime {
+		return vestedCoins
+	} else if blockTime.Unix() >= pva.EndTime {
+		return pva.OriginalVesting
+	}
+
+	periodEnd := pva.StartTime
+	for _, p := range pva.VestingPeriods {
+		periodEnd += p.Length
+		if blockTime.Unix() < periodEnd {
+			break
+		}
+		vestedCoins = vestedCoins.Add(p.Amount)
+	}
+
+	return vestedCoins
+}
+
+// GetVestingCoins returns the total number of vesting coins. If no coins are
+// vesting, nil is returned.
+func (pva PeriodicVestingAccount) GetVestingCoins(blockTime time.Time) sdk.Coins {
+	return pva.OriginalVesting.Sub(pva.GetVestedCoins(blockTime))
+}
+
+// SpendableCoins returns the total number of spendable coins per denom for a
+// periodic vesting account.
+func (pva PeriodicVestingAccount) SpendableCoins(blockTime time.Time) sdk.Coins {
+	return pva.spendableCoins(pva.GetVestingCoins(blockTime))
+}
+
+// TrackDelegation tracks a desired delegation amount by setting the appropriate
+// values for the amount of delegated vesting, delegated free, and reducing the
+// overall amount of base coins.
+func (pva *PeriodicVestingAccount) TrackDelegation(blockTime time.Time, amount sdk.Coins) {
+	pva.trackDelegation(pva.GetVestingCoins(blockTime), amount)
+}
+
+// GetStartTime returns the time when vesting starts for a periodic vesting
+// account.
+func (pva *PeriodicVestingAccount) GetStartTime() int64 {
+	return pva.StartTime
+}
+
+// GetEndTime returns the time when vesting ends for a periodic vesting account.
+func (pva *PeriodicVestingAccount) GetEndTime() int64 {
+	return pva.EndTime
+}
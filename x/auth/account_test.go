@@ -481,3 +481,47 @@ func TestTrackUndelegationDelVestingAcc(t *testing.T) {
 	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 25)}, dva.DelegatedVesting)
 	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000), sdk.NewInt64Coin(stakeDenom, 75)}, dva.GetCoins())
 }
+
+func TestGetVestedCoinsPeriodicVestingAcc(t *testing.T) {
+	now := tmtime.Now()
+	periods := Periods{
+		Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}},
+		Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 250), sdk.NewInt64Coin(stakeDenom, 25)}},
+		Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 250), sdk.NewInt64Coin(stakeDenom, 25)}},
+	}
+	require.NoError(t, periods.Validate())
+
+	_, _, addr := keyPubAddr()
+	origCoins := sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000), sdk.NewInt64Coin(stakeDenom, 100)}
+	require.Equal(t, origCoins, periods.TotalAmount())
+	bacc := NewBaseAccountWithAddress(addr)
+	bacc.SetCoins(origCoins)
+	pva := NewPeriodicVestingAccount(&bacc, now.Unix(), periods)
+	require.Equal(t, now.Add(24*time.Hour).Unix(), pva.GetEndTime())
+
+	// require no coins vested in the very beginning of the vesting schedule
+	require.Nil(t, pva.GetVestedCoins(now))
+	require.Equal(t, origCoins, pva.GetVestingCoins(now))
+	require.Nil(t, pva.SpendableCoins(now))
+
+	// require no coins vested before the first period has elapsed
+	require.Nil(t, pva.GetVestedCoins(now.Add(11*time.Hour)))
+
+	// require the first period vested once it elapsed
+	vestedCoins := pva.GetVestedCoins(now.Add(12 * time.Hour))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}, vestedCoins)
+	require.Equal(t, vestedCoins, pva.SpendableCoins(now.Add(12*time.Hour)))
+
+	// require the first two periods vested
+	vestedCoins = pva.GetVestedCoins(now.Add(20 * time.Hour))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 750), sdk.NewInt64Coin(stakeDenom, 75)}, vestedCoins)
+
+	// require all coins vested at the end of the vesting schedule
+	require.Equal(t, origCoins, pva.GetVestedCoins(now.Add(24*time.Hour)))
+	require.Nil(t, pva.GetVestingCoins(now.Add(24*time.Hour)))
+
+	// require a schedule without periods or with empty periods to be invalid
+	require.Error(t, Periods{}.Validate())
+	require.Error(t, Periods{Period{Length: 0, Amount: origCoins}}.Validate())
+	require.Error(t, Periods{Period{Length: 10}}.Validate())
+}
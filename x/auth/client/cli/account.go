@@ -1,12 +1,15 @@
 package cli
 
 import (
+	"fmt"
+
 	"github.com/spf13/cobra"
 
 	"github.com/PhenixChain/PhenixChain/client"
 	"github.com/PhenixChain/PhenixChain/client/context"
 	"github.com/PhenixChain/PhenixChain/codec"
 	sdk "github.com/PhenixChain/PhenixChain/types"
+	"github.com/PhenixChain/PhenixChain/x/auth"
 )
 
 // GetAccountCmd returns a query account that will display the state of the
@@ -40,3 +43,37 @@ func GetAccountCmd(storeName string, cdc *codec.Codec) *cobra.Command {
 	}
 	return client.GetCommands(cmd)[0]
 }
+
+// GetVestingCmd returns a query command that displays how much of the coins of
+// a vesting account have vested at the latest block.
+func GetVestingCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vesting [address]",
+		Short: "Query the vested, vesting and spendable coins of a vesting account",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			bz, err := cdc.MarshalJSON(auth.NewQueryAccountParams(addr))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", auth.QuerierRoute, auth.QueryVesting)
+			res, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var details auth.VestingDetails
+			cdc.MustUnmarshalJSON(res, &details)
+			return cliCtx.PrintOutput(details)
+		},
+	}
+	return client.GetCommands(cmd)[0]
+}
@@ -20,7 +20,7 @@ import (
 	authtxb "github.com/PhenixChain/PhenixChain/x/auth/client/txbuilder"
 )
 
-// GetSignCommand returns the sign command
+// GetMultiSignCommand returns the multi-sign command
 func GetMultiSignCommand(codec *amino.Codec) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "multisign [file] [name] [[signature]...]",
@@ -30,7 +30,7 @@ func GetMultiSignCommand(codec *amino.Codec) *cobra.Command {
 Read signature(s) from [signature] file(s), generate a multisig signature compliant to the
 multisig key [name], and attach it to the transaction read from [file]. Example:
 
-   gaiacli multisign transaction.json k1k2k3 k1sig.json k2sig.json k3sig.json
+   phenixcli tx multisign transaction.json k1k2k3 k1sig.json k2sig.json k3sig.json
 
 If the flag --signature-only flag is on, it outputs a JSON representation
 of the generated signature only.
@@ -38,6 +38,9 @@ of the generated signature only.
 The --offline flag makes sure that the client will not reach out to an external node.
 Thus account number or sequence number lookups will not be performed and it is
 recommended to set such parameters manually.
+
+If [file] holds a sign request, the chain ID and the sequence embedded in it are used
+and no node is queried.
 `,
 		RunE: makeMultiSignCmd(codec),
 		Args: cobra.MinimumNArgs(3),
@@ -52,7 +55,7 @@ recommended to set such parameters manually.
 
 func makeMultiSignCmd(cdc *amino.Codec) func(cmd *cobra.Command, args []string) error {
 	return func(cmd *cobra.Command, args []string) (err error) {
-		stdTx, err := utils.ReadStdTxFromFile(cdc, args[0])
+		stdTx, signReq, err := utils.ReadStdTxOrSignRequestFromFile(cdc, args[0])
 		if err != nil {
 			return
 		}
@@ -71,11 +74,21 @@ func makeMultiSignCmd(cdc *amino.Codec) func(cmd *cobra.Command, args []string)
 		}
 
 		multisigPub := multisigInfo.GetPubKey().(multisig.PubKeyMultisigThreshold)
-		multisigSig := multisig.NewMultisig(len(multisigPub.PubKeys))
 		cliCtx := context.NewCLIContext().WithCodec(cdc).WithAccountDecoder(cdc)
 		txBldr := authtxb.NewTxBuilderFromCLI()
 
-		if !viper.GetBool(flagOffline) {
+		switch {
+		case signReq != nil:
+			if !signReq.Signer.Equals(multisigInfo.GetAddress()) {
+				return fmt.Errorf("sign request is addressed to %s, not %s", signReq.Signer, multisigInfo.GetAddress())
+			}
+
+			txBldr, err = builderFromSignRequest(txBldr, *signReq)
+			if err != nil {
+				return err
+			}
+
+		case !viper.GetBool(flagOffline):
 			addr := multisigInfo.GetAddress()
 
 			seq, err := cliCtx.GetAccountSequence(addr)
@@ -86,28 +99,20 @@ func makeMultiSignCmd(cdc *amino.Codec) func(cmd *cobra.Command, args []string)
 			txBldr = txBldr.WithSequence(seq)
 		}
 
-		// read each signature and add it to the multisig if valid
+		// read each signature file
+		var stdSigs []auth.StdSignature
 		for i := 2; i < len(args); i++ {
 			stdSig, err := readAndUnmarshalStdSignature(cdc, args[i])
 			if err != nil {
 				return err
 			}
-
-			// Validate each signature
-			sigBytes := auth.StdSignBytes(
-				txBldr.ChainID(), txBldr.Sequence(),
-				stdTx.Fee, stdTx.GetMsgs(), stdTx.GetMemo(),
-			)
-			if ok := stdSig.PubKey.VerifyBytes(sigBytes, stdSig.Signature); !ok {
-				return fmt.Errorf("couldn't verify signature")
-			}
-			if err := multisigSig.AddSignatureFromPubKey(stdSig.Signature, stdSig.PubKey, multisigPub.PubKeys); err != nil {
-				return err
-			}
+			stdSigs = append(stdSigs, stdSig)
 		}
 
-		newStdSig := auth.StdSignature{Signature: cdc.MustMarshalBinaryBare(multisigSig), PubKey: multisigPub}
-		newTx := auth.NewStdTx(stdTx.GetMsgs(), stdTx.Fee, []auth.StdSignature{newStdSig}, stdTx.GetMemo())
+		newTx, err := combineMultisigSignatures(cdc, txBldr, stdTx, multisigPub, stdSigs)
+		if err != nil {
+			return err
+		}
 
 		sigOnly := viper.GetBool(flagSigOnly)
 		var json []byte
@@ -144,6 +149,38 @@ func makeMultiSignCmd(cdc *amino.Codec) func(cmd *cobra.Command, args []string)
 	}
 }
 
+// combineMultisigSignatures verifies each of the given partial signatures over
+// the transaction sign bytes and aggregates them into a single multisig
+// signature that replaces the transaction's signatures.
+func combineMultisigSignatures(
+	cdc *amino.Codec, txBldr authtxb.TxBuilder, stdTx auth.StdTx,
+	multisigPub multisig.PubKeyMultisigThreshold, stdSigs []auth.StdSignature,
+) (newTx auth.StdTx, err error) {
+
+	multisigSig := multisig.NewMultisig(len(multisigPub.PubKeys))
+	sigBytes := auth.StdSignBytes(
+		txBldr.ChainID(), txBldr.Sequence(),
+		stdTx.Fee, stdTx.GetMsgs(), stdTx.GetMemo(),
+	)
+
+	// add each signature to the multisig if valid
+	for _, stdSig := range stdSigs {
+		if ok := stdSig.PubKey.VerifyBytes(sigBytes, stdSig.Signature); !ok {
+			return newTx, fmt.Errorf("couldn't verify signature")
+		}
+		if err = multisigSig.AddSignatureFromPubKey(stdSig.Signature, stdSig.PubKey, multisigPub.PubKeys); err != nil {
+			return
+		}
+	}
+
+	if n := multisigSig.BitArray.NumTrueBitsBefore(len(multisigPub.PubKeys)); n < int(multisigPub.K) {
+		return newTx, fmt.Errorf("not enough signatures: got %d, threshold is %d", n, multisigPub.K)
+	}
+
+	newStdSig := auth.StdSignature{Signature: cdc.MustMarshalBinaryBare(multisigSig), PubKey: multisigPub}
+	return auth.NewStdTx(stdTx.GetMsgs(), stdTx.Fee, []auth.StdSignature{newStdSig}, stdTx.GetMemo()), nil
+}
+
 func readAndUnmarshalStdSignature(cdc *amino.Codec, filename string) (stdSig auth.StdSignature, err error) {
 	var bytes []byte
 	if bytes, err = ioutil.ReadFile(filename); err != nil {
@@ -0,0 +1,82 @@
+package cli
+
+import (
+	"bytes"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/multisig"
+
+	"github.com/PhenixChain/PhenixChain/codec"
+	crkeys "github.com/PhenixChain/PhenixChain/crypto/keys"
+	sdk "github.com/PhenixChain/PhenixChain/types"
+	"github.com/PhenixChain/PhenixChain/x/auth"
+	authtxb "github.com/PhenixChain/PhenixChain/x/auth/client/txbuilder"
+)
+
+func TestCombineMultisigSignatures(t *testing.T) {
+	cdc := codec.New()
+	sdk.RegisterCodec(cdc)
+	cdc.RegisterConcrete(sdk.TestMsg{}, "cosmos-sdk/Test", nil)
+	auth.RegisterCodec(cdc)
+	codec.RegisterCrypto(cdc)
+
+	kb := crkeys.NewInMemory()
+	names := []string{"k1", "k2", "k3"}
+	var pks []crypto.PubKey
+	for _, name := range names {
+		info, _, err := kb.CreateMnemonic(name, crkeys.English, "passphrase", crkeys.Secp256k1)
+		require.NoError(t, err)
+		pks = append(pks, info.GetPubKey())
+	}
+	sort.Slice(pks, func(i, j int) bool {
+		return bytes.Compare(pks[i].Address(), pks[j].Address()) < 0
+	})
+
+	multisigPub := multisig.NewPubKeyMultisigThreshold(2, pks).(multisig.PubKeyMultisigThreshold)
+	multiInfo, err := kb.CreateMulti("multi", multisigPub)
+	require.NoError(t, err)
+
+	// generate the unsigned tx on behalf of the multisig address
+	msg := sdk.NewTestMsg(multiInfo.GetAddress())
+	fee := auth.NewStdFee(50000, sdk.NewCoins(sdk.NewInt64Coin("pnx", 10)))
+	stdTx := auth.NewStdTx([]sdk.Msg{msg}, fee, nil, "memo")
+
+	txBldr := authtxb.NewTxBuilder(
+		auth.DefaultTxEncoder(cdc), 3, 50000, 1.0, false, "test-chain", "memo", nil, nil,
+	).WithKeybase(kb)
+
+	// collect partial signatures offline
+	partialSig := func(name string) auth.StdSignature {
+		signedTx, err := txBldr.SignStdTx(name, "passphrase", stdTx, false)
+		require.NoError(t, err)
+		return signedTx.Signatures[0]
+	}
+	sig1, sig2, sig3 := partialSig("k1"), partialSig("k2"), partialSig("k3")
+
+	// below the threshold
+	_, err = combineMultisigSignatures(cdc, txBldr, stdTx, multisigPub, []auth.StdSignature{sig1})
+	require.Error(t, err)
+
+	// signature over a different sequence
+	badSig := func() auth.StdSignature {
+		signedTx, err := txBldr.WithSequence(4).SignStdTx("k3", "passphrase", stdTx, false)
+		require.NoError(t, err)
+		return signedTx.Signatures[0]
+	}()
+	_, err = combineMultisigSignatures(cdc, txBldr, stdTx, multisigPub, []auth.StdSignature{sig1, badSig})
+	require.Error(t, err)
+
+	for _, sigs := range [][]auth.StdSignature{{sig1, sig2}, {sig3, sig1}, {sig1, sig2, sig3}} {
+		newTx, err := combineMultisigSignatures(cdc, txBldr, stdTx, multisigPub, sigs)
+		require.NoError(t, err)
+		require.Len(t, newTx.Signatures, 1)
+		require.Equal(t, multiInfo.GetAddress(), sdk.AccAddress(newTx.Signatures[0].Address()))
+
+		// the combined signature must be accepted the way the ante handler checks it
+		signBytes := auth.StdSignBytes("test-chain", 3, fee, stdTx.GetMsgs(), "memo")
+		require.True(t, multisigPub.VerifyBytes(signBytes, newTx.Signatures[0].Signature))
+	}
+}
@@ -49,13 +49,17 @@ As a result, the account and sequence number queries will not be performed and
 it is required to set such parameters manually. Note, invalid values will cause
 the transaction to fail.
 
+The [file] may also hold a sign request created with --generate-only --sign-request,
+either in JSON or in compact encoding. The chain ID and the sequence embedded in the
+request are used to sign it, so no node and no --sequence flag are needed, which
+allows signing on an air-gapped machine.
+
 The --multisig=<multisig_key> flag generates a signature on behalf of a multisig account
 key. It implies --signature-only. Full multisig signed transactions may eventually
 be generated via the 'multisign' command.
 `,
-		PreRun: preSignCmd,
-		RunE:   makeSignCmd(codec),
-		Args:   cobra.ExactArgs(1),
+		RunE: makeSignCmd(codec),
+		Args: cobra.ExactArgs(1),
 	}
 
 	cmd.Flags().String(
@@ -80,17 +84,9 @@ be generated via the 'multisign' command.
 	return cmd
 }
 
-func preSignCmd(cmd *cobra.Command, _ []string) {
-	// Conditionally mark the account and sequence numbers required as no RPC
-	// query will be done.
-	if viper.GetBool(flagOffline) {
-		cmd.MarkFlagRequired(client.FlagSequence)
-	}
-}
-
 func makeSignCmd(cdc *amino.Codec) func(cmd *cobra.Command, args []string) error {
 	return func(cmd *cobra.Command, args []string) (err error) {
-		stdTx, err := utils.ReadStdTxFromFile(cdc, args[0])
+		stdTx, signReq, err := utils.ReadStdTxOrSignRequestFromFile(cdc, args[0])
 		if err != nil {
 			return
 		}
@@ -99,6 +95,18 @@ func makeSignCmd(cdc *amino.Codec) func(cmd *cobra.Command, args []string) error
 		cliCtx := context.NewCLIContext().WithCodec(cdc).WithAccountDecoder(cdc)
 		txBldr := authtxb.NewTxBuilderFromCLI()
 
+		if signReq != nil {
+			// the sign request carries everything needed to build the sign bytes
+			txBldr, err = builderFromSignRequest(txBldr, *signReq)
+			if err != nil {
+				return err
+			}
+			offline = true
+		} else if offline && !cmd.Flags().Changed(client.FlagSequence) {
+			// no RPC query will be done, so the sequence must be provided
+			return fmt.Errorf("--%s is required in offline mode", client.FlagSequence)
+		}
+
 		if viper.GetBool(flagValidateSigs) {
 			if !printAndValidateSigs(cliCtx, txBldr.ChainID(), stdTx, offline) {
 				return fmt.Errorf("signatures validation failed")
@@ -120,11 +128,19 @@ func makeSignCmd(cdc *amino.Codec) func(cmd *cobra.Command, args []string) error
 				return err
 			}
 
+			if signReq != nil && !signReq.Signer.Equals(multisigAddr) {
+				return fmt.Errorf("sign request is addressed to %s, not %s", signReq.Signer, multisigAddr)
+			}
+
 			newTx, err = utils.SignStdTxWithSignerAddress(
 				txBldr, cliCtx, multisigAddr, cliCtx.GetFromName(), stdTx, offline,
 			)
 			generateSignatureOnly = true
 		} else {
+			if signReq != nil && !signReq.Signer.Equals(cliCtx.GetFromAddress()) {
+				return fmt.Errorf("sign request is addressed to %s, not %s", signReq.Signer, cliCtx.GetFromAddress())
+			}
+
 			appendSig := viper.GetBool(flagAppend) && !generateSignatureOnly
 			newTx, err = utils.SignStdTx(txBldr, cliCtx, cliCtx.GetFromName(), stdTx, appendSig, offline)
 		}
@@ -178,6 +194,19 @@ func makeSignCmd(cdc *amino.Codec) func(cmd *cobra.Command, args []string) error
 	}
 }
 
+// builderFromSignRequest sets the chain ID and the sequence embedded in the
+// sign request on the TxBuilder. A chain ID given on the command line must
+// match the one of the request.
+func builderFromSignRequest(txBldr authtxb.TxBuilder, signReq authtxb.StdSignRequest) (authtxb.TxBuilder, error) {
+	if txBldr.ChainID() != "" && txBldr.ChainID() != signReq.ChainID {
+		return txBldr, fmt.Errorf(
+			"chain ID mismatch: sign request is for %s, got %s", signReq.ChainID, txBldr.ChainID(),
+		)
+	}
+
+	return txBldr.WithChainID(signReq.ChainID).WithSequence(signReq.Sequence), nil
+}
+
 // printAndValidateSigs will validate the signatures of a given transaction over
 // its expected signers. In addition, if offline has not been supplied, the
 // signature is verified over the transaction sign bytes.
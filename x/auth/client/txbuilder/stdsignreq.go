@@ -0,0 +1,108 @@
+package context
+
+import (
+	"bytes"
+	"compress/flate"
+	"encoding/base32"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/PhenixChain/PhenixChain/codec"
+	sdk "github.com/PhenixChain/PhenixChain/types"
+	"github.com/PhenixChain/PhenixChain/x/auth"
+)
+
+// SignRequestCompactPrefix marks the compact encoding of a StdSignRequest.
+const SignRequestCompactPrefix = "PNXSIGNREQ:"
+
+// compactEncoding only uses characters of the QR code alphanumeric mode.
+var compactEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// StdSignRequest is an envelope carrying an unsigned StdTx together with the
+// signer metadata required to build its sign bytes, so that it can be signed
+// on a machine that has no access to a full node. Sign bytes only commit to
+// the chain ID and the signer sequence, so no account number is needed.
+type StdSignRequest struct {
+	ChainID  string         `json:"chain_id"`
+	Signer   sdk.AccAddress `json:"signer"`
+	Sequence uint64         `json:"sequence"`
+	Tx       auth.StdTx     `json:"tx"`
+}
+
+// NewStdSignRequest returns a new StdSignRequest for the given signer.
+func NewStdSignRequest(chainID string, signer sdk.AccAddress, seq uint64, stdTx auth.StdTx) StdSignRequest {
+	return StdSignRequest{
+		ChainID:  chainID,
+		Signer:   signer,
+		Sequence: seq,
+		Tx:       stdTx,
+	}
+}
+
+// ValidateBasic performs basic validation of the sign request.
+func (req StdSignRequest) ValidateBasic() error {
+	if req.ChainID == "" {
+		return fmt.Errorf("sign request is missing the chain ID")
+	}
+	if req.Signer.Empty() {
+		return fmt.Errorf("sign request is missing the signer address")
+	}
+	if len(req.Tx.GetMsgs()) == 0 {
+		return fmt.Errorf("sign request does not contain any message")
+	}
+	return nil
+}
+
+// MarshalCompact encodes the sign request as deflated amino binary in
+// unpadded base32, prefixed with SignRequestCompactPrefix. The output only
+// contains uppercase letters, digits and a colon, which fit the QR code
+// alphanumeric mode.
+func (req StdSignRequest) MarshalCompact(cdc *codec.Codec) (string, error) {
+	bz, err := cdc.MarshalBinaryBare(req)
+	if err != nil {
+		return "", err
+	}
+
+	var buf bytes.Buffer
+	w, err := flate.NewWriter(&buf, flate.BestCompression)
+	if err != nil {
+		return "", err
+	}
+	if _, err = w.Write(bz); err != nil {
+		return "", err
+	}
+	if err = w.Close(); err != nil {
+		return "", err
+	}
+
+	return SignRequestCompactPrefix + compactEncoding.EncodeToString(buf.Bytes()), nil
+}
+
+// UnmarshalCompactStdSignRequest decodes a sign request produced by
+// MarshalCompact.
+func UnmarshalCompactStdSignRequest(cdc *codec.Codec, s string) (req StdSignRequest, err error) {
+	s = strings.TrimSpace(s)
+	if !strings.HasPrefix(s, SignRequestCompactPrefix) {
+		return req, fmt.Errorf("compact sign request must start with %q", SignRequestCompactPrefix)
+	}
+
+	deflated, err := compactEncoding.DecodeString(strings.TrimPrefix(s, SignRequestCompactPrefix))
+	if err != nil {
+		return req, err
+	}
+
+	bz, err := ioutil.ReadAll(flate.NewReader(bytes.NewReader(deflated)))
+	if err != nil {
+		return req, err
+	}
+
+	err = cdc.UnmarshalBinaryBare(bz, &req)
+	return req, err
+}
+
+// IsCompactStdSignRequest reports whether the given data holds a sign request
+// in compact encoding.
+func IsCompactStdSignRequest(bz []byte) bool {
+	return bytes.HasPrefix(bytes.TrimSpace(bz), []byte(SignRequestCompactPrefix))
+}
@@ -0,0 +1,55 @@
+package context
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	"github.com/PhenixChain/PhenixChain/codec"
+	sdk "github.com/PhenixChain/PhenixChain/types"
+	"github.com/PhenixChain/PhenixChain/x/auth"
+	"github.com/PhenixChain/PhenixChain/x/bank"
+)
+
+func TestStdSignRequestCompact(t *testing.T) {
+	cdc := codec.New()
+	sdk.RegisterCodec(cdc)
+	auth.RegisterCodec(cdc)
+	bank.RegisterCodec(cdc)
+	codec.RegisterCrypto(cdc)
+
+	signer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	to := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	msg := bank.NewMsgSend(signer, to, sdk.NewCoins(sdk.NewInt64Coin("pnx", 1000)))
+	fee := auth.NewStdFee(200000, sdk.NewCoins(sdk.NewInt64Coin("pnx", 5)))
+	stdTx := auth.NewStdTx([]sdk.Msg{msg}, fee, nil, "treasury transfer")
+	req := NewStdSignRequest("phenix", signer, 42, stdTx)
+	require.NoError(t, req.ValidateBasic())
+
+	compact, err := req.MarshalCompact(cdc)
+	require.NoError(t, err)
+	require.True(t, IsCompactStdSignRequest([]byte(compact+"\n")))
+
+	// QR code alphanumeric mode character set
+	require.Regexp(t, regexp.MustCompile(`^[0-9A-Z $%*+\-./:]+$`), compact)
+
+	decoded, err := UnmarshalCompactStdSignRequest(cdc, compact)
+	require.NoError(t, err)
+	require.Equal(t, req.ChainID, decoded.ChainID)
+	require.Equal(t, req.Signer, decoded.Signer)
+	require.Equal(t, req.Sequence, decoded.Sequence)
+	require.Equal(t, req.Tx.Fee, decoded.Tx.Fee)
+	require.Equal(t, req.Tx.Memo, decoded.Tx.Memo)
+	require.Equal(t, req.Tx.GetMsgs(), decoded.Tx.GetMsgs())
+
+	_, err = UnmarshalCompactStdSignRequest(cdc, compact[len(SignRequestCompactPrefix):])
+	require.Error(t, err)
+	_, err = UnmarshalCompactStdSignRequest(cdc, compact[:len(compact)-4])
+	require.Error(t, err)
+
+	require.Error(t, NewStdSignRequest("", signer, 0, stdTx).ValidateBasic())
+	require.Error(t, NewStdSignRequest("phenix", nil, 0, stdTx).ValidateBasic())
+	require.Error(t, NewStdSignRequest("phenix", signer, 0, auth.StdTx{}).ValidateBasic())
+}
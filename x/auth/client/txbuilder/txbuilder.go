@@ -52,6 +52,13 @@ func NewTxBuilder(
 // NewTxBuilderFromCLI returns a new initialized TxBuilder with parameters from
 // the command line using Viper.
 func NewTxBuilderFromCLI() TxBuilder {
+	return NewTxBuilderFromCLIWithFees(nil).WithFees(viper.GetString(client.FlagFees))
+}
+
+// NewTxBuilderFromCLIWithFees returns a new initialized TxBuilder with
+// parameters from the command line using Viper, except for the fees which
+// the caller parsed from the fees flag itself.
+func NewTxBuilderFromCLIWithFees(fees sdk.Coins) TxBuilder {
 	kb, err := keys.NewKeyBaseFromHomeFlag()
 	if err != nil {
 		panic(err)
@@ -64,9 +71,9 @@ func NewTxBuilderFromCLI() TxBuilder {
 		simulateAndExecute: client.GasFlagVar.Simulate,
 		chainID:            viper.GetString(client.FlagChainID),
 		memo:               viper.GetString(client.FlagMemo),
+		fees:               fees,
 	}
 
-	txbldr = txbldr.WithFees(viper.GetString(client.FlagFees))
 	txbldr = txbldr.WithGasPrices(viper.GetString(client.FlagGasPrices))
 
 	return txbldr
@@ -170,7 +177,7 @@ func (bldr TxBuilder) BuildSignMsg(msgs []sdk.Msg) (StdSignMsg, error) {
 		return StdSignMsg{}, fmt.Errorf("chain ID required but not specified")
 	}
 
-	fees := sdk.Coins{}
+	fees := bldr.fees
 	if !bldr.gasPrices.IsZero() {
 		if !fees.IsZero() {
 			return StdSignMsg{}, errors.New("cannot provide both fees and gas prices")
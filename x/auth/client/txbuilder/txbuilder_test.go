@@ -0,0 +1,36 @@
+package context
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	sdk "github.com/PhenixChain/PhenixChain/types"
+	"github.com/PhenixChain/PhenixChain/x/bank"
+)
+
+func TestBuildSignMsgFees(t *testing.T) {
+	from := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	to := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	msgs := []sdk.Msg{bank.NewMsgSend(from, to, sdk.NewCoins(sdk.NewInt64Coin("upnx", 1000)))}
+	fees := sdk.NewCoins(sdk.NewInt64Coin("upnx", 10000))
+	gasPrices := sdk.DecCoins{sdk.NewDecCoinFromDec("upnx", sdk.NewDecWithPrec(5, 1))}
+
+	// the fees the builder was given are signed
+	bldr := NewTxBuilder(nil, 1, 200000, 1, false, "phenix", "", fees, nil)
+	signMsg, err := bldr.BuildSignMsg(msgs)
+	require.NoError(t, err)
+	require.Equal(t, fees, signMsg.Fee.Amount)
+	require.Equal(t, uint64(200000), signMsg.Fee.Gas)
+
+	// otherwise they are derived from the gas prices
+	bldr = NewTxBuilder(nil, 1, 200001, 1, false, "phenix", "", nil, gasPrices)
+	signMsg, err = bldr.BuildSignMsg(msgs)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("upnx", 100001)), signMsg.Fee.Amount)
+
+	bldr = NewTxBuilder(nil, 1, 200000, 1, false, "phenix", "", fees, gasPrices)
+	_, err = bldr.BuildSignMsg(msgs)
+	require.Error(t, err)
+}
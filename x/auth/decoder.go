@@ -0,0 +1,40 @@
+package auth
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/PhenixChain/PhenixChain/codec"
+	sdk "github.com/PhenixChain/PhenixChain/types"
+)
+
+// DecodeStore decodes a key/value pair of the account store into JSON
+func DecodeStore(cdc *codec.Codec, key, value []byte) (json.RawMessage, error) {
+	switch {
+	case bytes.HasPrefix(key, AddressStoreKeyPrefix):
+		var acc Account
+		if err := codec.UnmarshalAnyEncoding(cdc, value, &acc); err != nil {
+			return nil, err
+		}
+		return cdc.MarshalJSON(acc)
+
+	default:
+		return nil, fmt.Errorf("unknown key %X", key)
+	}
+}
+
+// DecodeFeeStore decodes a key/value pair of the fee store into JSON
+func DecodeFeeStore(cdc *codec.Codec, key, value []byte) (json.RawMessage, error) {
+	switch {
+	case bytes.Equal(key, collectedFeesKey):
+		var fees sdk.Coins
+		if err := cdc.UnmarshalBinaryLengthPrefixed(value, &fees); err != nil {
+			return nil, err
+		}
+		return cdc.MarshalJSON(fees)
+
+	default:
+		return nil, fmt.Errorf("unknown key %X", key)
+	}
+}
@@ -0,0 +1,48 @@
+package auth
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/PhenixChain/PhenixChain/codec"
+)
+
+func TestDecodeStore(t *testing.T) {
+	for _, encoding := range []codec.Encoding{codec.EncodingJSON, codec.EncodingBinary} {
+		input := setupBenchInput(encoding)
+		_, _, addr := keyPubAddr()
+		acc := input.ak.NewAccountWithAddress(input.ctx, addr)
+		acc.SetCoins(oneCoin)
+		input.ak.SetAccount(input.ctx, acc)
+
+		key := AddressStoreKey(addr)
+		value := input.ctx.KVStore(input.ak.key).Get(key)
+		bz, err := DecodeStore(input.cdc, key, value)
+		require.NoError(t, err, encoding)
+
+		expected, err := input.cdc.MarshalJSON(acc)
+		require.NoError(t, err)
+		require.JSONEq(t, string(expected), string(bz), encoding)
+
+		_, err = DecodeStore(input.cdc, key, []byte("garbage"))
+		require.Error(t, err, encoding)
+	}
+
+	input := setupTestInput()
+	_, err := DecodeStore(input.cdc, []byte("unknown"), nil)
+	require.Error(t, err)
+}
+
+func TestDecodeFeeStore(t *testing.T) {
+	input := setupTestInput()
+	input.fck.setCollectedFees(input.ctx, twoCoins)
+
+	value := input.ctx.KVStore(input.fck.key).Get(collectedFeesKey)
+	bz, err := DecodeFeeStore(input.cdc, collectedFeesKey, value)
+	require.NoError(t, err)
+	require.JSONEq(t, `[{"denom":"foocoin","amount":"2"}]`, string(bz))
+
+	_, err = DecodeFeeStore(input.cdc, []byte("unknown"), value)
+	require.Error(t, err)
+}
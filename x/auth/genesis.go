@@ -3,30 +3,36 @@ package auth
 import (
 	"fmt"
 
+	"github.com/PhenixChain/PhenixChain/codec"
 	sdk "github.com/PhenixChain/PhenixChain/types"
 )
 
 // GenesisState - all auth state that must be provided at genesis
 type GenesisState struct {
-	CollectedFees sdk.Coins `json:"collected_fees"`
-	Params        Params    `json:"params"`
+	CollectedFees sdk.Coins      `json:"collected_fees"`
+	Params        Params         `json:"params"`
+	Encoding      codec.Encoding `json:"encoding"`
 }
 
 // NewGenesisState - Create a new genesis state
-func NewGenesisState(collectedFees sdk.Coins, params Params) GenesisState {
+func NewGenesisState(collectedFees sdk.Coins, params Params, encoding codec.Encoding) GenesisState {
 	return GenesisState{
 		Params:        params,
 		CollectedFees: collectedFees,
+		Encoding:      encoding,
 	}
 }
 
-// DefaultGenesisState - Return a default genesis state
+// DefaultGenesisState - Return a default genesis state. New chains store
+// transactions and accounts in the binary encoding.
 func DefaultGenesisState() GenesisState {
-	return NewGenesisState(sdk.NewCoins(), DefaultParams())
+	return NewGenesisState(sdk.NewCoins(), DefaultParams(), codec.EncodingBinary)
 }
 
-// InitGenesis - Init store state from genesis data
+// InitGenesis - Init store state from genesis data. The encoding is set first
+// since genesis accounts are stored in it.
 func InitGenesis(ctx sdk.Context, ak AccountKeeper, fck FeeCollectionKeeper, data GenesisState) {
+	ak.SetEncoding(ctx, data.Encoding)
 	ak.SetParams(ctx, data.Params)
 	fck.setCollectedFees(ctx, data.CollectedFees)
 }
@@ -36,12 +42,18 @@ func ExportGenesis(ctx sdk.Context, ak AccountKeeper, fck FeeCollectionKeeper) G
 	collectedFees := fck.GetCollectedFees(ctx)
 	params := ak.GetParams(ctx)
 
-	return NewGenesisState(collectedFees, params)
+	return NewGenesisState(collectedFees, params, ak.GetEncoding(ctx))
 }
 
 // ValidateGenesis performs basic validation of auth genesis data returning an
 // error for any failed validation criteria.
 func ValidateGenesis(data GenesisState) error {
+	if err := data.Encoding.Validate(); err != nil {
+		return err
+	}
+	if !data.CollectedFees.IsValid() {
+		return fmt.Errorf("invalid collected fees: %s", data.CollectedFees)
+	}
 	if data.Params.TxSigLimit == 0 {
 		return fmt.Errorf("invalid tx signature limit: %d", data.Params.TxSigLimit)
 	}
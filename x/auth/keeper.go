@@ -41,6 +41,9 @@ type AccountKeeper struct {
 	// The codec codec for binary encoding/decoding of accounts.
 	cdc *codec.Codec
 
+	// The encoding accounts are stored in, shared with the app's tx decoder.
+	enc *codec.Encoder
+
 	paramSubspace params.Subspace
 }
 
@@ -55,10 +58,23 @@ func NewAccountKeeper(
 		key:           key,
 		proto:         proto,
 		cdc:           cdc,
+		enc:           codec.NewEncoder(cdc, codec.EncodingJSON),
 		paramSubspace: paramstore.WithKeyTable(ParamKeyTable()),
 	}
 }
 
+// WithEncoder returns a copy of the keeper storing accounts in the encoding of
+// enc. The encoding follows enc when it is switched, e.g. at genesis.
+func (ak AccountKeeper) WithEncoder(enc *codec.Encoder) AccountKeeper {
+	ak.enc = enc
+	return ak
+}
+
+// Encoder returns the encoder accounts are stored with
+func (ak AccountKeeper) Encoder() *codec.Encoder {
+	return ak.enc
+}
+
 // NewAccountWithAddress implements sdk.AccountKeeper.
 func (ak AccountKeeper) NewAccountWithAddress(ctx sdk.Context, addr sdk.AccAddress) Account {
 	acc := ak.proto()
@@ -101,8 +117,7 @@ func (ak AccountKeeper) GetAllAccounts(ctx sdk.Context) []Account {
 func (ak AccountKeeper) SetAccount(ctx sdk.Context, acc Account) {
 	addr := acc.GetAddress()
 	store := ctx.KVStore(ak.key)
-	// nikolas
-	bz, err := ak.cdc.MarshalJSON(acc)
+	bz, err := ak.enc.Marshal(acc)
 	if err != nil {
 		panic(err)
 	}
@@ -181,12 +196,27 @@ func (ak AccountKeeper) GetParams(ctx sdk.Context) (params Params) {
 	return
 }
 
+// SetEncoding sets the encoding accounts and transactions are serialized in
+// and switches the keeper's encoder to it. It may only be called before any
+// account is stored, i.e. at genesis.
+func (ak AccountKeeper) SetEncoding(ctx sdk.Context, encoding codec.Encoding) {
+	ak.paramSubspace.Set(ctx, KeyEncoding, encoding)
+	ak.enc.SetEncoding(encoding)
+}
+
+// GetEncoding gets the encoding accounts and transactions are serialized in.
+// Chains started before the encoding could be chosen use JSON.
+func (ak AccountKeeper) GetEncoding(ctx sdk.Context) codec.Encoding {
+	encoding := codec.EncodingJSON
+	ak.paramSubspace.GetIfExists(ctx, KeyEncoding, &encoding)
+	return encoding
+}
+
 // -----------------------------------------------------------------------------
 // Misc.
 
 func (ak AccountKeeper) decodeAccount(bz []byte) (acc Account) {
-	// nikolas
-	err := ak.cdc.UnmarshalJSON(bz, &acc)
+	err := ak.enc.Unmarshal(bz, &acc)
 	if err != nil {
 		panic(err)
 	}
@@ -3,42 +3,73 @@ package auth
 import (
 	"testing"
 
+	"github.com/PhenixChain/PhenixChain/codec"
 	sdk "github.com/PhenixChain/PhenixChain/types"
 )
 
-func BenchmarkAccountMapperGetAccountFound(b *testing.B) {
+// runEncodings runs a benchmark once for every encoding accounts can be
+// stored in
+func runEncodings(b *testing.B, bench func(*testing.B, codec.Encoding)) {
+	for _, encoding := range []codec.Encoding{codec.EncodingJSON, codec.EncodingBinary} {
+		encoding := encoding
+		b.Run(encoding.String(), func(b *testing.B) { bench(b, encoding) })
+	}
+}
+
+// benchAddress returns a distinct address for every i < 2**24, the JSON
+// encoding only accepts addresses of full length
+func benchAddress(i int) sdk.AccAddress {
+	addr := make([]byte, sdk.AddrLen)
+	addr[sdk.AddrLen-3] = byte((i & 0xFF0000) >> 16)
+	addr[sdk.AddrLen-2] = byte((i & 0xFF00) >> 8)
+	addr[sdk.AddrLen-1] = byte(i & 0xFF)
+	return sdk.AccAddress(addr)
+}
+
+func setupBenchInput(encoding codec.Encoding) testInput {
 	input := setupTestInput()
+	input.ak = input.ak.WithEncoder(codec.NewEncoder(input.cdc, encoding))
+	return input
+}
+
+func BenchmarkAccountMapperGetAccountFound(b *testing.B) {
+	runEncodings(b, benchmarkAccountMapperGetAccountFound)
+}
+
+func benchmarkAccountMapperGetAccountFound(b *testing.B, encoding codec.Encoding) {
+	input := setupBenchInput(encoding)
 
 	// assumes b.N < 2**24
 	for i := 0; i < b.N; i++ {
-		arr := []byte{byte((i & 0xFF0000) >> 16), byte((i & 0xFF00) >> 8), byte(i & 0xFF)}
-		addr := sdk.AccAddress(arr)
+		addr := benchAddress(i)
 		acc := input.ak.NewAccountWithAddress(input.ctx, addr)
 		input.ak.SetAccount(input.ctx, acc)
 	}
 
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
-		arr := []byte{byte((i & 0xFF0000) >> 16), byte((i & 0xFF00) >> 8), byte(i & 0xFF)}
-		input.ak.GetAccount(input.ctx, sdk.AccAddress(arr))
+		input.ak.GetAccount(input.ctx, benchAddress(i))
 	}
 }
 
 func BenchmarkAccountMapperGetAccountFoundWithCoins(b *testing.B) {
-	input := setupTestInput()
+	runEncodings(b, benchmarkAccountMapperGetAccountFoundWithCoins)
+}
+
+func benchmarkAccountMapperGetAccountFoundWithCoins(b *testing.B, encoding codec.Encoding) {
+	input := setupBenchInput(encoding)
 	coins := sdk.Coins{
-		sdk.NewCoin("LTC", sdk.NewInt(1000)),
-		sdk.NewCoin("BTC", sdk.NewInt(1000)),
-		sdk.NewCoin("ETH", sdk.NewInt(1000)),
-		sdk.NewCoin("XRP", sdk.NewInt(1000)),
-		sdk.NewCoin("BCH", sdk.NewInt(1000)),
-		sdk.NewCoin("EOS", sdk.NewInt(1000)),
+		sdk.NewCoin("ltc", sdk.NewInt(1000)),
+		sdk.NewCoin("btc", sdk.NewInt(1000)),
+		sdk.NewCoin("eth", sdk.NewInt(1000)),
+		sdk.NewCoin("xrp", sdk.NewInt(1000)),
+		sdk.NewCoin("bch", sdk.NewInt(1000)),
+		sdk.NewCoin("eos", sdk.NewInt(1000)),
 	}
 
 	// assumes b.N < 2**24
 	for i := 0; i < b.N; i++ {
-		arr := []byte{byte((i & 0xFF0000) >> 16), byte((i & 0xFF00) >> 8), byte(i & 0xFF)}
-		addr := sdk.AccAddress(arr)
+		addr := benchAddress(i)
 		acc := input.ak.NewAccountWithAddress(input.ctx, addr)
 		acc.SetCoins(coins)
 		input.ak.SetAccount(input.ctx, acc)
@@ -46,42 +77,47 @@ func BenchmarkAccountMapperGetAccountFoundWithCoins(b *testing.B) {
 
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
-		arr := []byte{byte((i & 0xFF0000) >> 16), byte((i & 0xFF00) >> 8), byte(i & 0xFF)}
-		input.ak.GetAccount(input.ctx, sdk.AccAddress(arr))
+		input.ak.GetAccount(input.ctx, benchAddress(i))
 	}
 }
 
 func BenchmarkAccountMapperSetAccount(b *testing.B) {
-	input := setupTestInput()
+	runEncodings(b, benchmarkAccountMapperSetAccount)
+}
+
+func benchmarkAccountMapperSetAccount(b *testing.B, encoding codec.Encoding) {
+	input := setupBenchInput(encoding)
 
 	b.ResetTimer()
 
 	// assumes b.N < 2**24
 	for i := 0; i < b.N; i++ {
-		arr := []byte{byte((i & 0xFF0000) >> 16), byte((i & 0xFF00) >> 8), byte(i & 0xFF)}
-		addr := sdk.AccAddress(arr)
+		addr := benchAddress(i)
 		acc := input.ak.NewAccountWithAddress(input.ctx, addr)
 		input.ak.SetAccount(input.ctx, acc)
 	}
 }
 
 func BenchmarkAccountMapperSetAccountWithCoins(b *testing.B) {
-	input := setupTestInput()
+	runEncodings(b, benchmarkAccountMapperSetAccountWithCoins)
+}
+
+func benchmarkAccountMapperSetAccountWithCoins(b *testing.B, encoding codec.Encoding) {
+	input := setupBenchInput(encoding)
 	coins := sdk.Coins{
-		sdk.NewCoin("LTC", sdk.NewInt(1000)),
-		sdk.NewCoin("BTC", sdk.NewInt(1000)),
-		sdk.NewCoin("ETH", sdk.NewInt(1000)),
-		sdk.NewCoin("XRP", sdk.NewInt(1000)),
-		sdk.NewCoin("BCH", sdk.NewInt(1000)),
-		sdk.NewCoin("EOS", sdk.NewInt(1000)),
+		sdk.NewCoin("ltc", sdk.NewInt(1000)),
+		sdk.NewCoin("btc", sdk.NewInt(1000)),
+		sdk.NewCoin("eth", sdk.NewInt(1000)),
+		sdk.NewCoin("xrp", sdk.NewInt(1000)),
+		sdk.NewCoin("bch", sdk.NewInt(1000)),
+		sdk.NewCoin("eos", sdk.NewInt(1000)),
 	}
 
 	b.ResetTimer()
 
 	// assumes b.N < 2**24
 	for i := 0; i < b.N; i++ {
-		arr := []byte{byte((i & 0xFF0000) >> 16), byte((i & 0xFF00) >> 8), byte(i & 0xFF)}
-		addr := sdk.AccAddress(arr)
+		addr := benchAddress(i)
 		acc := input.ak.NewAccountWithAddress(input.ctx, addr)
 		acc.SetCoins(coins)
 		input.ak.SetAccount(input.ctx, acc)
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/PhenixChain/PhenixChain/codec"
 	"github.com/PhenixChain/PhenixChain/x/params"
 )
 
@@ -27,6 +28,9 @@ var (
 	KeyTxSizeCostPerByte      = []byte("TxSizeCostPerByte")
 	KeySigVerifyCostED25519   = []byte("SigVerifyCostED25519")
 	KeySigVerifyCostSecp256k1 = []byte("SigVerifyCostSecp256k1")
+
+	// KeyEncoding is kept apart from Params, it is chosen once at genesis
+	KeyEncoding = []byte("Encoding")
 )
 
 var _ params.ParamSet = &Params{}
@@ -42,7 +46,8 @@ type Params struct {
 
 // ParamKeyTable for auth module
 func ParamKeyTable() params.KeyTable {
-	return params.NewKeyTable().RegisterParamSet(&Params{})
+	return params.NewKeyTable().RegisterParamSet(&Params{}).
+		RegisterType(KeyEncoding, codec.Encoding(""))
 }
 
 // ParamSetPairs implements the ParamSet interface and returns all the key/value pairs
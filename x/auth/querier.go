@@ -2,6 +2,7 @@ package auth
 
 import (
 	"fmt"
+	"time"
 
 	abci "github.com/tendermint/tendermint/abci/types"
 
@@ -11,7 +12,9 @@ import (
 
 // query endpoints supported by the auth Querier
 const (
-	QueryAccount = "account"
+	QueryAccount  = "account"
+	QueryVesting  = "vesting"
+	QueryEncoding = "encoding"
 )
 
 // creates a querier for auth REST endpoints
@@ -20,6 +23,10 @@ func NewQuerier(keeper AccountKeeper) sdk.Querier {
 		switch path[0] {
 		case QueryAccount:
 			return queryAccount(ctx, req, keeper)
+		case QueryVesting:
+			return queryVesting(ctx, req, keeper)
+		case QueryEncoding:
+			return queryEncoding(ctx, keeper)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown auth query endpoint")
 		}
@@ -55,3 +62,85 @@ func queryAccount(ctx sdk.Context, req abci.RequestQuery, keeper AccountKeeper)
 
 	return bz, nil
 }
+
+// VestingDetails is the vesting state of an account at a given block time
+type VestingDetails struct {
+	Address          sdk.AccAddress `json:"address"`
+	Time             time.Time      `json:"time"`
+	StartTime        int64          `json:"start_time"`
+	EndTime          int64          `json:"end_time"`
+	OriginalVesting  sdk.Coins      `json:"original_vesting"`
+	Vested           sdk.Coins      `json:"vested"`
+	Vesting          sdk.Coins      `json:"vesting"`
+	Spendable        sdk.Coins      `json:"spendable"`
+	DelegatedFree    sdk.Coins      `json:"delegated_free"`
+	DelegatedVesting sdk.Coins      `json:"delegated_vesting"`
+}
+
+// NewVestingDetails returns the vesting state of a vesting account at the given
+// block time
+func NewVestingDetails(acc VestingAccount, blockTime time.Time) VestingDetails {
+	return VestingDetails{
+		Address:          acc.GetAddress(),
+		Time:             blockTime,
+		StartTime:        acc.GetStartTime(),
+		EndTime:          acc.GetEndTime(),
+		OriginalVesting:  acc.GetOriginalVesting(),
+		Vested:           acc.GetVestedCoins(blockTime),
+		Vesting:          acc.GetVestingCoins(blockTime),
+		Spendable:        acc.SpendableCoins(blockTime),
+		DelegatedFree:    acc.GetDelegatedFree(),
+		DelegatedVesting: acc.GetDelegatedVesting(),
+	}
+}
+
+// String implements fmt.Stringer
+func (vd VestingDetails) String() string {
+	return fmt.Sprintf(`Vesting Details:
+  Address:          %s
+  Time:             %s
+  StartTime:        %d
+  EndTime:          %d
+  OriginalVesting:  %s
+  Vested:           %s
+  Vesting:          %s
+  Spendable:        %s
+  DelegatedFree:    %s
+  DelegatedVesting: %s`,
+		vd.Address, vd.Time, vd.StartTime, vd.EndTime, vd.OriginalVesting,
+		vd.Vested, vd.Vesting, vd.Spendable, vd.DelegatedFree, vd.DelegatedVesting,
+	)
+}
+
+func queryVesting(ctx sdk.Context, req abci.RequestQuery, keeper AccountKeeper) ([]byte, sdk.Error) {
+	var params QueryAccountParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	account := keeper.GetAccount(ctx, params.Address)
+	if account == nil {
+		return nil, sdk.ErrUnknownAddress(fmt.Sprintf("account %s does not exist", params.Address))
+	}
+
+	vacc, ok := account.(VestingAccount)
+	if !ok {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("account %s is not a vesting account", params.Address))
+	}
+
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, NewVestingDetails(vacc, ctx.BlockHeader().Time))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return bz, nil
+}
+
+func queryEncoding(ctx sdk.Context, keeper AccountKeeper) ([]byte, sdk.Error) {
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, keeper.GetEncoding(ctx))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return bz, nil
+}
@@ -3,9 +3,12 @@ package auth
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/PhenixChain/PhenixChain/types"
 )
 
 func Test_queryAccount(t *testing.T) {
@@ -39,3 +42,37 @@ func Test_queryAccount(t *testing.T) {
 	err2 := input.cdc.UnmarshalJSON(res, &account)
 	require.Nil(t, err2)
 }
+
+func Test_queryVesting(t *testing.T) {
+	input := setupTestInput()
+	req := abci.RequestQuery{
+		Path: fmt.Sprintf("custom/%s/%s", QuerierRoute, QueryVesting),
+	}
+
+	_, _, addr := keyPubAddr()
+	req.Data = input.cdc.MustMarshalJSON(NewQueryAccountParams(addr))
+	res, err := queryVesting(input.ctx, req, input.ak)
+	require.NotNil(t, err)
+	require.Nil(t, res)
+
+	// plain accounts have no vesting details
+	bacc := NewBaseAccountWithAddress(addr)
+	bacc.SetCoins(sdk.Coins{sdk.NewInt64Coin(stakeDenom, 100)})
+	input.ak.SetAccount(input.ctx, &bacc)
+	res, err = queryVesting(input.ctx, req, input.ak)
+	require.NotNil(t, err)
+	require.Nil(t, res)
+
+	now := time.Unix(1000, 0)
+	ctx := input.ctx.WithBlockTime(now)
+	input.ak.SetAccount(ctx, NewContinuousVestingAccount(&bacc, 500, 1500))
+	res, err = queryVesting(ctx, req, input.ak)
+	require.Nil(t, err)
+
+	var details VestingDetails
+	require.Nil(t, input.cdc.UnmarshalJSON(res, &details))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}, details.Vested)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}, details.Vesting)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}, details.Spendable)
+	require.Equal(t, int64(1500), details.EndTime)
+}
@@ -200,8 +200,20 @@ type StdSignature struct {
 	Signature     []byte           `json:"signature"`
 }
 
-// DefaultTxDecoder logic for standard transaction decoding
+// DefaultTxDecoder logic for standard transaction decoding of JSON encoded
+// transactions
 func DefaultTxDecoder(cdc *codec.Codec) sdk.TxDecoder {
+	return NewTxDecoder(codec.NewEncoder(cdc, codec.EncodingJSON))
+}
+
+// DefaultTxEncoder logic for standard transaction encoding to JSON
+func DefaultTxEncoder(cdc *codec.Codec) sdk.TxEncoder {
+	return NewTxEncoder(codec.NewEncoder(cdc, codec.EncodingJSON))
+}
+
+// NewTxDecoder returns a decoder of standard transactions serialized in the
+// encoding of enc
+func NewTxDecoder(enc *codec.Encoder) sdk.TxDecoder {
 	return func(txBytes []byte) (sdk.Tx, sdk.Error) {
 		var tx = StdTx{}
 
@@ -211,8 +223,7 @@ func DefaultTxDecoder(cdc *codec.Codec) sdk.TxDecoder {
 
 		// StdTx.Msg is an interface. The concrete types
 		// are registered by MakeTxCodec
-		// nikolas
-		err := cdc.UnmarshalJSON(txBytes, &tx)
+		err := enc.UnmarshalLengthPrefixed(txBytes, &tx)
 		if err != nil {
 			return nil, sdk.ErrTxDecode("error decoding transaction").TraceSDK(err.Error())
 		}
@@ -221,10 +232,10 @@ func DefaultTxDecoder(cdc *codec.Codec) sdk.TxDecoder {
 	}
 }
 
-// DefaultTxEncoder logic for standard transaction encoding
-func DefaultTxEncoder(cdc *codec.Codec) sdk.TxEncoder {
+// NewTxEncoder returns an encoder of standard transactions serializing them in
+// the encoding of enc
+func NewTxEncoder(enc *codec.Encoder) sdk.TxEncoder {
 	return func(tx sdk.Tx) ([]byte, error) {
-		// nikolas
-		return cdc.MarshalJSON(tx)
+		return enc.MarshalLengthPrefixed(tx)
 	}
 }
@@ -150,3 +150,36 @@ func TestDefaultTxEncoder(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, cdcBytes, encoderBytes)
 }
+
+func TestTxEncoding(t *testing.T) {
+	cdc := codec.New()
+	sdk.RegisterCodec(cdc)
+	RegisterCodec(cdc)
+	cdc.RegisterConcrete(&sdk.TestMsg{}, "cosmos-sdk/Test", nil)
+
+	tx := NewStdTx([]sdk.Msg{sdk.NewTestMsg(addr)}, newStdFee(), []StdSignature{}, "")
+
+	jsonBytes, err := cdc.MarshalJSON(tx)
+	require.NoError(t, err)
+	binaryBytes, err := cdc.MarshalBinaryLengthPrefixed(tx)
+	require.NoError(t, err)
+
+	enc := codec.NewEncoder(cdc, codec.EncodingJSON)
+	encoded, err := NewTxEncoder(enc)(tx)
+	require.NoError(t, err)
+	require.Equal(t, jsonBytes, encoded)
+
+	// switching the encoding applies to encoders and decoders created before
+	enc.SetEncoding(codec.EncodingBinary)
+	encoded, err = NewTxEncoder(enc)(tx)
+	require.NoError(t, err)
+	require.Equal(t, binaryBytes, encoded)
+
+	decoded, err := NewTxDecoder(enc)(binaryBytes)
+	require.NoError(t, err)
+	require.Equal(t, tx.Fee, decoded.(StdTx).Fee)
+	require.Len(t, decoded.GetMsgs(), 1)
+
+	_, err = NewTxDecoder(enc)(jsonBytes)
+	require.Error(t, err)
+}
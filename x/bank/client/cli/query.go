@@ -0,0 +1,128 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/PhenixChain/PhenixChain/client"
+	"github.com/PhenixChain/PhenixChain/client/context"
+	"github.com/PhenixChain/PhenixChain/codec"
+	sdk "github.com/PhenixChain/PhenixChain/types"
+	"github.com/PhenixChain/PhenixChain/x/bank"
+	"github.com/PhenixChain/PhenixChain/x/bank/client/common"
+)
+
+const (
+	flagDisplay = "display"
+)
+
+// GetDenomMetadataCmd returns a command to query the metadata of the
+// denominations known to the chain.
+func GetDenomMetadataCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "denom-metadata",
+		Short: "Query the base and display units of the chain's denominations",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			metadata, err := common.QueryDenomMetadata(cliCtx, cdc)
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(denomMetadataOutput(metadata))
+		},
+	}
+	return client.GetCommands(cmd)[0]
+}
+
+// GetBalanceCmd returns a command to query the balance of an account,
+// optionally rendered in display units.
+func GetBalanceCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "balance [address]",
+		Short: "Query account balance",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).WithAccountDecoder(cdc)
+
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			acc, err := cliCtx.GetAccount(addr)
+			if err != nil {
+				return err
+			}
+
+			if !viper.GetBool(flagDisplay) {
+				return cliCtx.PrintOutput(acc.GetCoins())
+			}
+
+			metadata, err := common.QueryDenomMetadata(cliCtx, cdc)
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(sdk.ConvertCoinsToDisplay(acc.GetCoins(), metadata))
+		},
+	}
+	cmd.Flags().Bool(flagDisplay, false, "Show amounts in display denominations (e.g. 1.5pnx instead of 1500000upnx)")
+	return client.GetCommands(cmd)[0]
+}
+
+// GetSupplyCmd returns a command to query the total supply of all coins or
+// of a single denomination.
+func GetSupplyCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "supply [denom]",
+		Short: "Query the total supply of coins",
+		Long: `Query the total supply of all coins, or of a single denomination if one is
+given. The supply includes coins held outside of accounts, e.g. collected fees.`,
+		Args: cobra.RangeArgs(0, 1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", bank.QuerierRoute, bank.QuerySupply)
+			if len(args) > 0 {
+				route = fmt.Sprintf("%s/%s", route, args[0])
+			}
+
+			res, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			if len(args) > 0 {
+				var supply sdk.Coin
+				if err := cdc.UnmarshalJSON(res, &supply); err != nil {
+					return err
+				}
+				return cliCtx.PrintOutput(supply)
+			}
+
+			var supply sdk.Coins
+			if err := cdc.UnmarshalJSON(res, &supply); err != nil {
+				return err
+			}
+			return cliCtx.PrintOutput(supply)
+		},
+	}
+	return client.GetCommands(cmd)[0]
+}
+
+type denomMetadataOutput []sdk.DenomMetadata
+
+func (out denomMetadataOutput) String() string {
+	s := make([]string, len(out))
+	for i, m := range out {
+		s[i] = m.String()
+	}
+	return strings.Join(s, "\n")
+}
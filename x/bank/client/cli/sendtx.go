@@ -10,8 +10,10 @@ import (
 	sdk "github.com/PhenixChain/PhenixChain/types"
 	authtxb "github.com/PhenixChain/PhenixChain/x/auth/client/txbuilder"
 	"github.com/PhenixChain/PhenixChain/x/bank"
+	"github.com/PhenixChain/PhenixChain/x/bank/client/common"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 )
 
 const (
@@ -24,42 +26,70 @@ func SendTxCmd(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "send [to_address] [amount]",
 		Short: "Create and sign a send tx",
-		Args:  cobra.ExactArgs(2),
+		Long: `Create and sign a send tx. The amount and the --fees flag accept base
+denominations (e.g. 1500000upnx) as well as display denominations registered in
+the chain's denom metadata, which may have a fractional part (e.g. 1.5pnx).`,
+		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			txBldr := authtxb.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
 			cliCtx := context.NewCLIContext().
 				WithCodec(cdc).
 				WithAccountDecoder(cdc)
 
-			if err := cliCtx.EnsureAccountExists(); err != nil {
-				return err
+			// an unsigned tx can be generated without reaching out to a node,
+			// e.g. for a multisig account whose signers are offline, unless
+			// the signer sequence must be looked up for a sign request
+			offline := cliCtx.GenerateOnly &&
+				(viper.GetString(client.FlagSignRequest) == "" || cmd.Flags().Changed(client.FlagSequence))
+
+			// amounts may be given in display denominations, e.g. 1.5pnx, which
+			// are only known to the node, so base denominations are expected offline
+			parseCoins := func(coinsStr string) (sdk.Coins, error) {
+				if offline {
+					return sdk.ParseCoins(coinsStr)
+				}
+				return common.ParseCoins(cliCtx, cdc, coinsStr)
 			}
 
-			to, err := sdk.AccAddressFromBech32(args[0])
+			// the TxBuilder cannot parse fees in display denominations from the flag
+			fees, err := parseCoins(viper.GetString(client.FlagFees))
 			if err != nil {
 				return err
 			}
 
-			// parse coins trying to be sent
-			coins, err := sdk.ParseCoins(args[1])
+			txBldr := authtxb.NewTxBuilderFromCLIWithFees(fees).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			to, err := sdk.AccAddressFromBech32(args[0])
 			if err != nil {
 				return err
 			}
 
-			from := cliCtx.GetFromAddress()
-			account, err := cliCtx.GetAccount(from)
+			// parse coins trying to be sent, e.g. 1000upnx or 1.5pnx
+			coins, err := parseCoins(args[1])
 			if err != nil {
 				return err
 			}
 
-			// ensure account has enough coins
-			if !account.GetCoins().IsAllGTE(coins) {
-				return fmt.Errorf("address %s doesn't have enough coins to pay for this transaction", from)
+			from := cliCtx.GetFromAddress()
+
+			if !cliCtx.GenerateOnly {
+				if err := cliCtx.EnsureAccountExists(); err != nil {
+					return err
+				}
+
+				account, err := cliCtx.GetAccount(from)
+				if err != nil {
+					return err
+				}
+
+				// ensure account has enough coins
+				if !account.GetCoins().IsAllGTE(coins) {
+					return fmt.Errorf("address %s doesn't have enough coins to pay for this transaction", from)
+				}
 			}
 
 			// build and sign the transaction, then broadcast to Tendermint
 			msg := bank.NewMsgSend(from, to, coins)
-			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg}, false)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg}, offline)
 		},
 	}
 
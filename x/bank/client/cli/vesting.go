@@ -0,0 +1,73 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/PhenixChain/PhenixChain/client"
+	"github.com/PhenixChain/PhenixChain/client/context"
+	"github.com/PhenixChain/PhenixChain/client/utils"
+	"github.com/PhenixChain/PhenixChain/codec"
+	sdk "github.com/PhenixChain/PhenixChain/types"
+	authtxb "github.com/PhenixChain/PhenixChain/x/auth/client/txbuilder"
+	"github.com/PhenixChain/PhenixChain/x/bank"
+	"github.com/PhenixChain/PhenixChain/x/bank/client/common"
+)
+
+const (
+	flagStartTime = "start-time"
+	flagDelayed   = "delayed"
+)
+
+// CreateVestingAccountCmd will create a tx creating a vesting account and sign
+// it with the given key.
+func CreateVestingAccountCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-vesting-account [to_address] [amount] [end_time]",
+		Short: "Create and sign a tx creating a vesting account funded by the sender",
+		Long: `Create a new account at to_address holding amount, which vests linearly
+from --start-time until end_time, both unix timestamps. With --delayed all coins
+vest at once at end_time. The account must not exist yet.`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithAccountDecoder(cdc)
+
+			txBldr := authtxb.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			to, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			coins, err := common.ParseCoins(cliCtx, cdc, args[1])
+			if err != nil {
+				return err
+			}
+
+			endTime, err := strconv.ParseInt(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := bank.NewMsgCreateVestingAccount(cliCtx.GetFromAddress(), to, coins,
+				viper.GetInt64(flagStartTime), endTime, viper.GetBool(flagDelayed))
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg}, false)
+		},
+	}
+
+	cmd.Flags().Int64(flagStartTime, 0, "Unix time the coins start to vest, ignored with --delayed")
+	cmd.Flags().Bool(flagDelayed, false, "Vest all coins at once at the end time")
+
+	cmd = client.PostCommands(cmd)[0]
+	cmd.MarkFlagRequired(client.FlagFrom)
+
+	return cmd
+}
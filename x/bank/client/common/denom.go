@@ -0,0 +1,46 @@
+package common
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/PhenixChain/PhenixChain/client/context"
+	"github.com/PhenixChain/PhenixChain/codec"
+	sdk "github.com/PhenixChain/PhenixChain/types"
+	"github.com/PhenixChain/PhenixChain/x/bank"
+)
+
+// QueryDenomMetadata queries the metadata of the denominations known to the
+// chain.
+func QueryDenomMetadata(cliCtx context.CLIContext, cdc *codec.Codec) ([]sdk.DenomMetadata, error) {
+	route := fmt.Sprintf("custom/%s/%s", bank.QuerierRoute, bank.QueryDenomMetadata)
+
+	res, err := cliCtx.QueryWithData(route, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var metadata []sdk.DenomMetadata
+	if err := cdc.UnmarshalJSON(res, &metadata); err != nil {
+		return nil, err
+	}
+
+	return metadata, nil
+}
+
+// ParseCoins parses coins which may be expressed in display denominations
+// (e.g. 1.5pnx) and converts them to base denominations. The denom metadata
+// must be queried to tell display from base denominations, so it fails if
+// the node cannot be reached.
+func ParseCoins(cliCtx context.CLIContext, cdc *codec.Codec, coinsStr string) (sdk.Coins, error) {
+	if strings.TrimSpace(coinsStr) == "" {
+		return nil, nil
+	}
+
+	metadata, err := QueryDenomMetadata(cliCtx, cdc)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query the denom metadata to parse %s: %v", coinsStr, err)
+	}
+
+	return sdk.ParseCoinsWithMetadata(coinsStr, metadata)
+}
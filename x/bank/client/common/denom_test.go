@@ -0,0 +1,22 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/PhenixChain/PhenixChain/client/context"
+	"github.com/PhenixChain/PhenixChain/codec"
+)
+
+func TestParseCoinsWithoutNode(t *testing.T) {
+	cliCtx := context.CLIContext{}
+
+	// without the denom metadata 1pnx could be taken for a single base unit
+	_, err := ParseCoins(cliCtx, codec.New(), "1pnx")
+	require.Error(t, err)
+
+	coins, err := ParseCoins(cliCtx, codec.New(), " ")
+	require.NoError(t, err)
+	require.Nil(t, coins)
+}
@@ -0,0 +1,99 @@
+package rest
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"github.com/PhenixChain/PhenixChain/client/context"
+	"github.com/PhenixChain/PhenixChain/codec"
+	sdk "github.com/PhenixChain/PhenixChain/types"
+	"github.com/PhenixChain/PhenixChain/types/rest"
+	"github.com/PhenixChain/PhenixChain/x/bank"
+	"github.com/PhenixChain/PhenixChain/x/bank/client/common"
+)
+
+func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Codec) {
+	r.HandleFunc(
+		"/bank/denom_metadata",
+		queryDenomMetadataHandlerFn(cdc, cliCtx),
+	).Methods("GET")
+
+	r.HandleFunc(
+		"/bank/balances/{address}",
+		queryBalancesHandlerFn(cdc, cliCtx),
+	).Methods("GET")
+
+	r.HandleFunc(
+		"/bank/supply",
+		querySupplyHandlerFn(cdc, cliCtx),
+	).Methods("GET")
+
+	r.HandleFunc(
+		"/bank/supply/{denom}",
+		querySupplyHandlerFn(cdc, cliCtx),
+	).Methods("GET")
+}
+
+func queryDenomMetadataHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		metadata, err := common.QueryDenomMetadata(cliCtx, cdc)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cdc, metadata, cliCtx.Indent)
+	}
+}
+
+// queryBalancesHandlerFn returns the coins of an account. Amounts are rendered
+// in display denominations if the display query parameter is true.
+func queryBalancesHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		addr, err := sdk.AccAddressFromBech32(mux.Vars(r)["address"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		acc, err := cliCtx.GetAccount(addr)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		if r.URL.Query().Get("display") != "true" {
+			rest.PostProcessResponse(w, cdc, acc.GetCoins(), cliCtx.Indent)
+			return
+		}
+
+		metadata, err := common.QueryDenomMetadata(cliCtx, cdc)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cdc, sdk.ConvertCoinsToDisplay(acc.GetCoins(), metadata), cliCtx.Indent)
+	}
+}
+
+// querySupplyHandlerFn returns the total supply of all coins, or of a single
+// denomination if one is given in the path.
+func querySupplyHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		route := fmt.Sprintf("custom/%s/%s", bank.QuerierRoute, bank.QuerySupply)
+		if denom := mux.Vars(r)["denom"]; denom != "" {
+			route = fmt.Sprintf("%s/%s", route, denom)
+		}
+
+		res, err := cliCtx.QueryWithData(route, nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+	}
+}
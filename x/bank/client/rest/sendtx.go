@@ -13,17 +13,24 @@ import (
 	"github.com/PhenixChain/PhenixChain/types/rest"
 
 	"github.com/PhenixChain/PhenixChain/x/bank"
+	"github.com/PhenixChain/PhenixChain/x/bank/client/common"
 )
 
 // RegisterRoutes - Central function to define routes that get registered by the main application
 func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Codec, kb keys.Keybase) {
 	r.HandleFunc("/bank/accounts/{address}/transfers", SendRequestHandlerFn(cdc, kb, cliCtx)).Methods("POST")
+	r.HandleFunc("/bank/accounts/{address}/vesting", CreateVestingAccountRequestHandlerFn(cdc, cliCtx)).Methods("POST")
+	registerQueryRoutes(cliCtx, r, cdc)
 }
 
-// SendReq defines the properties of a send request's body.
+// SendReq defines the properties of a send request's body. DisplayAmount and
+// DisplayFees optionally replace the amount and the base request fees with
+// coins that may be expressed in display denominations, e.g. "1.5pnx".
 type SendReq struct {
-	BaseReq rest.BaseReq `json:"base_req"`
-	Amount  sdk.Coins    `json:"amount"`
+	BaseReq       rest.BaseReq `json:"base_req"`
+	Amount        sdk.Coins    `json:"amount"`
+	DisplayAmount string       `json:"display_amount,omitempty"`
+	DisplayFees   string       `json:"display_fees,omitempty"`
 }
 
 var msgCdc = codec.New()
@@ -49,6 +56,22 @@ func SendRequestHandlerFn(cdc *codec.Codec, kb keys.Keybase, cliCtx context.CLIC
 			return
 		}
 
+		if req.DisplayAmount != "" {
+			req.Amount, err = common.ParseCoins(cliCtx, cdc, req.DisplayAmount)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+		}
+
+		if req.DisplayFees != "" {
+			req.BaseReq.Fees, err = common.ParseCoins(cliCtx, cdc, req.DisplayFees)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+		}
+
 		req.BaseReq = req.BaseReq.Sanitize()
 		if !req.BaseReq.ValidateBasic(w) {
 			return
@@ -64,3 +87,49 @@ func SendRequestHandlerFn(cdc *codec.Codec, kb keys.Keybase, cliCtx context.CLIC
 		clientrest.WriteGenerateStdTxResponse(w, cdc, cliCtx, req.BaseReq, []sdk.Msg{msg})
 	}
 }
+
+// CreateVestingAccountReq defines the properties of a request body creating a
+// vesting account.
+type CreateVestingAccountReq struct {
+	BaseReq   rest.BaseReq `json:"base_req"`
+	Amount    sdk.Coins    `json:"amount"`
+	StartTime int64        `json:"start_time"`
+	EndTime   int64        `json:"end_time"`
+	Delayed   bool         `json:"delayed"`
+}
+
+// CreateVestingAccountRequestHandlerFn - http request handler to create a
+// vesting account at an address.
+func CreateVestingAccountRequestHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		toAddr, err := sdk.AccAddressFromBech32(mux.Vars(r)["address"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		var req CreateVestingAccountReq
+		if !rest.ReadRESTReq(w, r, cdc, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := bank.NewMsgCreateVestingAccount(fromAddr, toAddr, req.Amount, req.StartTime, req.EndTime, req.Delayed)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		clientrest.WriteGenerateStdTxResponse(w, cdc, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
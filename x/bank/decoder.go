@@ -0,0 +1,41 @@
+package bank
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/PhenixChain/PhenixChain/codec"
+	sdk "github.com/PhenixChain/PhenixChain/types"
+)
+
+// DecodeStore decodes a key/value pair of the bank store into JSON
+func DecodeStore(cdc *codec.Codec, key, value []byte) (json.RawMessage, error) {
+	switch {
+	case bytes.Equal(key, supplyKey):
+		var supply sdk.Coins
+		if err := cdc.UnmarshalBinaryLengthPrefixed(value, &supply); err != nil {
+			return nil, err
+		}
+		return cdc.MarshalJSON(supply)
+
+	default:
+		return nil, fmt.Errorf("unknown key %X", key)
+	}
+}
+
+// DecodeTxStore decodes a key/value pair of the transaction history store
+// into JSON
+func DecodeTxStore(cdc *codec.Codec, key, value []byte) (json.RawMessage, error) {
+	switch {
+	case bytes.HasPrefix(key, addressTxsKeyPrefix):
+		history := AddressTxs{Address: sdk.AccAddress(key[len(addressTxsKeyPrefix):])}
+		if err := codec.UnmarshalAnyEncoding(cdc, value, &history.Txs); err != nil {
+			return nil, err
+		}
+		return cdc.MarshalJSON(history)
+
+	default:
+		return nil, fmt.Errorf("unknown key %X", key)
+	}
+}
@@ -1,6 +1,8 @@
 package bank
 
 import (
+	"fmt"
+
 	sdk "github.com/PhenixChain/PhenixChain/types"
 )
 
@@ -10,6 +12,8 @@ const (
 
 	CodeSendDisabled         sdk.CodeType = 101
 	CodeInvalidInputsOutputs sdk.CodeType = 102
+	CodeAccountExists        sdk.CodeType = 103
+	CodeInvalidVesting       sdk.CodeType = 104
 )
 
 // ErrNoInputs is an error
@@ -31,3 +35,13 @@ func ErrInputOutputMismatch(codespace sdk.CodespaceType) sdk.Error {
 func ErrSendDisabled(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeSendDisabled, "send transactions are currently disabled")
 }
+
+// ErrAccountExists is an error
+func ErrAccountExists(codespace sdk.CodespaceType, addr sdk.AccAddress) sdk.Error {
+	return sdk.NewError(codespace, CodeAccountExists, fmt.Sprintf("account %s already exists", addr))
+}
+
+// ErrInvalidVestingSchedule is an error
+func ErrInvalidVestingSchedule(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidVesting, msg)
+}
@@ -1,32 +1,74 @@
 package bank
 
 import (
+	"fmt"
+
 	sdk "github.com/PhenixChain/PhenixChain/types"
 )
 
 // GenesisState is the bank state that must be provided at genesis.
 type GenesisState struct {
-	SendEnabled bool `json:"send_enabled"`
+	SendEnabled   bool                `json:"send_enabled"`
+	DenomMetadata []sdk.DenomMetadata `json:"denom_metadata"`
+	Supply        sdk.Coins           `json:"supply"`
+	TxHistory     []AddressTxs        `json:"tx_history"`
 }
 
 // NewGenesisState creates a new genesis state.
-func NewGenesisState(sendEnabled bool) GenesisState {
-	return GenesisState{SendEnabled: sendEnabled}
+func NewGenesisState(sendEnabled bool, denomMetadata []sdk.DenomMetadata, supply sdk.Coins,
+	txHistory []AddressTxs) GenesisState {
+
+	return GenesisState{
+		SendEnabled:   sendEnabled,
+		DenomMetadata: denomMetadata,
+		Supply:        supply,
+		TxHistory:     txHistory,
+	}
 }
 
-// DefaultGenesisState returns a default genesis state
-func DefaultGenesisState() GenesisState { return NewGenesisState(true) }
+// DefaultGenesisState returns a default genesis state. An empty supply is
+// computed from the genesis balances when the chain starts.
+func DefaultGenesisState() GenesisState {
+	return NewGenesisState(true, []sdk.DenomMetadata{}, sdk.NewCoins(), []AddressTxs{})
+}
 
 // InitGenesis sets distribution information for genesis.
-func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) {
+func InitGenesis(ctx sdk.Context, keeper Keeper, tk TxKeeper, data GenesisState) {
 	keeper.SetSendEnabled(ctx, data.SendEnabled)
+	keeper.SetDenomMetadata(ctx, data.DenomMetadata)
+	keeper.SetSupply(ctx, data.Supply)
+	for _, history := range data.TxHistory {
+		tk.SetAddressTxs(ctx, history.Address, history.Txs)
+	}
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
-func ExportGenesis(ctx sdk.Context, keeper Keeper) GenesisState {
-	return NewGenesisState(keeper.GetSendEnabled(ctx))
+func ExportGenesis(ctx sdk.Context, keeper Keeper, tk TxKeeper) GenesisState {
+	txHistory := []AddressTxs{}
+	tk.IterateAddressTxs(ctx, func(history AddressTxs) (stop bool) {
+		txHistory = append(txHistory, history)
+		return false
+	})
+	return NewGenesisState(keeper.GetSendEnabled(ctx), keeper.GetDenomMetadata(ctx), keeper.GetSupply(ctx), txHistory)
 }
 
 // ValidateGenesis performs basic validation of bank genesis data returning an
 // error for any failed validation criteria.
-func ValidateGenesis(data GenesisState) error { return nil }
+func ValidateGenesis(data GenesisState) error {
+	if !data.Supply.IsValid() {
+		return fmt.Errorf("invalid total supply: %s", data.Supply)
+	}
+
+	seen := make(map[string]bool)
+	for _, history := range data.TxHistory {
+		if history.Address.Empty() {
+			return fmt.Errorf("tx history without an address")
+		}
+		if seen[history.Address.String()] {
+			return fmt.Errorf("duplicate tx history for %s", history.Address)
+		}
+		seen[history.Address.String()] = true
+	}
+
+	return sdk.ValidateDenomMetadata(data.DenomMetadata)
+}
@@ -12,6 +12,8 @@ func NewHandler(k Keeper) sdk.Handler {
 			return handleMsgSend(ctx, k, msg)
 		case MsgMultiSend:
 			return handleMsgMultiSend(ctx, k, msg)
+		case MsgCreateVestingAccount:
+			return handleMsgCreateVestingAccount(ctx, k, msg)
 		default:
 			errMsg := "Unrecognized bank Msg type: %s" + msg.Type()
 			return sdk.ErrUnknownRequest(errMsg).Result()
@@ -49,3 +51,19 @@ func handleMsgMultiSend(ctx sdk.Context, k Keeper, msg MsgMultiSend) sdk.Result
 		Tags: tags,
 	}
 }
+
+// Handle MsgCreateVestingAccount.
+func handleMsgCreateVestingAccount(ctx sdk.Context, k Keeper, msg MsgCreateVestingAccount) sdk.Result {
+	if !k.GetSendEnabled(ctx) {
+		return ErrSendDisabled(k.Codespace()).Result()
+	}
+	tags, err := k.CreateVestingAccount(ctx, msg.FromAddress, msg.ToAddress, msg.Amount,
+		msg.StartTime, msg.EndTime, msg.Delayed)
+	if err != nil {
+		return err.Result()
+	}
+
+	return sdk.Result{
+		Tags: tags,
+	}
+}
@@ -8,10 +8,15 @@ import (
 	"github.com/PhenixChain/PhenixChain/x/auth"
 )
 
-// register bank invariants
-func RegisterInvariants(c CrisisKeeper, ak auth.AccountKeeper) {
+// register bank invariants, heldElsewhereFn returns the coins held outside
+// of accounts (e.g. collected fees)
+func RegisterInvariants(c CrisisKeeper, k Keeper, ak auth.AccountKeeper,
+	heldElsewhereFn func(sdk.Context) sdk.Coins) {
+
 	c.RegisterRoute("bank", "nonnegative-outstanding",
 		NonnegativeBalanceInvariant(ak))
+	c.RegisterRoute("bank", "total-supply",
+		TotalSupplyInvariant(k, ak, heldElsewhereFn))
 }
 
 // NonnegativeBalanceInvariant checks that all accounts in the application have non-negative balances
@@ -49,3 +54,26 @@ func TotalCoinsInvariant(ak auth.AccountKeeper, totalSupplyFn func() sdk.Coins)
 		return nil
 	}
 }
+
+// TotalSupplyInvariant checks that the total supply recorded in the bank
+// state equals the sum of the coins across all accounts plus the coins held
+// outside of accounts
+func TotalSupplyInvariant(k Keeper, ak auth.AccountKeeper,
+	heldElsewhereFn func(sdk.Context) sdk.Coins) sdk.Invariant {
+
+	return func(ctx sdk.Context) error {
+		totalCoins := heldElsewhereFn(ctx)
+
+		ak.IterateAccounts(ctx, func(acc auth.Account) bool {
+			totalCoins = totalCoins.Add(acc.GetCoins())
+			return false
+		})
+
+		supply := k.GetSupply(ctx)
+		if diff, _ := supply.SafeSub(totalCoins); !diff.IsZero() {
+			return fmt.Errorf("total supply %s doesn't equal the sum of all coins %s",
+				supply, totalCoins)
+		}
+		return nil
+	}
+}
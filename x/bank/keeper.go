@@ -3,9 +3,11 @@ package bank
 import (
 	"encoding/hex"
 	"fmt"
+	"math/big"
 	"time"
 
 	"github.com/PhenixChain/PhenixChain/codec"
+	"github.com/PhenixChain/PhenixChain/telemetry"
 	sdk "github.com/PhenixChain/PhenixChain/types"
 	"github.com/PhenixChain/PhenixChain/x/auth"
 	"github.com/PhenixChain/PhenixChain/x/params"
@@ -23,9 +25,21 @@ type Keeper interface {
 	SubtractCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) (sdk.Coins, sdk.Tags, sdk.Error)
 	AddCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) (sdk.Coins, sdk.Tags, sdk.Error)
 	InputOutputCoins(ctx sdk.Context, inputs []Input, outputs []Output) (sdk.Tags, sdk.Error)
+	CreateVestingAccount(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins,
+		startTime, endTime int64, delayed bool) (sdk.Tags, sdk.Error)
 
 	DelegateCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) (sdk.Tags, sdk.Error)
 	UndelegateCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) (sdk.Tags, sdk.Error)
+
+	GetDenomMetadata(ctx sdk.Context) []sdk.DenomMetadata
+	SetDenomMetadata(ctx sdk.Context, metadata []sdk.DenomMetadata)
+
+	GetSupply(ctx sdk.Context) sdk.Coins
+	SetSupply(ctx sdk.Context, supply sdk.Coins)
+	InflateSupply(ctx sdk.Context, amt sdk.Coins)
+	DeflateSupply(ctx sdk.Context, amt sdk.Coins)
+	MintCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) (sdk.Tags, sdk.Error)
+	BurnCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) (sdk.Tags, sdk.Error)
 }
 
 // BaseKeeper manages transfers between accounts. It implements the Keeper interface.
@@ -34,12 +48,13 @@ type BaseKeeper struct {
 
 	ak         auth.AccountKeeper
 	tk         TxKeeper
+	storeKey   sdk.StoreKey
 	paramSpace params.Subspace
 }
 
 // NewBaseKeeper returns a new BaseKeeper
 func NewBaseKeeper(ak auth.AccountKeeper, tk TxKeeper,
-	paramSpace params.Subspace,
+	key sdk.StoreKey, paramSpace params.Subspace,
 	codespace sdk.CodespaceType) BaseKeeper {
 
 	ps := paramSpace.WithKeyTable(ParamKeyTable())
@@ -47,10 +62,18 @@ func NewBaseKeeper(ak auth.AccountKeeper, tk TxKeeper,
 		BaseSendKeeper: NewBaseSendKeeper(ak, tk, ps, codespace),
 		ak:             ak,
 		tk:             tk,
+		storeKey:       key,
 		paramSpace:     ps,
 	}
 }
 
+// WithMetrics returns a copy of the keeper reporting the transfer volume to
+// the given metrics
+func (keeper BaseKeeper) WithMetrics(metrics *telemetry.Metrics) BaseKeeper {
+	keeper.metrics = metrics
+	return keeper
+}
+
 // SetCoins sets the coins at the addr.
 func (keeper BaseKeeper) SetCoins(
 	ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins,
@@ -119,6 +142,18 @@ func (keeper BaseKeeper) UndelegateCoins(
 	return undelegateCoins(ctx, keeper.ak, addr, amt)
 }
 
+// GetDenomMetadata returns the metadata of the denominations known to the chain.
+func (keeper BaseKeeper) GetDenomMetadata(ctx sdk.Context) []sdk.DenomMetadata {
+	metadata := []sdk.DenomMetadata{}
+	keeper.paramSpace.GetIfExists(ctx, ParamStoreKeyDenomMetadata, &metadata)
+	return metadata
+}
+
+// SetDenomMetadata sets the metadata of the denominations known to the chain.
+func (keeper BaseKeeper) SetDenomMetadata(ctx sdk.Context, metadata []sdk.DenomMetadata) {
+	keeper.paramSpace.Set(ctx, ParamStoreKeyDenomMetadata, &metadata)
+}
+
 // SendKeeper defines a module interface that facilitates the transfer of coins
 // between accounts without the possibility of creating coins.
 type SendKeeper interface {
@@ -140,6 +175,7 @@ type BaseSendKeeper struct {
 	ak         auth.AccountKeeper
 	tk         TxKeeper
 	paramSpace params.Subspace
+	metrics    *telemetry.Metrics
 }
 
 // NewBaseSendKeeper returns a new BaseSendKeeper.
@@ -151,6 +187,7 @@ func NewBaseSendKeeper(ak auth.AccountKeeper, tk TxKeeper,
 		ak:             ak,
 		tk:             tk,
 		paramSpace:     paramSpace,
+		metrics:        telemetry.NopMetrics(),
 	}
 }
 
@@ -283,9 +320,9 @@ func subtractCoins(ctx sdk.Context, ak auth.AccountKeeper, tk TxKeeper, addr sdk
 	err := setCoins(ctx, ak, addr, newCoins)
 	// nikolas
 	storeAddressTxHash(ctx, tk, addr)
-	tags := sdk.NewTags(TagKeySender, addr.String())
 
-	return newCoins, tags, err
+	// spenders sign the message, which tags them as its senders
+	return newCoins, sdk.EmptyTags(), err
 }
 
 // AddCoins adds amt to the coins at the addr.
@@ -330,6 +367,7 @@ func sendCoins(ctx sdk.Context, bsk BaseSendKeeper, fromAddr sdk.AccAddress, toA
 		return nil, err
 	}
 
+	recordTransfer(ctx, bsk.metrics, amt)
 	return subTags.AppendTags(addTags), nil
 }
 
@@ -358,11 +396,23 @@ func inputOutputCoins(ctx sdk.Context, bk BaseKeeper, inputs []Input, outputs []
 			return nil, err
 		}
 		allTags = allTags.AppendTags(tags)
+		recordTransfer(ctx, bk.metrics, out.Coins)
 	}
 
 	return allTags, nil
 }
 
+// recordTransfer reports transferred coins to the metrics. Transfers are only
+// counted once the transaction making them succeeded.
+func recordTransfer(ctx sdk.Context, metrics *telemetry.Metrics, amt sdk.Coins) {
+	telemetry.RecordOnSuccess(ctx, func() {
+		for _, coin := range amt {
+			volume, _ := new(big.Float).SetInt(coin.Amount.BigInt()).Float64()
+			metrics.BankTransferVolume.With("denom", coin.Denom).Add(volume)
+		}
+	})
+}
+
 func delegateCoins(
 	ctx sdk.Context, ak auth.AccountKeeper, addr sdk.AccAddress, amt sdk.Coins,
 ) (sdk.Tags, sdk.Error) {
@@ -460,7 +510,7 @@ func storeAddressTxHash(ctx sdk.Context, tk TxKeeper, addr sdk.AccAddress) {
 	getTxs := []Tx{}
 	txHash := store.Get(key)
 	if txHash != nil {
-		if err := tk.cdc.UnmarshalJSON(txHash, &getTxs); err != nil {
+		if err := tk.enc.Unmarshal(txHash, &getTxs); err != nil {
 			panic(err)
 		}
 	}
@@ -475,7 +525,7 @@ func storeAddressTxHash(ctx sdk.Context, tk TxKeeper, addr sdk.AccAddress) {
 		// 数组长度最大暂定300
 		txs = txs[:300]
 	}
-	ay, err := tk.cdc.MarshalJSON(txs)
+	ay, err := tk.enc.Marshal(txs)
 	if err != nil {
 		panic(err)
 	}
@@ -490,13 +540,22 @@ type Tx struct {
 type TxKeeper struct {
 	key sdk.StoreKey
 	cdc *codec.Codec
+	enc *codec.Encoder
 }
 
 func NewTxKeeper(cdc *codec.Codec, key sdk.StoreKey) TxKeeper {
 	return TxKeeper{
 		key: key,
 		cdc: cdc,
+		enc: codec.NewEncoder(cdc, codec.EncodingJSON),
 	}
 }
 
+// WithEncoder returns a copy of the keeper storing the transaction history in
+// the encoding of enc
+func (tk TxKeeper) WithEncoder(enc *codec.Encoder) TxKeeper {
+	tk.enc = enc
+	return tk
+}
+
 //#############################################################################################
@@ -101,6 +101,73 @@ func (msg MsgMultiSend) GetSigners() []sdk.AccAddress {
 	return addrs
 }
 
+// MsgCreateVestingAccount - create a vesting account funded by the sender
+type MsgCreateVestingAccount struct {
+	FromAddress sdk.AccAddress `json:"from_address"`
+	ToAddress   sdk.AccAddress `json:"to_address"`
+	Amount      sdk.Coins      `json:"amount"`
+	StartTime   int64          `json:"start_time"`
+	EndTime     int64          `json:"end_time"`
+	Delayed     bool           `json:"delayed"`
+}
+
+var _ sdk.Msg = MsgCreateVestingAccount{}
+
+// NewMsgCreateVestingAccount - construct a msg creating a vesting account that
+// vests continuously from startTime to endTime, or entirely at endTime when
+// delayed.
+func NewMsgCreateVestingAccount(fromAddr, toAddr sdk.AccAddress, amount sdk.Coins,
+	startTime, endTime int64, delayed bool) MsgCreateVestingAccount {
+
+	return MsgCreateVestingAccount{
+		FromAddress: fromAddr,
+		ToAddress:   toAddr,
+		Amount:      amount,
+		StartTime:   startTime,
+		EndTime:     endTime,
+		Delayed:     delayed,
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgCreateVestingAccount) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgCreateVestingAccount) Type() string { return "create_vesting_account" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgCreateVestingAccount) ValidateBasic() sdk.Error {
+	if msg.FromAddress.Empty() {
+		return sdk.ErrInvalidAddress("missing sender address")
+	}
+	if msg.ToAddress.Empty() {
+		return sdk.ErrInvalidAddress("missing recipient address")
+	}
+	if !msg.Amount.IsValid() {
+		return sdk.ErrInvalidCoins("vesting amount is invalid: " + msg.Amount.String())
+	}
+	if !msg.Amount.IsAllPositive() {
+		return sdk.ErrInsufficientCoins("vesting amount must be positive")
+	}
+	if msg.EndTime <= 0 {
+		return ErrInvalidVestingSchedule(DefaultCodespace, "vesting end time must be positive")
+	}
+	if !msg.Delayed && msg.StartTime >= msg.EndTime {
+		return ErrInvalidVestingSchedule(DefaultCodespace, "vesting start time must be before the end time")
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgCreateVestingAccount) GetSignBytes() []byte {
+	return sdk.MustSortJSON(msgCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgCreateVestingAccount) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.FromAddress}
+}
+
 // Input models transaction input
 type Input struct {
 	Address sdk.AccAddress `json:"address"`
@@ -235,3 +235,40 @@ func TestMsgSendSigners(t *testing.T) {
 	require.Equal(t, signers, tx.Signers())
 }
 */
+
+func TestMsgCreateVestingAccountValidation(t *testing.T) {
+	addr1 := sdk.AccAddress([]byte("from"))
+	addr2 := sdk.AccAddress([]byte("to"))
+	atom123 := sdk.NewCoins(sdk.NewInt64Coin("atom", 123))
+	atom0 := sdk.NewCoins(sdk.NewInt64Coin("atom", 0))
+
+	var emptyAddr sdk.AccAddress
+
+	cases := []struct {
+		valid bool
+		tx    MsgCreateVestingAccount
+	}{
+		{true, NewMsgCreateVestingAccount(addr1, addr2, atom123, 100, 200, false)},  // continuous
+		{true, NewMsgCreateVestingAccount(addr1, addr2, atom123, 0, 200, true)},     // delayed
+		{true, NewMsgCreateVestingAccount(addr1, addr2, atom123, 300, 200, true)},   // delayed ignores start
+		{false, NewMsgCreateVestingAccount(addr1, addr2, atom123, 200, 200, false)}, // empty schedule
+		{false, NewMsgCreateVestingAccount(addr1, addr2, atom123, 0, 0, true)},      // no end time
+		{false, NewMsgCreateVestingAccount(addr1, addr2, atom0, 100, 200, false)},   // non positive coin
+		{false, NewMsgCreateVestingAccount(emptyAddr, addr2, atom123, 100, 200, false)},
+		{false, NewMsgCreateVestingAccount(addr1, emptyAddr, atom123, 100, 200, false)},
+	}
+
+	for _, tc := range cases {
+		err := tc.tx.ValidateBasic()
+		if tc.valid {
+			require.Nil(t, err)
+		} else {
+			require.NotNil(t, err)
+		}
+	}
+
+	msg := NewMsgCreateVestingAccount(addr1, addr2, atom123, 100, 200, false)
+	require.Equal(t, "bank", msg.Route())
+	require.Equal(t, "create_vesting_account", msg.Type())
+	require.Equal(t, []sdk.AccAddress{addr1}, msg.GetSigners())
+}
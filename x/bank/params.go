@@ -1,6 +1,7 @@
 package bank
 
 import (
+	sdk "github.com/PhenixChain/PhenixChain/types"
 	"github.com/PhenixChain/PhenixChain/x/params"
 )
 
@@ -11,12 +12,18 @@ const (
 	DefaultSendEnabled = true
 )
 
-// ParamStoreKeySendEnabled is store's key for SendEnabled
-var ParamStoreKeySendEnabled = []byte("sendenabled")
+// Parameter store keys
+var (
+	// ParamStoreKeySendEnabled is store's key for SendEnabled
+	ParamStoreKeySendEnabled = []byte("sendenabled")
+	// ParamStoreKeyDenomMetadata is store's key for the denom metadata
+	ParamStoreKeyDenomMetadata = []byte("denommetadata")
+)
 
 // ParamKeyTable type declaration for parameters
 func ParamKeyTable() params.KeyTable {
 	return params.NewKeyTable(
 		ParamStoreKeySendEnabled, false,
+		ParamStoreKeyDenomMetadata, []sdk.DenomMetadata{},
 	)
 }
@@ -0,0 +1,66 @@
+package bank
+
+import (
+	"fmt"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/PhenixChain/PhenixChain/codec"
+	sdk "github.com/PhenixChain/PhenixChain/types"
+)
+
+// QuerierRoute is the querier route for bank
+const QuerierRoute = RouterKey
+
+// Query endpoints supported by the bank querier
+const (
+	QueryDenomMetadata = "denom_metadata"
+	QuerySupply        = "supply"
+)
+
+// NewQuerier returns a bank Querier handler.
+func NewQuerier(k Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, _ abci.RequestQuery) ([]byte, sdk.Error) {
+		switch path[0] {
+		case QueryDenomMetadata:
+			return queryDenomMetadata(ctx, k)
+
+		case QuerySupply:
+			return querySupply(ctx, path[1:], k)
+
+		default:
+			return nil, sdk.ErrUnknownRequest(fmt.Sprintf("unknown bank query endpoint: %s", path[0]))
+		}
+	}
+}
+
+func queryDenomMetadata(ctx sdk.Context, k Keeper) ([]byte, sdk.Error) {
+	res, err := codec.MarshalJSONIndent(msgCdc, k.GetDenomMetadata(ctx))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to marshal JSON", err.Error()))
+	}
+
+	return res, nil
+}
+
+// querySupply returns the total supply of all coins, or the supply of a
+// single coin if a denom is given
+func querySupply(ctx sdk.Context, path []string, k Keeper) ([]byte, sdk.Error) {
+	supply := k.GetSupply(ctx)
+
+	var res []byte
+	var err error
+	if len(path) > 0 && path[0] != "" {
+		if err := sdk.ValidateDenom(path[0]); err != nil {
+			return nil, sdk.ErrInvalidCoins(err.Error())
+		}
+		res, err = codec.MarshalJSONIndent(msgCdc, sdk.Coin{Denom: path[0], Amount: supply.AmountOf(path[0])})
+	} else {
+		res, err = codec.MarshalJSONIndent(msgCdc, supply)
+	}
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to marshal JSON", err.Error()))
+	}
+
+	return res, nil
+}
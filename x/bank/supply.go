@@ -0,0 +1,83 @@
+package bank
+
+import (
+	"fmt"
+
+	sdk "github.com/PhenixChain/PhenixChain/types"
+)
+
+// StoreKey is the store key of the bank module
+const StoreKey = RouterKey
+
+var (
+	// supplyKey is the key of the total supply record
+	supplyKey = []byte{0x00}
+)
+
+// GetSupply returns the total supply of all coins, including coins held
+// outside of accounts (e.g. collected fees or bonded tokens).
+func (keeper BaseKeeper) GetSupply(ctx sdk.Context) sdk.Coins {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(supplyKey)
+	if bz == nil {
+		return sdk.NewCoins()
+	}
+
+	var supply sdk.Coins
+	msgCdc.MustUnmarshalBinaryLengthPrefixed(bz, &supply)
+	return supply
+}
+
+// SetSupply sets the total supply of all coins.
+func (keeper BaseKeeper) SetSupply(ctx sdk.Context, supply sdk.Coins) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(supplyKey, msgCdc.MustMarshalBinaryLengthPrefixed(supply))
+}
+
+// InflateSupply adds newly created coins to the total supply. It is meant for
+// modules that create coins outside of accounts, e.g. inflation; coins
+// credited to an account should be created with MintCoins.
+func (keeper BaseKeeper) InflateSupply(ctx sdk.Context, amt sdk.Coins) {
+	keeper.SetSupply(ctx, keeper.GetSupply(ctx).Add(amt))
+}
+
+// DeflateSupply removes destroyed coins from the total supply. It is meant
+// for modules that destroy coins held outside of accounts, e.g. slashing;
+// coins debited from an account should be destroyed with BurnCoins.
+func (keeper BaseKeeper) DeflateSupply(ctx sdk.Context, amt sdk.Coins) {
+	supply, hasNeg := keeper.GetSupply(ctx).SafeSub(amt)
+	if hasNeg {
+		panic(fmt.Sprintf("burning %s exceeds the total supply", amt))
+	}
+	keeper.SetSupply(ctx, supply)
+}
+
+// MintCoins creates new coins, credits them to the addr and adds them to the
+// total supply.
+func (keeper BaseKeeper) MintCoins(
+	ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins,
+) (sdk.Tags, sdk.Error) {
+
+	_, tags, err := keeper.AddCoins(ctx, addr, amt)
+	if err != nil {
+		return nil, err
+	}
+
+	keeper.InflateSupply(ctx, amt)
+	return tags, nil
+}
+
+// BurnCoins debits coins from the addr, destroys them and removes them from
+// the total supply.
+func (keeper BaseKeeper) BurnCoins(
+	ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins,
+) (sdk.Tags, sdk.Error) {
+
+	_, tags, err := keeper.SubtractCoins(ctx, addr, amt)
+	if err != nil {
+		return nil, err
+	}
+
+	keeper.DeflateSupply(ctx, amt)
+	return tags, nil
+}
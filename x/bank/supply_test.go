@@ -0,0 +1,145 @@
+package bank
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+	dbm "github.com/tendermint/tendermint/libs/db"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/PhenixChain/PhenixChain/codec"
+	"github.com/PhenixChain/PhenixChain/store"
+	sdk "github.com/PhenixChain/PhenixChain/types"
+	"github.com/PhenixChain/PhenixChain/x/auth"
+	"github.com/PhenixChain/PhenixChain/x/params"
+)
+
+func setupTestInput() (sdk.Context, auth.AccountKeeper, BaseKeeper) {
+	db := dbm.NewMemDB()
+
+	cdc := codec.New()
+	auth.RegisterBaseAccount(cdc)
+
+	keyAcc := sdk.NewKVStoreKey(auth.StoreKey)
+	keyAddress := sdk.NewKVStoreKey(auth.StoreAdrKey)
+	keyBank := sdk.NewKVStoreKey(StoreKey)
+	keyParams := sdk.NewKVStoreKey(params.StoreKey)
+	tkeyParams := sdk.NewTransientStoreKey(params.TStoreKey)
+
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(keyAcc, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyAddress, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyBank, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(tkeyParams, sdk.StoreTypeTransient, db)
+	ms.LoadLatestVersion()
+
+	pk := params.NewKeeper(cdc, keyParams, tkeyParams)
+	ak := auth.NewAccountKeeper(cdc, keyAcc, pk.Subspace(auth.DefaultParamspace), auth.ProtoBaseAccount)
+	bk := NewBaseKeeper(ak, NewTxKeeper(cdc, keyAddress), keyBank, pk.Subspace(DefaultParamspace), DefaultCodespace)
+
+	ctx := sdk.NewContext(ms, abci.Header{ChainID: "test-chain-id"}, false, log.NewNopLogger())
+	return ctx, ak, bk
+}
+
+func newAddr() sdk.AccAddress {
+	return sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+}
+
+func TestMintBurnCoins(t *testing.T) {
+	ctx, ak, k := setupTestInput()
+	invariant := TotalSupplyInvariant(k, ak, func(sdk.Context) sdk.Coins { return sdk.NewCoins() })
+	addr := newAddr()
+	require.True(t, k.GetSupply(ctx).IsZero())
+
+	_, err := k.MintCoins(ctx, addr, sdk.NewCoins(sdk.NewInt64Coin("ufan", 100)))
+	require.Nil(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("ufan", 100)), k.GetCoins(ctx, addr))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("ufan", 100)), k.GetSupply(ctx))
+	require.NoError(t, invariant(ctx))
+
+	_, err = k.BurnCoins(ctx, addr, sdk.NewCoins(sdk.NewInt64Coin("ufan", 40)))
+	require.Nil(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("ufan", 60)), k.GetCoins(ctx, addr))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("ufan", 60)), k.GetSupply(ctx))
+	require.NoError(t, invariant(ctx))
+
+	// burning more than the account holds fails and leaves the supply unchanged
+	_, err = k.BurnCoins(ctx, addr, sdk.NewCoins(sdk.NewInt64Coin("ufan", 61)))
+	require.NotNil(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("ufan", 60)), k.GetCoins(ctx, addr))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("ufan", 60)), k.GetSupply(ctx))
+	require.NoError(t, invariant(ctx))
+}
+
+func TestInflateDeflateSupply(t *testing.T) {
+	ctx, _, k := setupTestInput()
+
+	k.InflateSupply(ctx, sdk.NewCoins(sdk.NewInt64Coin("ufan", 100), sdk.NewInt64Coin("ugold", 5)))
+	k.DeflateSupply(ctx, sdk.NewCoins(sdk.NewInt64Coin("ufan", 30)))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("ufan", 70), sdk.NewInt64Coin("ugold", 5)), k.GetSupply(ctx))
+
+	// burning more than the supply, or coins never created, panics
+	require.Panics(t, func() { k.DeflateSupply(ctx, sdk.NewCoins(sdk.NewInt64Coin("ufan", 71))) })
+	require.Panics(t, func() { k.DeflateSupply(ctx, sdk.NewCoins(sdk.NewInt64Coin("usilver", 1))) })
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("ufan", 70), sdk.NewInt64Coin("ugold", 5)), k.GetSupply(ctx))
+}
+
+func TestTotalSupplyInvariant(t *testing.T) {
+	ctx, ak, k := setupTestInput()
+	collected := sdk.NewCoins()
+	invariant := TotalSupplyInvariant(k, ak, func(sdk.Context) sdk.Coins { return collected })
+
+	addr := newAddr()
+	_, err := k.MintCoins(ctx, addr, sdk.NewCoins(sdk.NewInt64Coin("ufan", 100)))
+	require.Nil(t, err)
+	require.NoError(t, invariant(ctx))
+
+	// coins moved out of accounts are accounted for by the coins held elsewhere
+	_, _, err = k.SubtractCoins(ctx, addr, sdk.NewCoins(sdk.NewInt64Coin("ufan", 10)))
+	require.Nil(t, err)
+	require.Error(t, invariant(ctx))
+	collected = sdk.NewCoins(sdk.NewInt64Coin("ufan", 10))
+	require.NoError(t, invariant(ctx))
+
+	// coins created without recording them in the supply break the invariant
+	_, _, err = k.AddCoins(ctx, addr, sdk.NewCoins(sdk.NewInt64Coin("ugold", 1)))
+	require.Nil(t, err)
+	require.Error(t, invariant(ctx))
+	k.InflateSupply(ctx, sdk.NewCoins(sdk.NewInt64Coin("ugold", 1)))
+	require.NoError(t, invariant(ctx))
+
+	// as does a supply recording coins that don't exist
+	k.InflateSupply(ctx, sdk.NewCoins(sdk.NewInt64Coin("ufan", 1)))
+	require.Error(t, invariant(ctx))
+}
+
+func TestQuerySupply(t *testing.T) {
+	ctx, _, k := setupTestInput()
+	querier := NewQuerier(k)
+	k.InflateSupply(ctx, sdk.NewCoins(sdk.NewInt64Coin("ufan", 100), sdk.NewInt64Coin("ugold", 5)))
+
+	bz, err := querier(ctx, []string{QuerySupply}, abci.RequestQuery{})
+	require.Nil(t, err)
+	var supply sdk.Coins
+	require.NoError(t, msgCdc.UnmarshalJSON(bz, &supply))
+	require.Equal(t, k.GetSupply(ctx), supply)
+
+	bz, err = querier(ctx, []string{QuerySupply, "ufan"}, abci.RequestQuery{})
+	require.Nil(t, err)
+	var coin sdk.Coin
+	require.NoError(t, msgCdc.UnmarshalJSON(bz, &coin))
+	require.Equal(t, sdk.NewInt64Coin("ufan", 100), coin)
+
+	// denoms without coins have a zero supply
+	bz, err = querier(ctx, []string{QuerySupply, "usilver"}, abci.RequestQuery{})
+	require.Nil(t, err)
+	require.NoError(t, msgCdc.UnmarshalJSON(bz, &coin))
+	require.Equal(t, "usilver", coin.Denom)
+	require.True(t, coin.Amount.IsZero())
+
+	_, err = querier(ctx, []string{QuerySupply, "U"}, abci.RequestQuery{})
+	require.NotNil(t, err)
+}
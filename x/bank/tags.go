@@ -1,10 +1,15 @@
 package bank
 
+import (
+	sdk "github.com/PhenixChain/PhenixChain/types"
+)
+
 // Tag keys and values
 var (
 	TagActionUndelegateCoins = []byte("undelegateCoins")
 	TagActionDelegateCoins   = []byte("delegateCoins")
 
-	TagKeyRecipient = "recipient"
-	TagKeySender    = "sender"
+	TagKeyRecipient = sdk.TagRecipient
+
+	TagKeyVestingAccount = "vesting-account"
 )
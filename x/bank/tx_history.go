@@ -0,0 +1,57 @@
+package bank
+
+import (
+	sdk "github.com/PhenixChain/PhenixChain/types"
+)
+
+// AddressTxs is the transaction history kept for an address, most recent
+// transaction first
+type AddressTxs struct {
+	Address sdk.AccAddress `json:"address"`
+	Txs     []Tx           `json:"txs"`
+}
+
+// prefix of the transaction histories, keyed by address
+var addressTxsKeyPrefix = []byte{0x01}
+
+// key of the transaction history of an address
+func addressTxsKey(addr sdk.AccAddress) []byte {
+	return append(addressTxsKeyPrefix, addr.Bytes()...)
+}
+
+// GetAddressTxs returns the transaction history of an address
+func (tk TxKeeper) GetAddressTxs(ctx sdk.Context, addr sdk.AccAddress) []Tx {
+	txs := []Tx{}
+	bz := ctx.KVStore(tk.key).Get(addressTxsKey(addr))
+	if bz == nil {
+		return txs
+	}
+	if err := tk.enc.Unmarshal(bz, &txs); err != nil {
+		panic(err)
+	}
+	return txs
+}
+
+// SetAddressTxs sets the transaction history of an address
+func (tk TxKeeper) SetAddressTxs(ctx sdk.Context, addr sdk.AccAddress, txs []Tx) {
+	bz, err := tk.enc.Marshal(txs)
+	if err != nil {
+		panic(err)
+	}
+	ctx.KVStore(tk.key).Set(addressTxsKey(addr), bz)
+}
+
+// IterateAddressTxs iterates over the transaction history of every address
+func (tk TxKeeper) IterateAddressTxs(ctx sdk.Context, process func(AddressTxs) (stop bool)) {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(tk.key), addressTxsKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		history := AddressTxs{Address: sdk.AccAddress(iter.Key()[1:])}
+		if err := tk.enc.Unmarshal(iter.Value(), &history.Txs); err != nil {
+			panic(err)
+		}
+		if process(history) {
+			return
+		}
+	}
+}
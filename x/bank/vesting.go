@@ -0,0 +1,41 @@
+package bank
+
+import (
+	sdk "github.com/PhenixChain/PhenixChain/types"
+	"github.com/PhenixChain/PhenixChain/x/auth"
+)
+
+// CreateVestingAccount creates a vesting account at toAddr funded with amt
+// from fromAddr. The account vests continuously between startTime and endTime,
+// or all at once at endTime when delayed is set.
+func (keeper BaseKeeper) CreateVestingAccount(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress,
+	amt sdk.Coins, startTime, endTime int64, delayed bool) (sdk.Tags, sdk.Error) {
+
+	if keeper.ak.GetAccount(ctx, toAddr) != nil {
+		return nil, ErrAccountExists(keeper.Codespace(), toAddr)
+	}
+
+	baseAcc := auth.NewBaseAccountWithAddress(toAddr)
+	baseAcc.Coins = amt
+
+	var acc auth.VestingAccount
+	if delayed {
+		acc = auth.NewDelayedVestingAccount(&baseAcc, endTime)
+	} else {
+		acc = auth.NewContinuousVestingAccount(&baseAcc, startTime, endTime)
+	}
+
+	// the vesting schedule is set up empty and funded by a regular send, so
+	// the sender must be able to spend the amount
+	if err := acc.SetCoins(sdk.NewCoins()); err != nil {
+		return nil, sdk.ErrInternal(err.Error())
+	}
+	keeper.ak.SetAccount(ctx, acc)
+
+	tags, err := keeper.SendCoins(ctx, fromAddr, toAddr, amt)
+	if err != nil {
+		return nil, err
+	}
+
+	return tags.AppendTag(TagKeyVestingAccount, toAddr.String()), nil
+}
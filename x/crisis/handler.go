@@ -71,8 +71,7 @@ func handleMsgVerifyInvariant(ctx sdk.Context, msg MsgVerifyInvariant, k Keeper)
 	}
 
 	tags := sdk.NewTags(
-		"sender", msg.Sender.String(),
-		"invariant", msg.InvariantRoute,
+		TagKeyInvariant, msg.InvariantRoute,
 	)
 	return sdk.Result{
 		Tags: tags,
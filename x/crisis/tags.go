@@ -0,0 +1,7 @@
+package crisis
+
+// Tag keys, messages are also tagged with their action, module and senders.
+// The sender of MsgVerifyInvariant signs it, so it is still tagged as sender.
+var (
+	TagKeyInvariant = "invariant"
+)
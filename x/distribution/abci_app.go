@@ -30,4 +30,9 @@ func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, k keeper.Keeper)
 	consAddr := sdk.ConsAddress(req.Header.ProposerAddress)
 	k.SetPreviousProposerConsAddr(ctx, consAddr)
 
+	// compound the rewards of the delegations which opted in, within the per-block gas budget
+	k.ProcessAutoRestakes(ctx)
+
+	// drop the withdraw history records which fell out of the window
+	k.PruneWithdrawRecords(ctx)
 }
@@ -14,6 +14,7 @@ type (
 	MsgSetWithdrawAddress          = types.MsgSetWithdrawAddress
 	MsgWithdrawDelegatorReward     = types.MsgWithdrawDelegatorReward
 	MsgWithdrawValidatorCommission = types.MsgWithdrawValidatorCommission
+	MsgSetAutoRestake              = types.MsgSetAutoRestake
 
 	GenesisState = types.GenesisState
 
@@ -27,6 +28,7 @@ type (
 	QueryValidatorSlashesParams      = keeper.QueryValidatorSlashesParams
 	QueryDelegationRewardsParams     = keeper.QueryDelegationRewardsParams
 	QueryDelegatorWithdrawAddrParams = keeper.QueryDelegatorWithdrawAddrParams
+	QueryWithdrawHistoryParams       = keeper.QueryWithdrawHistoryParams
 )
 
 const (
@@ -49,6 +51,7 @@ var (
 	NewMsgSetWithdrawAddress          = types.NewMsgSetWithdrawAddress
 	NewMsgWithdrawDelegatorReward     = types.NewMsgWithdrawDelegatorReward
 	NewMsgWithdrawValidatorCommission = types.NewMsgWithdrawValidatorCommission
+	NewMsgSetAutoRestake              = types.NewMsgSetAutoRestake
 
 	NewKeeper                                 = keeper.NewKeeper
 	NewQuerier                                = keeper.NewQuerier
@@ -58,6 +61,7 @@ var (
 	NewQueryDelegationRewardsParams           = keeper.NewQueryDelegationRewardsParams
 	NewQueryDelegatorParams                   = keeper.NewQueryDelegatorParams
 	NewQueryDelegatorWithdrawAddrParams       = keeper.NewQueryDelegatorWithdrawAddrParams
+	NewQueryWithdrawHistoryParams             = keeper.NewQueryWithdrawHistoryParams
 	DefaultParamspace                         = keeper.DefaultParamspace
 	RegisterInvariants                        = keeper.RegisterInvariants
 	AllInvariants                             = keeper.AllInvariants
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"os"
 	"strconv"
 	"strings"
 
@@ -188,3 +189,97 @@ $ gaiacli query distr community-pool
 		},
 	}
 }
+
+// GetCmdQueryDelegatorAutoRestakes returns the command for fetching the
+// validators a delegator auto-restakes its rewards with
+func GetCmdQueryDelegatorAutoRestakes(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "auto-restakes [delegator-addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the validators a delegator auto-restakes its rewards with",
+		Long: strings.TrimSpace(`Query the validators whose delegation rewards are periodically restaked for a delegator:
+
+$ phenixcli query distr auto-restakes cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			delAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			bz := cdc.MustMarshalJSON(distr.NewQueryDelegatorParams(delAddr))
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/delegator_auto_restakes", queryRoute), bz)
+			if err != nil {
+				return err
+			}
+
+			var result types.AutoRestakeValidators
+			cdc.MustUnmarshalJSON(res, &result)
+			return cliCtx.PrintOutput(result)
+		},
+	}
+}
+
+// nolint
+const (
+	flagHistorySince = "since"
+	flagHistoryUntil = "until"
+	flagCSV          = "csv"
+)
+
+// GetCmdQueryRewardsHistory returns the command for fetching the reward and
+// commission withdrawals of an address
+func GetCmdQueryRewardsHistory(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rewards-history [address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the reward and commission withdrawals of an address",
+		Long: strings.TrimSpace(`Query the reward and commission withdrawals recorded for an address, optionally
+between two heights or times. Each bound is a block height, a date or an RFC3339 time.
+Commission withdrawals are recorded for the validator operator account. Withdrawals are
+only recorded while the withdraw_history param is set and kept for that many blocks.
+
+$ phenixcli query distr rewards-history cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p --since 2019-01-01 --until 2019-12-31 --csv
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			since, err := cmd.Flags().GetString(flagHistorySince)
+			if err != nil {
+				return err
+			}
+			until, err := cmd.Flags().GetString(flagHistoryUntil)
+			if err != nil {
+				return err
+			}
+			printCSV, err := cmd.Flags().GetBool(flagCSV)
+			if err != nil {
+				return err
+			}
+
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := common.QueryWithdrawHistory(cliCtx, cdc, queryRoute, addr, since, until)
+			if err != nil {
+				return err
+			}
+
+			var result types.WithdrawRecords
+			cdc.MustUnmarshalJSON(res, &result)
+			if printCSV {
+				return common.WriteWithdrawRecordsCSV(os.Stdout, result)
+			}
+			return cliCtx.PrintOutput(result)
+		},
+	}
+
+	cmd.Flags().String(flagHistorySince, "", "start of the range, as a height, a date (2006-01-02) or an RFC3339 time")
+	cmd.Flags().String(flagHistoryUntil, "", "end of the range, as a height, a date (2006-01-02) or an RFC3339 time")
+	cmd.Flags().Bool(flagCSV, false, "print the history as CSV")
+	return cmd
+}
@@ -2,6 +2,7 @@
 package cli
 
 import (
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -35,6 +36,7 @@ func GetTxCmd(storeKey string, cdc *amino.Codec) *cobra.Command {
 	distTxCmd.AddCommand(client.PostCommands(
 		GetCmdWithdrawRewards(cdc),
 		GetCmdSetWithdrawAddr(cdc),
+		GetCmdSetAutoRestake(cdc),
 	)...)
 
 	return distTxCmd
@@ -132,3 +134,41 @@ $ gaiacli tx set-withdraw-addr cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p --f
 	}
 	return cmd
 }
+
+// command to opt a delegation in or out of auto-restaking its rewards
+func GetCmdSetAutoRestake(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-auto-restake [validator-addr] [true|false]",
+		Short: "enable or disable the periodic restaking of the rewards of a delegation",
+		Long: strings.TrimSpace(`Enable or disable auto-restaking for a delegation. When enabled, the rewards
+of the delegation are withdrawn and delegated back to the same validator every
+restake epoch, without having to send any transaction. Rewards can only be
+restaked if they are withdrawn to the delegator address:
+
+$ phenixcli tx distr set-auto-restake cosmosvaloper1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj true --from mykey
+`),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			txBldr := authtxb.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithAccountDecoder(cdc)
+
+			delAddr := cliCtx.GetFromAddress()
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			enabled, err := strconv.ParseBool(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetAutoRestake(delAddr, valAddr, enabled)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg}, false)
+		},
+	}
+	return cmd
+}
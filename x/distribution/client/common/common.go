@@ -1,12 +1,17 @@
 package common
 
 import (
+	"encoding/csv"
 	"fmt"
+	"io"
+	"strconv"
+	"time"
 
 	"github.com/PhenixChain/PhenixChain/client/context"
 	"github.com/PhenixChain/PhenixChain/codec"
 	sdk "github.com/PhenixChain/PhenixChain/types"
 	distr "github.com/PhenixChain/PhenixChain/x/distribution"
+	"github.com/PhenixChain/PhenixChain/x/distribution/types"
 )
 
 // QueryParams actually queries distribution params.
@@ -36,8 +41,26 @@ func QueryParams(cliCtx context.CLIContext, queryRoute string) (PrettyParams, er
 		return PrettyParams{}, err
 	}
 
-	return NewPrettyParams(retCommunityTax, retBaseProposerReward,
-		retBonusProposerReward, retWithdrawAddrEnabled), nil
+	route = fmt.Sprintf("custom/%s/params/restake_epoch", queryRoute)
+	retRestakeEpoch, err := cliCtx.QueryWithData(route, []byte{})
+	if err != nil {
+		return PrettyParams{}, err
+	}
+
+	route = fmt.Sprintf("custom/%s/params/restake_gas_budget", queryRoute)
+	retRestakeGasBudget, err := cliCtx.QueryWithData(route, []byte{})
+	if err != nil {
+		return PrettyParams{}, err
+	}
+
+	route = fmt.Sprintf("custom/%s/params/withdraw_history", queryRoute)
+	retWithdrawHistory, err := cliCtx.QueryWithData(route, []byte{})
+	if err != nil {
+		return PrettyParams{}, err
+	}
+
+	return NewPrettyParams(retCommunityTax, retBaseProposerReward, retBonusProposerReward,
+		retWithdrawAddrEnabled, retRestakeEpoch, retRestakeGasBudget, retWithdrawHistory), nil
 }
 
 // QueryDelegatorTotalRewards queries delegator total rewards.
@@ -95,6 +118,74 @@ func QueryValidatorCommission(cliCtx context.CLIContext, cdc *codec.Codec,
 	)
 }
 
+// QueryWithdrawHistory returns the reward and commission withdrawals of an
+// address between two bounds, each bound being either a height or a time.
+func QueryWithdrawHistory(cliCtx context.CLIContext, cdc *codec.Codec,
+	queryRoute string, addr sdk.AccAddress, from, to string) ([]byte, error) {
+
+	startingHeight, startingTime, err := ParseHistoryBound(from, false)
+	if err != nil {
+		return nil, err
+	}
+	endingHeight, endingTime, err := ParseHistoryBound(to, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return cliCtx.QueryWithData(
+		fmt.Sprintf("custom/%s/withdraw_history", queryRoute),
+		cdc.MustMarshalJSON(distr.NewQueryWithdrawHistoryParams(addr, startingHeight, endingHeight, startingTime, endingTime)),
+	)
+}
+
+// ParseHistoryBound parses a bound of the withdraw history range, which is
+// either a block height, a date (2006-01-02) or an RFC3339 time. An empty
+// bound is unbounded. A date used as an ending bound includes the whole day.
+func ParseHistoryBound(bound string, ending bool) (height int64, t time.Time, err error) {
+	if bound == "" {
+		return 0, time.Time{}, nil
+	}
+	if height, err = strconv.ParseInt(bound, 10, 64); err == nil {
+		if height < 0 {
+			return 0, time.Time{}, fmt.Errorf("height must be non-negative, got %d", height)
+		}
+		return height, time.Time{}, nil
+	}
+	if t, err = time.Parse("2006-01-02", bound); err == nil {
+		if ending {
+			t = t.Add(24*time.Hour - time.Nanosecond)
+		}
+		return 0, t, nil
+	}
+	if t, err = time.Parse(time.RFC3339, bound); err == nil {
+		return 0, t, nil
+	}
+	return 0, time.Time{}, fmt.Errorf("invalid bound %q, expected a height, a date (2006-01-02) or an RFC3339 time", bound)
+}
+
+// WriteWithdrawRecordsCSV writes withdraw history records as CSV, one row per
+// record with its height, time, type, validator and amount.
+func WriteWithdrawRecordsCSV(w io.Writer, records types.WithdrawRecords) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write([]string{"height", "time", "type", "validator", "amount"}); err != nil {
+		return err
+	}
+	for _, record := range records {
+		row := []string{
+			strconv.FormatInt(record.Height, 10),
+			record.Time.UTC().Format(time.RFC3339),
+			record.Type.String(),
+			record.Validator.String(),
+			record.Amount.String(),
+		}
+		if err := cw.Write(row); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
 // WithdrawAllDelegatorRewards builds a multi-message slice to be used
 // to withdraw all delegations rewards for the given delegator.
 func WithdrawAllDelegatorRewards(cliCtx context.CLIContext, cdc *codec.Codec,
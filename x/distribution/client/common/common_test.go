@@ -1,12 +1,16 @@
 package common
 
 import (
+	"bytes"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
 	"github.com/PhenixChain/PhenixChain/client/context"
 	"github.com/PhenixChain/PhenixChain/codec"
+	sdk "github.com/PhenixChain/PhenixChain/types"
+	"github.com/PhenixChain/PhenixChain/x/distribution/types"
 )
 
 func TestQueryDelegationRewardsAddrValidation(t *testing.T) {
@@ -34,3 +38,48 @@ func TestQueryDelegationRewardsAddrValidation(t *testing.T) {
 		})
 	}
 }
+
+func TestParseHistoryBound(t *testing.T) {
+	day := time.Date(2019, 3, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name       string
+		bound      string
+		ending     bool
+		wantHeight int64
+		wantTime   time.Time
+		wantErr    bool
+	}{
+		{"empty", "", false, 0, time.Time{}, false},
+		{"height", "120", false, 120, time.Time{}, false},
+		{"negative height", "-1", false, 0, time.Time{}, true},
+		{"starting date", "2019-03-01", false, 0, day, false},
+		{"ending date", "2019-03-01", true, 0, day.Add(24*time.Hour - time.Nanosecond), false},
+		{"rfc3339 time", "2019-03-01T00:00:00Z", true, 0, day, false},
+		{"invalid", "yesterday", false, 0, time.Time{}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			height, tm, err := ParseHistoryBound(tt.bound, tt.ending)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.wantHeight, height)
+			require.True(t, tt.wantTime.Equal(tm))
+		})
+	}
+}
+
+func TestWriteWithdrawRecordsCSV(t *testing.T) {
+	valAddr := sdk.ValAddress([]byte("validator"))
+	records := types.WithdrawRecords{
+		types.NewWithdrawRecord(sdk.AccAddress(valAddr), valAddr, types.WithdrawTypeCommission, 10,
+			time.Date(2019, 3, 1, 12, 0, 0, 0, time.UTC), sdk.NewCoins(sdk.NewInt64Coin("stake", 5))),
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, WriteWithdrawRecordsCSV(&buf, records))
+	require.Equal(t, "height,time,type,validator,amount\n"+
+		"10,2019-03-01T12:00:00Z,commission,"+valAddr.String()+",5stake\n", buf.String())
+}
@@ -11,15 +11,23 @@ type PrettyParams struct {
 	BaseProposerReward  json.RawMessage `json:"base_proposer_reward"`
 	BonusProposerReward json.RawMessage `json:"bonus_proposer_reward"`
 	WithdrawAddrEnabled json.RawMessage `json:"withdraw_addr_enabled"`
+	RestakeEpoch        json.RawMessage `json:"restake_epoch"`
+	RestakeGasBudget    json.RawMessage `json:"restake_gas_budget"`
+	WithdrawHistory     json.RawMessage `json:"withdraw_history"`
 }
 
 // Construct a new PrettyParams
-func NewPrettyParams(communityTax json.RawMessage, baseProposerReward json.RawMessage, bonusProposerReward json.RawMessage, withdrawAddrEnabled json.RawMessage) PrettyParams {
+func NewPrettyParams(communityTax json.RawMessage, baseProposerReward json.RawMessage, bonusProposerReward json.RawMessage,
+	withdrawAddrEnabled json.RawMessage, restakeEpoch json.RawMessage, restakeGasBudget json.RawMessage,
+	withdrawHistory json.RawMessage) PrettyParams {
 	return PrettyParams{
 		CommunityTax:        communityTax,
 		BaseProposerReward:  baseProposerReward,
 		BonusProposerReward: bonusProposerReward,
 		WithdrawAddrEnabled: withdrawAddrEnabled,
+		RestakeEpoch:        restakeEpoch,
+		RestakeGasBudget:    restakeGasBudget,
+		WithdrawHistory:     withdrawHistory,
 	}
 }
 
@@ -28,7 +36,11 @@ func (pp PrettyParams) String() string {
   Community Tax:          %s
   Base Proposer Reward:   %s
   Bonus Proposer Reward:  %s
-  Withdraw Addr Enabled:  %s`, pp.CommunityTax,
-		pp.BaseProposerReward, pp.BonusProposerReward, pp.WithdrawAddrEnabled)
+  Withdraw Addr Enabled:  %s
+  Restake Epoch:          %s
+  Restake Gas Budget:     %s
+  Withdraw History:       %s`, pp.CommunityTax,
+		pp.BaseProposerReward, pp.BonusProposerReward, pp.WithdrawAddrEnabled,
+		pp.RestakeEpoch, pp.RestakeGasBudget, pp.WithdrawHistory)
 
 }
@@ -32,6 +32,8 @@ func (mc ModuleClient) GetQueryCmd() *cobra.Command {
 		distCmds.GetCmdQueryValidatorSlashes(mc.storeKey, mc.cdc),
 		distCmds.GetCmdQueryDelegatorRewards(mc.storeKey, mc.cdc),
 		distCmds.GetCmdQueryCommunityPool(mc.storeKey, mc.cdc),
+		distCmds.GetCmdQueryDelegatorAutoRestakes(mc.storeKey, mc.cdc),
+		distCmds.GetCmdQueryRewardsHistory(mc.storeKey, mc.cdc),
 	)...)
 
 	return distQueryCmd
@@ -47,6 +49,7 @@ func (mc ModuleClient) GetTxCmd() *cobra.Command {
 	distTxCmd.AddCommand(client.PostCommands(
 		distCmds.GetCmdWithdrawRewards(mc.cdc),
 		distCmds.GetCmdSetWithdrawAddr(mc.cdc),
+		distCmds.GetCmdSetAutoRestake(mc.cdc),
 		distCmds.GetCmdWithdrawAllRewards(mc.cdc, mc.storeKey),
 	)...)
 
@@ -37,6 +37,18 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router,
 		delegatorWithdrawalAddrHandlerFn(cliCtx, cdc, queryRoute),
 	).Methods("GET")
 
+	// Get the validators a delegator auto-restakes its rewards with
+	r.HandleFunc(
+		"/distribution/delegators/{delegatorAddr}/auto_restakes",
+		delegatorAutoRestakesHandlerFn(cliCtx, cdc, queryRoute),
+	).Methods("GET")
+
+	// Get the reward and commission withdrawals of an address, as JSON or CSV
+	r.HandleFunc(
+		"/distribution/delegators/{delegatorAddr}/rewards_history",
+		rewardsHistoryHandlerFn(cliCtx, cdc, queryRoute),
+	).Methods("GET")
+
 	// Validator distribution information
 	r.HandleFunc(
 		"/distribution/validators/{validatorAddr}",
@@ -276,3 +288,75 @@ func checkResponseQueryDelegationRewards(w http.ResponseWriter, cliCtx context.C
 
 	return res, true
 }
+
+// HTTP request handler to query the validators a delegator auto-restakes its rewards with
+func delegatorAutoRestakesHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec,
+	queryRoute string) http.HandlerFunc {
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		delegatorAddr, ok := checkDelegatorAddressVar(w, r)
+		if !ok {
+			return
+		}
+
+		bz := cdc.MustMarshalJSON(distribution.NewQueryDelegatorParams(delegatorAddr))
+		res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/delegator_auto_restakes", queryRoute), bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+	}
+}
+
+// HTTP request handler to query the reward and commission withdrawals of an
+// address, the range is set by the from and to query parameters and the
+// history is returned as CSV when format=csv
+func rewardsHistoryHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec,
+	queryRoute string) http.HandlerFunc {
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		delegatorAddr, ok := checkDelegatorAddressVar(w, r)
+		if !ok {
+			return
+		}
+
+		query := r.URL.Query()
+		format := query.Get("format")
+		if format != "" && format != "json" && format != "csv" {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("unsupported format %q", format))
+			return
+		}
+		if _, _, err := common.ParseHistoryBound(query.Get("from"), false); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		if _, _, err := common.ParseHistoryBound(query.Get("to"), true); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, err := common.QueryWithdrawHistory(cliCtx, cdc, queryRoute, delegatorAddr, query.Get("from"), query.Get("to"))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		if format != "csv" {
+			rest.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+			return
+		}
+
+		var records types.WithdrawRecords
+		if err := cdc.UnmarshalJSON(res, &records); err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		w.Header().Set("Content-Type", "text/csv")
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=rewards_history_%s.csv", delegatorAddr))
+		if err := common.WriteWithdrawRecordsCSV(w, records); err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+		}
+	}
+}
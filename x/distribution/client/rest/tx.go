@@ -36,6 +36,12 @@ func registerTxRoutes(cliCtx context.CLIContext, r *mux.Router,
 		setDelegatorWithdrawalAddrHandlerFn(cdc, cliCtx),
 	).Methods("POST")
 
+	// Enable or disable auto-restaking of delegation rewards
+	r.HandleFunc(
+		"/distribution/delegators/{delegatorAddr}/auto_restakes/{validatorAddr}",
+		setAutoRestakeHandlerFn(cdc, cliCtx),
+	).Methods("POST")
+
 	// Withdraw validator rewards and commission
 	r.HandleFunc(
 		"/distribution/validators/{validatorAddr}/rewards",
@@ -53,6 +59,11 @@ type (
 		BaseReq         rest.BaseReq   `json:"base_req"`
 		WithdrawAddress sdk.AccAddress `json:"withdraw_address"`
 	}
+
+	setAutoRestakeReq struct {
+		BaseReq rest.BaseReq `json:"base_req"`
+		Enabled bool         `json:"enabled"`
+	}
 )
 
 // Withdraw delegator rewards
@@ -202,3 +213,38 @@ func checkValidatorAddressVar(w http.ResponseWriter, r *http.Request) (sdk.ValAd
 	}
 	return addr, true
 }
+
+// Enable or disable auto-restaking of delegation rewards
+func setAutoRestakeHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req setAutoRestakeReq
+
+		if !rest.ReadRESTReq(w, r, cdc, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		// read and validate URL's variables
+		delAddr, ok := checkDelegatorAddressVar(w, r)
+		if !ok {
+			return
+		}
+
+		valAddr, ok := checkValidatorAddressVar(w, r)
+		if !ok {
+			return
+		}
+
+		msg := types.NewMsgSetAutoRestake(delAddr, valAddr, req.Enabled)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		clientrest.WriteGenerateStdTxResponse(w, cdc, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
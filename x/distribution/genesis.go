@@ -12,6 +12,9 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) {
 	keeper.SetBaseProposerReward(ctx, data.BaseProposerReward)
 	keeper.SetBonusProposerReward(ctx, data.BonusProposerReward)
 	keeper.SetWithdrawAddrEnabled(ctx, data.WithdrawAddrEnabled)
+	keeper.SetRestakeEpoch(ctx, data.RestakeEpoch)
+	keeper.SetRestakeGasBudget(ctx, data.RestakeGasBudget)
+	keeper.SetWithdrawHistory(ctx, data.WithdrawHistory)
 	for _, dwi := range data.DelegatorWithdrawInfos {
 		keeper.SetDelegatorWithdrawAddr(ctx, dwi.DelegatorAddress, dwi.WithdrawAddress)
 	}
@@ -34,6 +37,12 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) {
 	for _, evt := range data.ValidatorSlashEvents {
 		keeper.SetValidatorSlashEvent(ctx, evt.ValidatorAddress, evt.Height, evt.Event)
 	}
+	for _, restake := range data.DelegatorAutoRestakes {
+		keeper.SetDelegatorAutoRestake(ctx, restake.DelegatorAddress, restake.ValidatorAddress)
+	}
+	for _, record := range data.WithdrawRecords {
+		keeper.SetWithdrawRecord(ctx, record)
+	}
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
@@ -43,6 +52,9 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
 	baseProposerRewards := keeper.GetBaseProposerReward(ctx)
 	bonusProposerRewards := keeper.GetBonusProposerReward(ctx)
 	withdrawAddrEnabled := keeper.GetWithdrawAddrEnabled(ctx)
+	restakeEpoch := keeper.GetRestakeEpoch(ctx)
+	restakeGasBudget := keeper.GetRestakeGasBudget(ctx)
+	withdrawHistory := keeper.GetWithdrawHistory(ctx)
 	dwi := make([]types.DelegatorWithdrawInfo, 0)
 	keeper.IterateDelegatorWithdrawAddrs(ctx, func(del sdk.AccAddress, addr sdk.AccAddress) (stop bool) {
 		dwi = append(dwi, types.DelegatorWithdrawInfo{
@@ -115,6 +127,24 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
 			return false
 		},
 	)
+	restakes := make([]types.DelegatorAutoRestakeRecord, 0)
+	keeper.IterateDelegatorAutoRestakes(ctx,
+		func(del sdk.AccAddress, val sdk.ValAddress) (stop bool) {
+			restakes = append(restakes, types.DelegatorAutoRestakeRecord{
+				DelegatorAddress: del,
+				ValidatorAddress: val,
+			})
+			return false
+		},
+	)
+	withdrawRecords := make([]types.WithdrawRecord, 0)
+	keeper.IterateWithdrawRecords(ctx,
+		func(record types.WithdrawRecord) (stop bool) {
+			withdrawRecords = append(withdrawRecords, record)
+			return false
+		},
+	)
 	return types.NewGenesisState(feePool, communityTax, baseProposerRewards, bonusProposerRewards, withdrawAddrEnabled,
-		dwi, pp, outstanding, acc, his, cur, dels, slashes)
+		restakeEpoch, restakeGasBudget, withdrawHistory, dwi, pp, outstanding, acc, his, cur, dels, slashes, restakes,
+		withdrawRecords)
 }
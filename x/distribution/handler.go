@@ -17,6 +17,8 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 			return handleMsgWithdrawDelegatorReward(ctx, msg, k)
 		case types.MsgWithdrawValidatorCommission:
 			return handleMsgWithdrawValidatorCommission(ctx, msg, k)
+		case types.MsgSetAutoRestake:
+			return handleMsgSetAutoRestake(ctx, msg, k)
 		default:
 			return sdk.ErrTxDecode("invalid message parse in distribution module").Result()
 		}
@@ -70,3 +72,19 @@ func handleMsgWithdrawValidatorCommission(ctx sdk.Context, msg types.MsgWithdraw
 		Tags: tags,
 	}
 }
+
+func handleMsgSetAutoRestake(ctx sdk.Context, msg types.MsgSetAutoRestake, k keeper.Keeper) sdk.Result {
+
+	err := k.SetAutoRestake(ctx, msg.DelegatorAddress, msg.ValidatorAddress, msg.Enabled)
+	if err != nil {
+		return err.Result()
+	}
+
+	tags := sdk.NewTags(
+		tags.Delegator, []byte(msg.DelegatorAddress.String()),
+		tags.Validator, []byte(msg.ValidatorAddress.String()),
+	)
+	return sdk.Result{
+		Tags: tags,
+	}
+}
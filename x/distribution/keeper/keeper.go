@@ -59,7 +59,7 @@ func (k Keeper) WithdrawDelegationRewards(ctx sdk.Context, delAddr sdk.AccAddres
 	}
 
 	// withdraw rewards
-	if err := k.withdrawDelegationRewards(ctx, val, del); err != nil {
+	if _, err := k.withdrawDelegationRewards(ctx, val, del); err != nil {
 		return err
 	}
 
@@ -92,6 +92,7 @@ func (k Keeper) WithdrawValidatorCommission(ctx sdk.Context, valAddr sdk.ValAddr
 		if _, _, err := k.bankKeeper.AddCoins(ctx, withdrawAddr, coins); err != nil {
 			return err
 		}
+		k.recordWithdrawal(ctx, accAddr, valAddr, types.WithdrawTypeCommission, coins)
 	}
 
 	return nil
@@ -24,11 +24,18 @@ var (
 	ValidatorCurrentRewardsPrefix        = []byte{0x06} // key for current validator rewards
 	ValidatorAccumulatedCommissionPrefix = []byte{0x07} // key for accumulated validator commission
 	ValidatorSlashEventPrefix            = []byte{0x08} // key for validator slash fraction
+	DelegatorAutoRestakePrefix           = []byte{0x09} // key for delegations which auto-restake their rewards
+	RestakeCursorKey                     = []byte{0x0A} // key for the next delegation of the auto-restake pass in progress
+	WithdrawRecordPrefix                 = []byte{0x0B} // key for withdraw history records
+	WithdrawRecordHeightIndexPrefix      = []byte{0x0C} // key for withdraw history records by height, used for pruning
 
 	ParamStoreKeyCommunityTax        = []byte("communitytax")
 	ParamStoreKeyBaseProposerReward  = []byte("baseproposerreward")
 	ParamStoreKeyBonusProposerReward = []byte("bonusproposerreward")
 	ParamStoreKeyWithdrawAddrEnabled = []byte("withdrawaddrenabled")
+	ParamStoreKeyRestakeEpoch        = []byte("restakeepoch")
+	ParamStoreKeyRestakeGasBudget    = []byte("restakegasbudget")
+	ParamStoreKeyWithdrawHistory     = []byte("withdrawhistory")
 )
 
 // gets an address from a validator's outstanding rewards key
@@ -112,6 +119,21 @@ func GetValidatorSlashEventAddressHeight(key []byte) (valAddr sdk.ValAddress, he
 	return
 }
 
+// gets the addresses from a delegator auto-restake key
+func GetDelegatorAutoRestakeAddresses(key []byte) (delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+	addr := key[1 : 1+sdk.AddrLen]
+	if len(addr) != sdk.AddrLen {
+		panic("unexpected key length")
+	}
+	delAddr = sdk.AccAddress(addr)
+	addr = key[1+sdk.AddrLen:]
+	if len(addr) != sdk.AddrLen {
+		panic("unexpected key length")
+	}
+	valAddr = sdk.ValAddress(addr)
+	return
+}
+
 // gets the outstanding rewards key for a validator
 func GetValidatorOutstandingRewardsKey(valAddr sdk.ValAddress) []byte {
 	return append(ValidatorOutstandingRewardsPrefix, valAddr.Bytes()...)
@@ -160,3 +182,54 @@ func GetValidatorSlashEventKey(v sdk.ValAddress, height uint64) []byte {
 	binary.BigEndian.PutUint64(b, height)
 	return append(append(ValidatorSlashEventPrefix, v.Bytes()...), b...)
 }
+
+// gets the prefix key for a delegator's auto-restaked delegations
+func GetDelegatorAutoRestakePrefix(d sdk.AccAddress) []byte {
+	return append(DelegatorAutoRestakePrefix, d.Bytes()...)
+}
+
+// gets the key for a delegation which auto-restakes its rewards
+func GetDelegatorAutoRestakeKey(d sdk.AccAddress, v sdk.ValAddress) []byte {
+	return append(GetDelegatorAutoRestakePrefix(d), v.Bytes()...)
+}
+
+// gets the prefix key for the withdraw history of an address
+func GetWithdrawRecordsPrefix(addr sdk.AccAddress) []byte {
+	return append(WithdrawRecordPrefix, addr.Bytes()...)
+}
+
+// gets the prefix key for the withdraw history of an address from a height
+func GetWithdrawRecordsHeightPrefix(addr sdk.AccAddress, height int64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, uint64(height))
+	return append(GetWithdrawRecordsPrefix(addr), b...)
+}
+
+// gets the key for a withdraw history record
+func GetWithdrawRecordKey(addr sdk.AccAddress, height int64, withdrawType types.WithdrawType, v sdk.ValAddress) []byte {
+	return append(append(GetWithdrawRecordsHeightPrefix(addr, height), byte(withdrawType)), v.Bytes()...)
+}
+
+// gets the prefix key for the height index of the withdraw history up to a height
+func GetWithdrawRecordHeightIndexPrefix(height int64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, uint64(height))
+	return append(WithdrawRecordHeightIndexPrefix, b...)
+}
+
+// gets the key for the height index of a withdraw history record
+func GetWithdrawRecordHeightIndexKey(height int64, addr sdk.AccAddress, withdrawType types.WithdrawType, v sdk.ValAddress) []byte {
+	return append(append(append(GetWithdrawRecordHeightIndexPrefix(height), addr.Bytes()...), byte(withdrawType)), v.Bytes()...)
+}
+
+// gets the withdraw history record key from a height index key
+func GetWithdrawRecordKeyFromHeightIndexKey(key []byte) []byte {
+	if len(key) != 1+8+sdk.AddrLen+1+sdk.AddrLen {
+		panic("unexpected key length")
+	}
+	height := int64(binary.BigEndian.Uint64(key[1:9]))
+	addr := sdk.AccAddress(key[9 : 9+sdk.AddrLen])
+	withdrawType := types.WithdrawType(key[9+sdk.AddrLen])
+	valAddr := sdk.ValAddress(key[10+sdk.AddrLen:])
+	return GetWithdrawRecordKey(addr, height, withdrawType, valAddr)
+}
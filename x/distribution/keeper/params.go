@@ -12,6 +12,9 @@ func ParamKeyTable() params.KeyTable {
 		ParamStoreKeyBaseProposerReward, sdk.Dec{},
 		ParamStoreKeyBonusProposerReward, sdk.Dec{},
 		ParamStoreKeyWithdrawAddrEnabled, false,
+		ParamStoreKeyRestakeEpoch, int64(0),
+		ParamStoreKeyRestakeGasBudget, uint64(0),
+		ParamStoreKeyWithdrawHistory, int64(0),
 	)
 }
 
@@ -66,3 +69,43 @@ func (k Keeper) GetWithdrawAddrEnabled(ctx sdk.Context) bool {
 func (k Keeper) SetWithdrawAddrEnabled(ctx sdk.Context, enabled bool) {
 	k.paramSpace.Set(ctx, ParamStoreKeyWithdrawAddrEnabled, &enabled)
 }
+
+// returns the number of blocks between two auto-restake passes
+// nolint: errcheck
+func (k Keeper) GetRestakeEpoch(ctx sdk.Context) int64 {
+	var epoch int64
+	k.paramSpace.Get(ctx, ParamStoreKeyRestakeEpoch, &epoch)
+	return epoch
+}
+
+// nolint: errcheck
+func (k Keeper) SetRestakeEpoch(ctx sdk.Context, epoch int64) {
+	k.paramSpace.Set(ctx, ParamStoreKeyRestakeEpoch, &epoch)
+}
+
+// returns the gas each block may spend on auto-restaking
+// nolint: errcheck
+func (k Keeper) GetRestakeGasBudget(ctx sdk.Context) uint64 {
+	var budget uint64
+	k.paramSpace.Get(ctx, ParamStoreKeyRestakeGasBudget, &budget)
+	return budget
+}
+
+// nolint: errcheck
+func (k Keeper) SetRestakeGasBudget(ctx sdk.Context, budget uint64) {
+	k.paramSpace.Set(ctx, ParamStoreKeyRestakeGasBudget, &budget)
+}
+
+// returns the number of blocks withdrawals are kept in the withdraw history,
+// the history is not recorded if zero
+// nolint: errcheck
+func (k Keeper) GetWithdrawHistory(ctx sdk.Context) int64 {
+	var window int64
+	k.paramSpace.Get(ctx, ParamStoreKeyWithdrawHistory, &window)
+	return window
+}
+
+// nolint: errcheck
+func (k Keeper) SetWithdrawHistory(ctx sdk.Context, window int64) {
+	k.paramSpace.Set(ctx, ParamStoreKeyWithdrawHistory, &window)
+}
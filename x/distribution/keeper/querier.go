@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"time"
 
 	abci "github.com/tendermint/tendermint/abci/types"
 
@@ -21,11 +22,16 @@ const (
 	QueryDelegatorValidators         = "delegator_validators"
 	QueryWithdrawAddr                = "withdraw_addr"
 	QueryCommunityPool               = "community_pool"
+	QueryDelegatorAutoRestakes       = "delegator_auto_restakes"
+	QueryWithdrawHistory             = "withdraw_history"
 
 	ParamCommunityTax        = "community_tax"
 	ParamBaseProposerReward  = "base_proposer_reward"
 	ParamBonusProposerReward = "bonus_proposer_reward"
 	ParamWithdrawAddrEnabled = "withdraw_addr_enabled"
+	ParamRestakeEpoch        = "restake_epoch"
+	ParamRestakeGasBudget    = "restake_gas_budget"
+	ParamWithdrawHistory     = "withdraw_history"
 )
 
 func NewQuerier(k Keeper) sdk.Querier {
@@ -58,6 +64,12 @@ func NewQuerier(k Keeper) sdk.Querier {
 		case QueryCommunityPool:
 			return queryCommunityPool(ctx, path[1:], req, k)
 
+		case QueryDelegatorAutoRestakes:
+			return queryDelegatorAutoRestakes(ctx, path[1:], req, k)
+
+		case QueryWithdrawHistory:
+			return queryWithdrawHistory(ctx, path[1:], req, k)
+
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown distr query endpoint")
 		}
@@ -90,6 +102,24 @@ func queryParams(ctx sdk.Context, path []string, req abci.RequestQuery, k Keeper
 			return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
 		}
 		return bz, nil
+	case ParamRestakeEpoch:
+		bz, err := codec.MarshalJSONIndent(k.cdc, k.GetRestakeEpoch(ctx))
+		if err != nil {
+			return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+		}
+		return bz, nil
+	case ParamRestakeGasBudget:
+		bz, err := codec.MarshalJSONIndent(k.cdc, k.GetRestakeGasBudget(ctx))
+		if err != nil {
+			return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+		}
+		return bz, nil
+	case ParamWithdrawHistory:
+		bz, err := codec.MarshalJSONIndent(k.cdc, k.GetWithdrawHistory(ctx))
+		if err != nil {
+			return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+		}
+		return bz, nil
 	default:
 		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("%s is not a valid query request path", req.Path))
 	}
@@ -229,7 +259,7 @@ func queryDelegationRewards(ctx sdk.Context, _ []string, req abci.RequestQuery,
 	return bz, nil
 }
 
-// params for query 'custom/distr/delegator_total_rewards' and 'custom/distr/delegator_validators'
+// params for query 'custom/distr/delegator_total_rewards', 'custom/distr/delegator_validators' and 'custom/distr/delegator_auto_restakes'
 type QueryDelegatorParams struct {
 	DelegatorAddress sdk.AccAddress `json:"delegator_address"`
 }
@@ -336,3 +366,77 @@ func queryCommunityPool(ctx sdk.Context, _ []string, req abci.RequestQuery, k Ke
 	}
 	return bz, nil
 }
+
+func queryDelegatorAutoRestakes(ctx sdk.Context, _ []string, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params QueryDelegatorParams
+	err := k.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	validators := types.AutoRestakeValidators(k.GetDelegatorAutoRestakeValidators(ctx, params.DelegatorAddress))
+	if validators == nil {
+		validators = types.AutoRestakeValidators{}
+	}
+
+	bz, err := codec.MarshalJSONIndent(k.cdc, validators)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+// params for query 'custom/distr/withdraw_history', the heights are inclusive
+// and a zero ending height or zero time bounds are unbounded
+type QueryWithdrawHistoryParams struct {
+	Address        sdk.AccAddress `json:"address"`
+	StartingHeight int64          `json:"starting_height"`
+	EndingHeight   int64          `json:"ending_height"`
+	StartingTime   time.Time      `json:"starting_time"`
+	EndingTime     time.Time      `json:"ending_time"`
+}
+
+// creates a new instance of QueryWithdrawHistoryParams
+func NewQueryWithdrawHistoryParams(addr sdk.AccAddress, startingHeight, endingHeight int64,
+	startingTime, endingTime time.Time) QueryWithdrawHistoryParams {
+	return QueryWithdrawHistoryParams{
+		Address:        addr,
+		StartingHeight: startingHeight,
+		EndingHeight:   endingHeight,
+		StartingTime:   startingTime,
+		EndingTime:     endingTime,
+	}
+}
+
+func queryWithdrawHistory(ctx sdk.Context, _ []string, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params QueryWithdrawHistoryParams
+	err := k.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	endingHeight := params.EndingHeight
+	if endingHeight <= 0 {
+		endingHeight = ctx.BlockHeight()
+	}
+
+	records := types.WithdrawRecords{}
+	k.IterateWithdrawRecordsBetween(ctx, params.Address, params.StartingHeight, endingHeight,
+		func(record types.WithdrawRecord) (stop bool) {
+			if !params.StartingTime.IsZero() && record.Time.Before(params.StartingTime) {
+				return false
+			}
+			if !params.EndingTime.IsZero() && record.Time.After(params.EndingTime) {
+				return false
+			}
+			records = append(records, record)
+			return false
+		},
+	)
+
+	bz, err := codec.MarshalJSONIndent(k.cdc, records)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
@@ -0,0 +1,188 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/PhenixChain/PhenixChain/types"
+	"github.com/PhenixChain/PhenixChain/x/distribution/types"
+)
+
+// check whether a delegation auto-restakes its rewards
+func (k Keeper) GetDelegatorAutoRestake(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(GetDelegatorAutoRestakeKey(delAddr, valAddr))
+}
+
+// set a delegation to auto-restake its rewards
+func (k Keeper) SetDelegatorAutoRestake(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(GetDelegatorAutoRestakeKey(delAddr, valAddr), []byte{0x01})
+}
+
+// stop a delegation from auto-restaking its rewards
+func (k Keeper) DeleteDelegatorAutoRestake(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(GetDelegatorAutoRestakeKey(delAddr, valAddr))
+}
+
+// iterate over the delegations which auto-restake their rewards
+func (k Keeper) IterateDelegatorAutoRestakes(ctx sdk.Context, handler func(delAddr sdk.AccAddress, valAddr sdk.ValAddress) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, DelegatorAutoRestakePrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		delAddr, valAddr := GetDelegatorAutoRestakeAddresses(iter.Key())
+		if handler(delAddr, valAddr) {
+			break
+		}
+	}
+}
+
+// get the validators a delegator auto-restakes its rewards with
+func (k Keeper) GetDelegatorAutoRestakeValidators(ctx sdk.Context, delAddr sdk.AccAddress) (validators []sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, GetDelegatorAutoRestakePrefix(delAddr))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		_, valAddr := GetDelegatorAutoRestakeAddresses(iter.Key())
+		validators = append(validators, valAddr)
+	}
+	return validators
+}
+
+// opt a delegation in or out of auto-restaking its rewards
+func (k Keeper) SetAutoRestake(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, enabled bool) sdk.Error {
+	if !enabled {
+		k.DeleteDelegatorAutoRestake(ctx, delAddr, valAddr)
+		return nil
+	}
+
+	if k.stakingKeeper.Delegation(ctx, delAddr, valAddr) == nil {
+		return types.ErrNoDelegationToRestake(k.codespace)
+	}
+
+	// the rewards must be paid to the delegator for it to delegate them
+	if !k.GetDelegatorWithdrawAddr(ctx, delAddr).Equals(delAddr) {
+		return types.ErrRestakeWithdrawAddr(k.codespace)
+	}
+
+	k.SetDelegatorAutoRestake(ctx, delAddr, valAddr)
+	return nil
+}
+
+// ProcessAutoRestakes compounds the rewards of the delegations which opted in
+// to auto-restaking. A pass over all of them starts every restake epoch and
+// is spread over as many blocks as needed so that each block spends at most
+// the restake gas budget on it. At least one delegation is processed per
+// block so that a pass always completes.
+func (k Keeper) ProcessAutoRestakes(ctx sdk.Context) {
+	k.processAutoRestakes(ctx, k.restakeDelegationRewards)
+}
+
+// processAutoRestakes runs the auto-restake pass with restake compounding the
+// rewards of a single delegation
+func (k Keeper) processAutoRestakes(ctx sdk.Context,
+	restake func(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) sdk.Error) {
+
+	cursor := k.getRestakeCursor(ctx)
+	if cursor == nil {
+		epoch := k.GetRestakeEpoch(ctx)
+		if epoch <= 0 || ctx.BlockHeight()%epoch != 0 {
+			return
+		}
+		cursor = DelegatorAutoRestakePrefix
+	}
+
+	logger := ctx.Logger().With("module", "x/distr")
+	budget := k.GetRestakeGasBudget(ctx)
+	gasMeter := sdk.NewInfiniteGasMeter()
+	restakeCtx := ctx.WithGasMeter(gasMeter)
+
+	for processed := 0; ; processed++ {
+		key := k.nextAutoRestakeKey(ctx, cursor)
+		if key == nil {
+			// pass completed
+			k.deleteRestakeCursor(ctx)
+			return
+		}
+
+		if processed > 0 && gasMeter.GasConsumed() >= budget {
+			// out of gas for this block, resume from here on the next one
+			k.setRestakeCursor(ctx, key)
+			return
+		}
+
+		delAddr, valAddr := GetDelegatorAutoRestakeAddresses(key)
+		cacheCtx, writeCache := restakeCtx.CacheContext()
+		if err := restake(cacheCtx, delAddr, valAddr); err != nil {
+			logger.Info(fmt.Sprintf("failed to auto-restake rewards of delegator %s with validator %s: %s",
+				delAddr, valAddr, err.ABCILog()))
+		} else {
+			writeCache()
+		}
+
+		// continue right after the processed key
+		cursor = append(append([]byte{}, key...), 0x00)
+	}
+}
+
+// withdraw the rewards of a delegation and delegate them back to the same
+// validator, only the bond denom can be delegated and other coins are left
+// in the delegator account
+func (k Keeper) restakeDelegationRewards(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) sdk.Error {
+	validator, found := k.stakingKeeper.GetValidator(ctx, valAddr)
+	if !found {
+		return types.ErrNoValidatorDistInfo(k.codespace)
+	}
+
+	del := k.stakingKeeper.Delegation(ctx, delAddr, valAddr)
+	if del == nil {
+		return types.ErrNoDelegationDistInfo(k.codespace)
+	}
+
+	// the withdraw address may have changed since opting in
+	if !k.GetDelegatorWithdrawAddr(ctx, delAddr).Equals(delAddr) {
+		return types.ErrRestakeWithdrawAddr(k.codespace)
+	}
+
+	coins, err := k.withdrawDelegationRewards(ctx, validator, del)
+	if err != nil {
+		return err
+	}
+	k.initializeDelegation(ctx, valAddr, delAddr)
+
+	amount := coins.AmountOf(k.stakingKeeper.BondDenom(ctx))
+	if !amount.IsPositive() {
+		return nil
+	}
+
+	_, err = k.stakingKeeper.Delegate(ctx, delAddr, amount, validator, true)
+	return err
+}
+
+// get the first auto-restake key at or after the cursor
+func (k Keeper) nextAutoRestakeKey(ctx sdk.Context, cursor []byte) []byte {
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator(cursor, sdk.PrefixEndBytes(DelegatorAutoRestakePrefix))
+	defer iter.Close()
+	if !iter.Valid() {
+		return nil
+	}
+	return iter.Key()
+}
+
+// get the cursor of the auto-restake pass in progress, nil if there is none
+func (k Keeper) getRestakeCursor(ctx sdk.Context) []byte {
+	store := ctx.KVStore(k.storeKey)
+	return store.Get(RestakeCursorKey)
+}
+
+func (k Keeper) setRestakeCursor(ctx sdk.Context, cursor []byte) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(RestakeCursorKey, cursor)
+}
+
+func (k Keeper) deleteRestakeCursor(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(RestakeCursorKey)
+}
@@ -0,0 +1,258 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	dbm "github.com/tendermint/tendermint/libs/db"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/PhenixChain/PhenixChain/codec"
+	"github.com/PhenixChain/PhenixChain/store"
+	sdk "github.com/PhenixChain/PhenixChain/types"
+	"github.com/PhenixChain/PhenixChain/x/auth"
+	"github.com/PhenixChain/PhenixChain/x/bank"
+	"github.com/PhenixChain/PhenixChain/x/distribution/types"
+	"github.com/PhenixChain/PhenixChain/x/params"
+	"github.com/PhenixChain/PhenixChain/x/staking"
+	stakingkeeper "github.com/PhenixChain/PhenixChain/x/staking/keeper"
+	stakingtypes "github.com/PhenixChain/PhenixChain/x/staking/types"
+)
+
+func setupRestakeTestInput(t *testing.T) (sdk.Context, Keeper) {
+	db := dbm.NewMemDB()
+	cdc := codec.New()
+
+	keyDistr := sdk.NewKVStoreKey(types.StoreKey)
+	keyParams := sdk.NewKVStoreKey(params.StoreKey)
+	tkeyParams := sdk.NewTransientStoreKey(params.TStoreKey)
+
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(keyDistr, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(tkeyParams, sdk.StoreTypeTransient, db)
+	require.NoError(t, ms.LoadLatestVersion())
+	ctx := sdk.NewContext(ms, abci.Header{}, false, log.NewNopLogger())
+
+	pk := params.NewKeeper(cdc, keyParams, tkeyParams)
+	k := NewKeeper(cdc, keyDistr, pk.Subspace(DefaultParamspace), nil, nil, nil, types.DefaultCodespace)
+	return ctx, k
+}
+
+// setupStakingTestInput returns a distribution keeper hooked to a staking
+// keeper, with a validator whose operator self-delegated 100 bond tokens and
+// holds 100 more
+func setupStakingTestInput(t *testing.T) (sdk.Context, Keeper, bank.Keeper, stakingkeeper.Keeper, sdk.ValAddress) {
+	db := dbm.NewMemDB()
+	cdc := codec.New()
+	auth.RegisterBaseAccount(cdc)
+	stakingtypes.RegisterCodec(cdc)
+
+	keyAcc := sdk.NewKVStoreKey(auth.StoreKey)
+	keyAddress := sdk.NewKVStoreKey(auth.StoreAdrKey)
+	keyFee := sdk.NewKVStoreKey(auth.FeeStoreKey)
+	keyBank := sdk.NewKVStoreKey(bank.StoreKey)
+	keyStaking := sdk.NewKVStoreKey(stakingtypes.StoreKey)
+	tkeyStaking := sdk.NewTransientStoreKey(stakingtypes.TStoreKey)
+	keyDistr := sdk.NewKVStoreKey(types.StoreKey)
+	keyParams := sdk.NewKVStoreKey(params.StoreKey)
+	tkeyParams := sdk.NewTransientStoreKey(params.TStoreKey)
+
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(keyAcc, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyAddress, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyFee, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyBank, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyStaking, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(tkeyStaking, sdk.StoreTypeTransient, db)
+	ms.MountStoreWithDB(keyDistr, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(tkeyParams, sdk.StoreTypeTransient, db)
+	require.NoError(t, ms.LoadLatestVersion())
+	ctx := sdk.NewContext(ms, abci.Header{}, false, log.NewNopLogger())
+
+	pk := params.NewKeeper(cdc, keyParams, tkeyParams)
+	ak := auth.NewAccountKeeper(cdc, keyAcc, pk.Subspace(auth.DefaultParamspace), auth.ProtoBaseAccount)
+	bk := bank.NewBaseKeeper(ak, bank.NewTxKeeper(cdc, keyAddress), keyBank,
+		pk.Subspace(bank.DefaultParamspace), bank.DefaultCodespace)
+	fck := auth.NewFeeCollectionKeeper(cdc, keyFee)
+	sk := stakingkeeper.NewKeeper(cdc, keyStaking, tkeyStaking, bk,
+		pk.Subspace(stakingkeeper.DefaultParamspace), stakingtypes.DefaultCodespace)
+	sk.SetParams(ctx, stakingtypes.DefaultParams())
+	sk.SetPool(ctx, stakingtypes.InitialPool())
+
+	k := NewKeeper(cdc, keyDistr, pk.Subspace(DefaultParamspace), bk, sk, fck, types.DefaultCodespace)
+	sk.SetHooks(k.Hooks())
+	genesis := types.DefaultGenesisState()
+	k.SetFeePool(ctx, genesis.FeePool)
+	k.SetCommunityTax(ctx, genesis.CommunityTax)
+	k.SetBaseProposerReward(ctx, genesis.BaseProposerReward)
+	k.SetBonusProposerReward(ctx, genesis.BonusProposerReward)
+	k.SetWithdrawAddrEnabled(ctx, genesis.WithdrawAddrEnabled)
+	k.SetRestakeEpoch(ctx, genesis.RestakeEpoch)
+	k.SetRestakeGasBudget(ctx, genesis.RestakeGasBudget)
+	k.SetWithdrawHistory(ctx, genesis.WithdrawHistory)
+
+	pubKey := ed25519.GenPrivKey().PubKey()
+	valAddr := sdk.ValAddress(pubKey.Address())
+	bondDenom := sk.BondDenom(ctx)
+	_, _, err := bk.AddCoins(ctx, sdk.AccAddress(valAddr), sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 200)))
+	require.Nil(t, err)
+	msg := stakingtypes.NewMsgCreateValidator(valAddr, pubKey, sdk.NewInt64Coin(bondDenom, 100),
+		stakingtypes.Description{Moniker: "validator"},
+		stakingtypes.NewCommissionMsg(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()), sdk.OneInt())
+	require.True(t, staking.NewHandler(sk)(ctx, msg).IsOK())
+
+	return ctx, k, bk, sk, valAddr
+}
+
+func TestSetAutoRestake(t *testing.T) {
+	ctx, k, _, _, valAddr := setupStakingTestInput(t)
+	delAddr := sdk.AccAddress(valAddr)
+
+	// only existing delegations can opt in
+	other := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	require.NotNil(t, k.SetAutoRestake(ctx, other, valAddr, true))
+	require.False(t, k.GetDelegatorAutoRestake(ctx, other, valAddr))
+
+	// rewards paid to another address cannot be delegated
+	k.SetDelegatorWithdrawAddr(ctx, delAddr, other)
+	err := k.SetAutoRestake(ctx, delAddr, valAddr, true)
+	require.NotNil(t, err)
+	require.Equal(t, types.ErrRestakeWithdrawAddr(types.DefaultCodespace), err)
+	require.False(t, k.GetDelegatorAutoRestake(ctx, delAddr, valAddr))
+
+	k.SetDelegatorWithdrawAddr(ctx, delAddr, delAddr)
+	require.Nil(t, k.SetAutoRestake(ctx, delAddr, valAddr, true))
+	require.True(t, k.GetDelegatorAutoRestake(ctx, delAddr, valAddr))
+	require.Nil(t, k.SetAutoRestake(ctx, delAddr, valAddr, false))
+	require.False(t, k.GetDelegatorAutoRestake(ctx, delAddr, valAddr))
+}
+
+func TestRestakeDelegationRewards(t *testing.T) {
+	ctx, k, bk, sk, valAddr := setupStakingTestInput(t)
+	delAddr := sdk.AccAddress(valAddr)
+	bondDenom := sk.BondDenom(ctx)
+	require.Nil(t, k.SetAutoRestake(ctx, delAddr, valAddr, true))
+
+	// the rewards are withdrawn and delegated back, coins other than the bond
+	// denom stay in the account
+	ctx = ctx.WithBlockHeight(1)
+	validator, _ := sk.GetValidator(ctx, valAddr)
+	k.AllocateTokensToValidator(ctx, validator, sdk.DecCoins{
+		sdk.NewDecCoin(bondDenom, sdk.NewInt(50)),
+		sdk.NewDecCoin("ugold", sdk.NewInt(7)),
+	})
+	require.Nil(t, k.restakeDelegationRewards(ctx, delAddr, valAddr))
+
+	delegation, found := sk.GetDelegation(ctx, delAddr, valAddr)
+	require.True(t, found)
+	validator, _ = sk.GetValidator(ctx, valAddr)
+	require.Equal(t, sdk.NewInt(150), validator.TokensFromShares(delegation.Shares).TruncateInt())
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 100), sdk.NewInt64Coin("ugold", 7)),
+		bk.GetCoins(ctx, delAddr))
+	require.True(t, k.GetValidatorOutstandingRewards(ctx, valAddr).IsZero())
+
+	// nothing is left to restake
+	require.Nil(t, k.restakeDelegationRewards(ctx, delAddr, valAddr))
+	delegation, _ = sk.GetDelegation(ctx, delAddr, valAddr)
+	validator, _ = sk.GetValidator(ctx, valAddr)
+	require.Equal(t, sdk.NewInt(150), validator.TokensFromShares(delegation.Shares).TruncateInt())
+
+	// rewards are no longer restaked once they are paid to another address
+	other := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	k.SetDelegatorWithdrawAddr(ctx, delAddr, other)
+	k.AllocateTokensToValidator(ctx, validator, sdk.DecCoins{sdk.NewDecCoin(bondDenom, sdk.NewInt(10))})
+	err := k.restakeDelegationRewards(ctx, delAddr, valAddr)
+	require.NotNil(t, err)
+	require.Equal(t, types.ErrRestakeWithdrawAddr(types.DefaultCodespace), err)
+	require.True(t, bk.GetCoins(ctx, other).IsZero())
+}
+
+func TestProcessAutoRestakesResumesAtCursor(t *testing.T) {
+	ctx, k := setupRestakeTestInput(t)
+	k.SetRestakeEpoch(ctx, 10)
+	k.SetRestakeGasBudget(ctx, 250)
+
+	var delegators []string
+	for i := 0; i < 7; i++ {
+		delAddr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+		valAddr := sdk.ValAddress(ed25519.GenPrivKey().PubKey().Address())
+		k.SetDelegatorAutoRestake(ctx, delAddr, valAddr)
+		delegators = append(delegators, delAddr.String())
+	}
+
+	// every restake costs 100 gas and a block keeps restaking while under the
+	// budget of 250, so it fits three of them
+	var restaked []string
+	restake := func(ctx sdk.Context, delAddr sdk.AccAddress, _ sdk.ValAddress) sdk.Error {
+		ctx.GasMeter().ConsumeGas(100, "restake")
+		restaked = append(restaked, delAddr.String())
+		return nil
+	}
+	process := func(height int64) []string {
+		restaked = nil
+		k.processAutoRestakes(ctx.WithBlockHeight(height), restake)
+		return restaked
+	}
+
+	// no pass starts before the epoch
+	require.Empty(t, process(9))
+
+	var all []string
+	for _, height := range []int64{10, 11, 12} {
+		all = append(all, process(height)...)
+	}
+	require.Len(t, restaked, 1)
+	require.Nil(t, k.getRestakeCursor(ctx))
+
+	// the pass covered every delegation once, in key order
+	expected := make([]string, 0, len(delegators))
+	k.IterateDelegatorAutoRestakes(ctx, func(delAddr sdk.AccAddress, _ sdk.ValAddress) bool {
+		expected = append(expected, delAddr.String())
+		return false
+	})
+	require.ElementsMatch(t, delegators, expected)
+	require.Equal(t, expected, all)
+
+	// the next pass waits for the next epoch
+	require.Empty(t, process(13))
+	require.Len(t, process(20), 3)
+}
+
+func TestProcessAutoRestakesCursorSurvivesChanges(t *testing.T) {
+	ctx, k := setupRestakeTestInput(t)
+	k.SetRestakeEpoch(ctx, 10)
+	k.SetRestakeGasBudget(ctx, 1)
+
+	delAddrs := make([]sdk.AccAddress, 3)
+	valAddr := sdk.ValAddress(ed25519.GenPrivKey().PubKey().Address())
+	for i := range delAddrs {
+		delAddrs[i] = sdk.AccAddress([]byte{byte(i + 1), 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0})
+		k.SetDelegatorAutoRestake(ctx, delAddrs[i], valAddr)
+	}
+
+	var restaked []sdk.AccAddress
+	restake := func(ctx sdk.Context, delAddr sdk.AccAddress, _ sdk.ValAddress) sdk.Error {
+		ctx.GasMeter().ConsumeGas(1, "restake")
+		restaked = append(restaked, delAddr)
+		return nil
+	}
+
+	// one delegation per block, the first one is processed on the epoch
+	k.processAutoRestakes(ctx.WithBlockHeight(10), restake)
+	require.Equal(t, []sdk.AccAddress{delAddrs[0]}, restaked)
+	require.Equal(t, GetDelegatorAutoRestakeKey(delAddrs[1], valAddr), k.getRestakeCursor(ctx))
+
+	// the delegation at the cursor opts out before the next block: the pass
+	// goes on with the following one instead of restarting or skipping it
+	k.DeleteDelegatorAutoRestake(ctx, delAddrs[1], valAddr)
+	k.processAutoRestakes(ctx.WithBlockHeight(11), restake)
+	require.Equal(t, []sdk.AccAddress{delAddrs[0], delAddrs[2]}, restaked)
+
+	k.processAutoRestakes(ctx.WithBlockHeight(12), restake)
+	require.Len(t, restaked, 2)
+	require.Nil(t, k.getRestakeCursor(ctx))
+}
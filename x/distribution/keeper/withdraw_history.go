@@ -0,0 +1,96 @@
+package keeper
+
+import (
+	sdk "github.com/PhenixChain/PhenixChain/types"
+	"github.com/PhenixChain/PhenixChain/x/distribution/types"
+)
+
+// get a withdraw history record
+func (k Keeper) GetWithdrawRecord(ctx sdk.Context, addr sdk.AccAddress, height int64,
+	withdrawType types.WithdrawType, valAddr sdk.ValAddress) (record types.WithdrawRecord, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(GetWithdrawRecordKey(addr, height, withdrawType, valAddr))
+	if b == nil {
+		return record, false
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(b, &record)
+	return record, true
+}
+
+// set a withdraw history record
+func (k Keeper) SetWithdrawRecord(ctx sdk.Context, record types.WithdrawRecord) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshalBinaryLengthPrefixed(record)
+	store.Set(GetWithdrawRecordKey(record.Address, record.Height, record.Type, record.Validator), b)
+	store.Set(GetWithdrawRecordHeightIndexKey(record.Height, record.Address, record.Type, record.Validator), []byte{0x01})
+}
+
+// iterate over the withdraw history of an address between two heights, inclusive
+func (k Keeper) IterateWithdrawRecordsBetween(ctx sdk.Context, addr sdk.AccAddress, startingHeight, endingHeight int64,
+	handler func(record types.WithdrawRecord) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator(
+		GetWithdrawRecordsHeightPrefix(addr, startingHeight),
+		GetWithdrawRecordsHeightPrefix(addr, endingHeight+1),
+	)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var record types.WithdrawRecord
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &record)
+		if handler(record) {
+			break
+		}
+	}
+}
+
+// iterate over the withdraw history of all addresses
+func (k Keeper) IterateWithdrawRecords(ctx sdk.Context, handler func(record types.WithdrawRecord) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, WithdrawRecordPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var record types.WithdrawRecord
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &record)
+		if handler(record) {
+			break
+		}
+	}
+}
+
+// delete the withdraw history records at or below a height
+func (k Keeper) DeleteWithdrawRecordsUpTo(ctx sdk.Context, height int64) {
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator(WithdrawRecordHeightIndexPrefix, GetWithdrawRecordHeightIndexPrefix(height+1))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		store.Delete(GetWithdrawRecordKeyFromHeightIndexKey(iter.Key()))
+		store.Delete(iter.Key())
+	}
+}
+
+// prune the withdraw history records older than the history window
+func (k Keeper) PruneWithdrawRecords(ctx sdk.Context) {
+	window := k.GetWithdrawHistory(ctx)
+	if window <= 0 || ctx.BlockHeight() <= window {
+		return
+	}
+	k.DeleteWithdrawRecordsUpTo(ctx, ctx.BlockHeight()-window)
+}
+
+// record a withdrawal in the withdraw history if it is enabled, withdrawals of
+// the same kind within a block are summed up
+func (k Keeper) recordWithdrawal(ctx sdk.Context, addr sdk.AccAddress, valAddr sdk.ValAddress,
+	withdrawType types.WithdrawType, amount sdk.Coins) {
+	if k.GetWithdrawHistory(ctx) <= 0 || amount.IsZero() {
+		return
+	}
+
+	height := ctx.BlockHeight()
+	record, found := k.GetWithdrawRecord(ctx, addr, height, withdrawType, valAddr)
+	if found {
+		record.Amount = record.Amount.Add(amount)
+	} else {
+		record = types.NewWithdrawRecord(addr, valAddr, withdrawType, height, ctx.BlockHeader().Time, amount)
+	}
+	k.SetWithdrawRecord(ctx, record)
+}
@@ -0,0 +1,136 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+
+	sdk "github.com/PhenixChain/PhenixChain/types"
+	"github.com/PhenixChain/PhenixChain/x/distribution/types"
+)
+
+func TestRecordWithdrawal(t *testing.T) {
+	ctx, k := setupRestakeTestInput(t)
+	delAddr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	valAddr := sdk.ValAddress(ed25519.GenPrivKey().PubKey().Address())
+	coins := sdk.NewCoins(sdk.NewInt64Coin("upnx", 10))
+	ctx = ctx.WithBlockHeight(5)
+
+	// nothing is recorded while the history is disabled
+	k.SetWithdrawHistory(ctx, 0)
+	k.recordWithdrawal(ctx, delAddr, valAddr, types.WithdrawTypeRewards, coins)
+	_, found := k.GetWithdrawRecord(ctx, delAddr, 5, types.WithdrawTypeRewards, valAddr)
+	require.False(t, found)
+
+	k.SetWithdrawHistory(ctx, 10)
+	k.recordWithdrawal(ctx, delAddr, valAddr, types.WithdrawTypeRewards, sdk.NewCoins())
+	_, found = k.GetWithdrawRecord(ctx, delAddr, 5, types.WithdrawTypeRewards, valAddr)
+	require.False(t, found)
+
+	// withdrawals of the same kind within a block are merged
+	k.recordWithdrawal(ctx, delAddr, valAddr, types.WithdrawTypeRewards, coins)
+	k.recordWithdrawal(ctx, delAddr, valAddr, types.WithdrawTypeRewards, coins)
+	k.recordWithdrawal(ctx, delAddr, valAddr, types.WithdrawTypeCommission, coins)
+	record, found := k.GetWithdrawRecord(ctx, delAddr, 5, types.WithdrawTypeRewards, valAddr)
+	require.True(t, found)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("upnx", 20)), record.Amount)
+	record, found = k.GetWithdrawRecord(ctx, delAddr, 5, types.WithdrawTypeCommission, valAddr)
+	require.True(t, found)
+	require.Equal(t, coins, record.Amount)
+
+	// the next block gets a record of its own
+	k.recordWithdrawal(ctx.WithBlockHeight(6), delAddr, valAddr, types.WithdrawTypeRewards, coins)
+	record, found = k.GetWithdrawRecord(ctx, delAddr, 6, types.WithdrawTypeRewards, valAddr)
+	require.True(t, found)
+	require.Equal(t, coins, record.Amount)
+}
+
+func TestIterateAndPruneWithdrawRecords(t *testing.T) {
+	ctx, k := setupRestakeTestInput(t)
+	k.SetWithdrawHistory(ctx, 3)
+	delAddr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	other := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	valAddr := sdk.ValAddress(ed25519.GenPrivKey().PubKey().Address())
+	for height := int64(1); height <= 6; height++ {
+		coins := sdk.NewCoins(sdk.NewInt64Coin("upnx", height))
+		k.recordWithdrawal(ctx.WithBlockHeight(height), delAddr, valAddr, types.WithdrawTypeRewards, coins)
+		k.recordWithdrawal(ctx.WithBlockHeight(height), other, valAddr, types.WithdrawTypeRewards, coins)
+	}
+
+	heightsBetween := func(addr sdk.AccAddress, start, end int64) (heights []int64) {
+		k.IterateWithdrawRecordsBetween(ctx, addr, start, end, func(record types.WithdrawRecord) bool {
+			require.Equal(t, addr, record.Address)
+			heights = append(heights, record.Height)
+			return false
+		})
+		return heights
+	}
+	require.Equal(t, []int64{2, 3, 4}, heightsBetween(delAddr, 2, 4))
+	require.Equal(t, []int64{1, 2, 3, 4, 5, 6}, heightsBetween(other, 0, 10))
+	require.Empty(t, heightsBetween(delAddr, 7, 10))
+
+	// records within the window of the last three blocks are kept
+	k.PruneWithdrawRecords(ctx.WithBlockHeight(3))
+	require.Equal(t, []int64{1, 2, 3, 4, 5, 6}, heightsBetween(delAddr, 0, 10))
+	k.PruneWithdrawRecords(ctx.WithBlockHeight(6))
+	require.Equal(t, []int64{4, 5, 6}, heightsBetween(delAddr, 0, 10))
+	require.Equal(t, []int64{4, 5, 6}, heightsBetween(other, 0, 10))
+
+	k.DeleteWithdrawRecordsUpTo(ctx, 5)
+	require.Equal(t, []int64{6}, heightsBetween(delAddr, 0, 10))
+	var count int
+	k.IterateWithdrawRecords(ctx, func(types.WithdrawRecord) bool {
+		count++
+		return false
+	})
+	require.Equal(t, 2, count)
+
+	// pruning is off while the history is disabled
+	k.SetWithdrawHistory(ctx, 0)
+	k.PruneWithdrawRecords(ctx.WithBlockHeight(100))
+	require.Equal(t, []int64{6}, heightsBetween(delAddr, 0, 10))
+}
+
+func TestQueryWithdrawHistory(t *testing.T) {
+	ctx, k := setupRestakeTestInput(t)
+	k.SetWithdrawHistory(ctx, 100)
+	querier := NewQuerier(k)
+	delAddr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	valAddr := sdk.ValAddress(ed25519.GenPrivKey().PubKey().Address())
+	start := time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)
+	for height := int64(1); height <= 4; height++ {
+		blockCtx := ctx.WithBlockHeight(height).WithBlockTime(start.Add(time.Duration(height) * 24 * time.Hour))
+		k.recordWithdrawal(blockCtx, delAddr, valAddr, types.WithdrawTypeRewards, sdk.NewCoins(sdk.NewInt64Coin("upnx", height)))
+	}
+	ctx = ctx.WithBlockHeight(4)
+
+	query := func(params QueryWithdrawHistoryParams) (heights []int64) {
+		bz, err := querier(ctx, []string{QueryWithdrawHistory}, abci.RequestQuery{Data: k.cdc.MustMarshalJSON(params)})
+		require.Nil(t, err)
+		var records types.WithdrawRecords
+		k.cdc.MustUnmarshalJSON(bz, &records)
+		for _, record := range records {
+			heights = append(heights, record.Height)
+		}
+		return heights
+	}
+
+	require.Equal(t, []int64{1, 2, 3, 4},
+		query(NewQueryWithdrawHistoryParams(delAddr, 0, 0, time.Time{}, time.Time{})))
+	require.Equal(t, []int64{2, 3},
+		query(NewQueryWithdrawHistoryParams(delAddr, 2, 3, time.Time{}, time.Time{})))
+	require.Equal(t, []int64{2, 3},
+		query(NewQueryWithdrawHistoryParams(delAddr, 0, 0, start.Add(48*time.Hour), start.Add(72*time.Hour))))
+	require.Equal(t, []int64{3, 4},
+		query(NewQueryWithdrawHistoryParams(delAddr, 0, 0, start.Add(60*time.Hour), time.Time{})))
+	require.Equal(t, []int64{3},
+		query(NewQueryWithdrawHistoryParams(delAddr, 3, 0, time.Time{}, start.Add(80*time.Hour))))
+	require.Empty(t,
+		query(NewQueryWithdrawHistoryParams(delAddr, 0, 0, start.Add(100*time.Hour), time.Time{})))
+
+	_, err := querier(ctx, []string{QueryWithdrawHistory}, abci.RequestQuery{Data: []byte("{")})
+	require.NotNil(t, err)
+}
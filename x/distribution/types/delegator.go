@@ -1,6 +1,9 @@
 package types
 
 import (
+	"fmt"
+	"strings"
+
 	sdk "github.com/PhenixChain/PhenixChain/types"
 )
 
@@ -25,3 +28,17 @@ func NewDelegatorStartingInfo(previousPeriod uint64, stake sdk.Dec, height uint6
 		Height:         height,
 	}
 }
+
+// validators a delegator auto-restakes its rewards with
+type AutoRestakeValidators []sdk.ValAddress
+
+func (vals AutoRestakeValidators) String() string {
+	if len(vals) == 0 {
+		return "[]"
+	}
+	out := ""
+	for _, val := range vals {
+		out += fmt.Sprintf("%s\n", val)
+	}
+	return strings.TrimSpace(out)
+}
@@ -13,6 +13,7 @@ const (
 	CodeNoDistributionInfo      CodeType          = 104
 	CodeNoValidatorCommission   CodeType          = 105
 	CodeSetWithdrawAddrDisabled CodeType          = 106
+	CodeAutoRestake             CodeType          = 107
 )
 
 func ErrNilDelegatorAddr(codespace sdk.CodespaceType) sdk.Error {
@@ -39,3 +40,9 @@ func ErrSetWithdrawAddrDisabled(codespace sdk.CodespaceType) sdk.Error {
 func ErrBadDistribution(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeInvalidInput, "community pool does not have sufficient coins to distribute")
 }
+func ErrNoDelegationToRestake(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeAutoRestake, "no delegation to auto-restake")
+}
+func ErrRestakeWithdrawAddr(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeAutoRestake, "rewards can only be auto-restaked if they are withdrawn to the delegator address")
+}
@@ -1,6 +1,9 @@
 package types
 
-import sdk "github.com/PhenixChain/PhenixChain/types"
+import (
+	sdk "github.com/PhenixChain/PhenixChain/types"
+	stakingtypes "github.com/PhenixChain/PhenixChain/x/staking/types"
+)
 
 // expected staking keeper
 type StakingKeeper interface {
@@ -16,6 +19,12 @@ type StakingKeeper interface {
 	IterateValidators(ctx sdk.Context,
 		fn func(index int64, validator sdk.Validator) (stop bool))
 	GetAllSDKDelegations(ctx sdk.Context) []sdk.Delegation
+
+	// used for auto-restaking rewards
+	GetValidator(ctx sdk.Context, addr sdk.ValAddress) (validator stakingtypes.Validator, found bool)
+	Delegate(ctx sdk.Context, delAddr sdk.AccAddress, bondAmt sdk.Int,
+		validator stakingtypes.Validator, subtractAccount bool) (newShares sdk.Dec, err sdk.Error)
+	BondDenom(ctx sdk.Context) string
 }
 
 // expected coin keeper
@@ -13,6 +13,12 @@ type DelegatorWithdrawInfo struct {
 	WithdrawAddress  sdk.AccAddress `json:"withdraw_address"`
 }
 
+// used for import/export via genesis json
+type DelegatorAutoRestakeRecord struct {
+	DelegatorAddress sdk.AccAddress `json:"delegator_address"`
+	ValidatorAddress sdk.ValAddress `json:"validator_address"`
+}
+
 // used for import/export via genesis json
 type ValidatorOutstandingRewardsRecord struct {
 	ValidatorAddress   sdk.ValAddress `json:"validator_address"`
@@ -59,6 +65,9 @@ type GenesisState struct {
 	BaseProposerReward              sdk.Dec                                `json:"base_proposer_reward"`
 	BonusProposerReward             sdk.Dec                                `json:"bonus_proposer_reward"`
 	WithdrawAddrEnabled             bool                                   `json:"withdraw_addr_enabled"`
+	RestakeEpoch                    int64                                  `json:"restake_epoch"`
+	RestakeGasBudget                uint64                                 `json:"restake_gas_budget"`
+	WithdrawHistory                 int64                                  `json:"withdraw_history"`
 	DelegatorWithdrawInfos          []DelegatorWithdrawInfo                `json:"delegator_withdraw_infos"`
 	PreviousProposer                sdk.ConsAddress                        `json:"previous_proposer"`
 	OutstandingRewards              []ValidatorOutstandingRewardsRecord    `json:"outstanding_rewards"`
@@ -67,13 +76,17 @@ type GenesisState struct {
 	ValidatorCurrentRewards         []ValidatorCurrentRewardsRecord        `json:"validator_current_rewards"`
 	DelegatorStartingInfos          []DelegatorStartingInfoRecord          `json:"delegator_starting_infos"`
 	ValidatorSlashEvents            []ValidatorSlashEventRecord            `json:"validator_slash_events"`
+	DelegatorAutoRestakes           []DelegatorAutoRestakeRecord           `json:"delegator_auto_restakes"`
+	WithdrawRecords                 []WithdrawRecord                       `json:"withdraw_records"`
 }
 
 func NewGenesisState(feePool FeePool, communityTax, baseProposerReward, bonusProposerReward sdk.Dec,
-	withdrawAddrEnabled bool, dwis []DelegatorWithdrawInfo, pp sdk.ConsAddress, r []ValidatorOutstandingRewardsRecord,
+	withdrawAddrEnabled bool, restakeEpoch int64, restakeGasBudget uint64, withdrawHistory int64, dwis []DelegatorWithdrawInfo,
+	pp sdk.ConsAddress, r []ValidatorOutstandingRewardsRecord,
 	acc []ValidatorAccumulatedCommissionRecord, historical []ValidatorHistoricalRewardsRecord,
 	cur []ValidatorCurrentRewardsRecord, dels []DelegatorStartingInfoRecord,
-	slashes []ValidatorSlashEventRecord) GenesisState {
+	slashes []ValidatorSlashEventRecord, restakes []DelegatorAutoRestakeRecord,
+	withdrawRecords []WithdrawRecord) GenesisState {
 
 	return GenesisState{
 		FeePool:                         feePool,
@@ -81,6 +94,9 @@ func NewGenesisState(feePool FeePool, communityTax, baseProposerReward, bonusPro
 		BaseProposerReward:              baseProposerReward,
 		BonusProposerReward:             bonusProposerReward,
 		WithdrawAddrEnabled:             withdrawAddrEnabled,
+		RestakeEpoch:                    restakeEpoch,
+		RestakeGasBudget:                restakeGasBudget,
+		WithdrawHistory:                 withdrawHistory,
 		DelegatorWithdrawInfos:          dwis,
 		PreviousProposer:                pp,
 		OutstandingRewards:              r,
@@ -89,6 +105,8 @@ func NewGenesisState(feePool FeePool, communityTax, baseProposerReward, bonusPro
 		ValidatorCurrentRewards:         cur,
 		DelegatorStartingInfos:          dels,
 		ValidatorSlashEvents:            slashes,
+		DelegatorAutoRestakes:           restakes,
+		WithdrawRecords:                 withdrawRecords,
 	}
 }
 
@@ -100,6 +118,9 @@ func DefaultGenesisState() GenesisState {
 		BaseProposerReward:              sdk.NewDecWithPrec(1, 2), // 1%
 		BonusProposerReward:             sdk.NewDecWithPrec(4, 2), // 4%
 		WithdrawAddrEnabled:             true,
+		RestakeEpoch:                    100,
+		RestakeGasBudget:                1000000,
+		WithdrawHistory:                 0,
 		DelegatorWithdrawInfos:          []DelegatorWithdrawInfo{},
 		PreviousProposer:                nil,
 		OutstandingRewards:              []ValidatorOutstandingRewardsRecord{},
@@ -108,6 +129,8 @@ func DefaultGenesisState() GenesisState {
 		ValidatorCurrentRewards:         []ValidatorCurrentRewardsRecord{},
 		DelegatorStartingInfos:          []DelegatorStartingInfoRecord{},
 		ValidatorSlashEvents:            []ValidatorSlashEventRecord{},
+		DelegatorAutoRestakes:           []DelegatorAutoRestakeRecord{},
+		WithdrawRecords:                 []WithdrawRecord{},
 	}
 }
 
@@ -131,5 +154,13 @@ func ValidateGenesis(data GenesisState) error {
 			"BonusProposerReward cannot add to be greater than one, "+
 			"adds to %s", data.BaseProposerReward.Add(data.BonusProposerReward).String())
 	}
+	if data.RestakeEpoch < 0 {
+		return fmt.Errorf("distribution parameter RestakeEpoch should be non-negative, is %d",
+			data.RestakeEpoch)
+	}
+	if data.WithdrawHistory < 0 {
+		return fmt.Errorf("distribution parameter WithdrawHistory should be non-negative, is %d",
+			data.WithdrawHistory)
+	}
 	return data.FeePool.ValidateGenesis()
 }
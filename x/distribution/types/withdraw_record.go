@@ -0,0 +1,106 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/PhenixChain/PhenixChain/types"
+)
+
+// WithdrawType is the kind of payout recorded in the withdraw history
+type WithdrawType byte
+
+// nolint
+const (
+	WithdrawTypeNil        WithdrawType = 0x00
+	WithdrawTypeRewards    WithdrawType = 0x01
+	WithdrawTypeCommission WithdrawType = 0x02
+)
+
+// WithdrawTypeFromString turns a string into a WithdrawType
+func WithdrawTypeFromString(str string) (WithdrawType, error) {
+	switch str {
+	case "rewards":
+		return WithdrawTypeRewards, nil
+	case "commission":
+		return WithdrawTypeCommission, nil
+	case "":
+		return WithdrawTypeNil, nil
+	default:
+		return WithdrawType(0xff), fmt.Errorf("'%s' is not a valid withdraw type", str)
+	}
+}
+
+// Marshals to JSON using string
+func (wt WithdrawType) MarshalJSON() ([]byte, error) {
+	return json.Marshal(wt.String())
+}
+
+// Unmarshals from JSON using string
+func (wt *WithdrawType) UnmarshalJSON(data []byte) error {
+	var s string
+	err := json.Unmarshal(data, &s)
+	if err != nil {
+		return err
+	}
+
+	bz2, err := WithdrawTypeFromString(s)
+	if err != nil {
+		return err
+	}
+	*wt = bz2
+	return nil
+}
+
+// Turns WithdrawType byte to String
+func (wt WithdrawType) String() string {
+	switch wt {
+	case WithdrawTypeRewards:
+		return "rewards"
+	case WithdrawTypeCommission:
+		return "commission"
+	default:
+		return ""
+	}
+}
+
+// a reward or commission withdrawal kept in the withdraw history, rewards are
+// recorded for the delegator and commission for the validator operator account
+type WithdrawRecord struct {
+	Address   sdk.AccAddress `json:"address"`
+	Validator sdk.ValAddress `json:"validator"`
+	Type      WithdrawType   `json:"type"`
+	Height    int64          `json:"height"`
+	Time      time.Time      `json:"time"`
+	Amount    sdk.Coins      `json:"amount"`
+}
+
+// create a new WithdrawRecord
+func NewWithdrawRecord(addr sdk.AccAddress, valAddr sdk.ValAddress, withdrawType WithdrawType,
+	height int64, time time.Time, amount sdk.Coins) WithdrawRecord {
+	return WithdrawRecord{
+		Address:   addr,
+		Validator: valAddr,
+		Type:      withdrawType,
+		Height:    height,
+		Time:      time,
+		Amount:    amount,
+	}
+}
+
+// withdraw history of an address, in increasing height
+type WithdrawRecords []WithdrawRecord
+
+func (records WithdrawRecords) String() string {
+	if len(records) == 0 {
+		return "[]"
+	}
+	out := fmt.Sprintf("%-10s %-30s %-11s %-52s %s\n", "Height", "Time", "Type", "Validator", "Amount")
+	for _, record := range records {
+		out += fmt.Sprintf("%-10d %-30s %-11s %-52s %s\n", record.Height, record.Time.UTC().Format(time.RFC3339),
+			record.Type, record.Validator, record.Amount)
+	}
+	return strings.TrimSpace(out)
+}
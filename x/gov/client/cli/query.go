@@ -58,19 +58,29 @@ Query for a all proposals. You can filter the returns with the following flags:
 
 $ gaiacli query gov proposals --depositor cosmos1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk
 $ gaiacli query gov proposals --voter cosmos1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk
-$ gaiacli query gov proposals --status (DepositPeriod|VotingPeriod|Passed|Rejected)
+$ gaiacli query gov proposals --status (DepositPeriod|VotingPeriod|Passed|Rejected|Failed|Dropped)
+$ gaiacli query gov proposals --type (Text|SoftwareUpgrade|CommunitySpend)
+
+Proposals that left the deposit or voting period are kept with their final tally,
+deposit outcome and execution result. Results are paginated from the most recent
+proposals, page 1 holding the latest --limit matching proposals:
+
+$ gaiacli query gov proposals --status Passed --limit 10 --page 2
 `),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			bechDepositorAddr := viper.GetString(flagDepositor)
 			bechVoterAddr := viper.GetString(flagVoter)
 			strProposalStatus := viper.GetString(flagStatus)
+			strProposalType := viper.GetString(flagProposalType)
 			numLimit := uint64(viper.GetInt64(flagNumLimit))
+			page := uint64(viper.GetInt64(flagPage))
 
 			var depositorAddr sdk.AccAddress
 			var voterAddr sdk.AccAddress
 			var proposalStatus gov.ProposalStatus
+			var proposalType gov.ProposalKind
 
-			params := gov.NewQueryProposalsParams(proposalStatus, numLimit, voterAddr, depositorAddr)
+			params := gov.NewQueryProposalsParams(proposalStatus, proposalType, page, numLimit, voterAddr, depositorAddr)
 
 			if len(bechDepositorAddr) != 0 {
 				depositorAddr, err := sdk.AccAddressFromBech32(bechDepositorAddr)
@@ -96,6 +106,14 @@ $ gaiacli query gov proposals --status (DepositPeriod|VotingPeriod|Passed|Reject
 				params.ProposalStatus = proposalStatus
 			}
 
+			if len(strProposalType) != 0 {
+				proposalType, err := gov.ProposalTypeFromString(gcutils.NormalizeProposalType(strProposalType))
+				if err != nil {
+					return err
+				}
+				params.ProposalType = proposalType
+			}
+
 			bz, err := cdc.MarshalJSON(params)
 			if err != nil {
 				return err
@@ -122,10 +140,12 @@ $ gaiacli query gov proposals --status (DepositPeriod|VotingPeriod|Passed|Reject
 		},
 	}
 
-	cmd.Flags().String(flagNumLimit, "", "(optional) limit to latest [number] proposals. Defaults to all proposals")
+	cmd.Flags().String(flagNumLimit, "", "(optional) limit to latest [number] matching proposals. Defaults to all proposals")
+	cmd.Flags().String(flagPage, "", "(optional) page of [limit] matching proposals, counting back from the latest. Defaults to 1")
 	cmd.Flags().String(flagDepositor, "", "(optional) filter by proposals deposited on by depositor")
 	cmd.Flags().String(flagVoter, "", "(optional) filter by proposals voted on by voted")
-	cmd.Flags().String(flagStatus, "", "(optional) filter proposals by proposal status, status: deposit_period/voting_period/passed/rejected")
+	cmd.Flags().String(flagStatus, "", "(optional) filter proposals by proposal status, status: deposit_period/voting_period/passed/rejected/failed/dropped")
+	cmd.Flags().String(flagProposalType, "", "(optional) filter proposals by proposal type, types: text/software_upgrade/community_spend")
 
 	return cmd
 }
@@ -14,6 +14,7 @@ import (
 	"strings"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 
 	govClientUtils "github.com/PhenixChain/PhenixChain/x/gov/client/utils"
 )
@@ -28,7 +29,12 @@ const (
 	flagDepositor    = "depositor"
 	flagStatus       = "status"
 	flagNumLimit     = "limit"
+	flagPage         = "page"
 	flagProposal     = "proposal"
+
+	flagRecipient     = "recipient"
+	flagAmount        = "amount"
+	flagJustification = "justification"
 )
 
 type proposal struct {
@@ -123,6 +129,63 @@ $ gaiacli gov submit-proposal --title="Test Proposal" --description="My awesome
 	return cmd
 }
 
+// GetCmdSubmitCommunitySpendProposal implements submitting a community spend
+// proposal transaction command.
+func GetCmdSubmitCommunitySpendProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit-community-spend-proposal",
+		Short: "Submit a proposal to pay coins from the community pool",
+		Long: strings.TrimSpace(`
+Submit a proposal to pay coins from the distribution community pool to a recipient, along with an initial deposit. The coins are paid when the proposal passes; if the community pool holds less than the requested amount at that time, the proposal is marked as failed. For example:
+
+$ phenixcli tx gov submit-community-spend-proposal --title="Video series" --description="Grant for a tutorial series" --recipient=<address> --amount="5000pnx" --justification="Twelve tutorials covering the wallet and staking" --deposit="10pnx" --from mykey
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := authtxb.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithAccountDecoder(cdc)
+
+			from := cliCtx.GetFromAddress()
+
+			recipient, err := sdk.AccAddressFromBech32(viper.GetString(flagRecipient))
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoins(viper.GetString(flagAmount))
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoins(viper.GetString(flagDeposit))
+			if err != nil {
+				return err
+			}
+
+			msg := gov.NewMsgSubmitCommunitySpendProposal(
+				viper.GetString(flagTitle), viper.GetString(flagDescription),
+				recipient, amount, viper.GetString(flagJustification), from, deposit,
+			)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg}, false)
+		},
+	}
+
+	cmd.Flags().String(flagTitle, "", "title of proposal")
+	cmd.Flags().String(flagDescription, "", "description of proposal")
+	cmd.Flags().String(flagRecipient, "", "address receiving the coins")
+	cmd.Flags().String(flagAmount, "", "coins to pay from the community pool")
+	cmd.Flags().String(flagJustification, "", "why the community pool should fund the recipient")
+	cmd.Flags().String(flagDeposit, "", "deposit of proposal")
+
+	return cmd
+}
+
 // GetCmdDeposit implements depositing tokens for an active proposal.
 func GetCmdDeposit(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
@@ -232,4 +295,53 @@ $ gaiacli tx gov vote 1 yes --from mykey
 	}
 }
 
+// GetCmdWeightedVote implements creating a new weighted vote command.
+func GetCmdWeightedVote(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "weighted-vote [proposal-id] [weighted-options]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Vote for an active proposal, splitting the voting power across options yes/no/no_with_veto/abstain",
+		Long: strings.TrimSpace(`
+Submit a vote for an active proposal that splits the voting power of the voter across several options. The weights must be positive and sum up to 1. Voting again replaces the previous vote. You can find the proposal-id by running phenixcli query gov proposals:
+
+$ phenixcli tx gov weighted-vote 1 yes=0.6,no=0.3,abstain=0.1 --from mykey
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := authtxb.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithAccountDecoder(cdc)
+
+			// Get voting address
+			from := cliCtx.GetFromAddress()
+
+			// validate that the proposal id is a uint
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid int, please input a valid proposal-id", args[0])
+			}
+
+			// check to see if the proposal is in the store
+			_, err = govClientUtils.QueryProposalByID(proposalID, cliCtx, cdc, queryRoute)
+			if err != nil {
+				return fmt.Errorf("Failed to fetch proposal-id %d: %s", proposalID, err)
+			}
+
+			options, err := govClientUtils.ParseWeightedVoteOptions(args[1])
+			if err != nil {
+				return err
+			}
+
+			// Build vote message and run basic validation
+			msg := gov.NewMsgVoteWeighted(from, proposalID, options)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg}, false)
+		},
+	}
+}
+
 // DONTCOVER
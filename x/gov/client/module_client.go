@@ -52,7 +52,9 @@ func (mc ModuleClient) GetTxCmd() *cobra.Command {
 	govTxCmd.AddCommand(client.PostCommands(
 		govCli.GetCmdDeposit(mc.storeKey, mc.cdc),
 		govCli.GetCmdVote(mc.storeKey, mc.cdc),
+		govCli.GetCmdWeightedVote(mc.storeKey, mc.cdc),
 		govCli.GetCmdSubmitProposal(mc.cdc),
+		govCli.GetCmdSubmitCommunitySpendProposal(mc.cdc),
 	)...)
 
 	return govTxCmd
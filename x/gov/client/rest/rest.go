@@ -26,13 +26,17 @@ const (
 	RestVoter          = "voter"
 	RestProposalStatus = "status"
 	RestNumLimit       = "limit"
+	RestPage           = "page"
+	RestProposalType   = "proposal_type"
 )
 
 // RegisterRoutes - Central function to define routes that get registered by the main application
 func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Codec) {
 	r.HandleFunc("/gov/proposals", postProposalHandlerFn(cdc, cliCtx)).Methods("POST")
+	r.HandleFunc("/gov/proposals/community_spend", postCommunitySpendProposalHandlerFn(cdc, cliCtx)).Methods("POST")
 	r.HandleFunc(fmt.Sprintf("/gov/proposals/{%s}/deposits", RestProposalID), depositHandlerFn(cdc, cliCtx)).Methods("POST")
 	r.HandleFunc(fmt.Sprintf("/gov/proposals/{%s}/votes", RestProposalID), voteHandlerFn(cdc, cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/gov/proposals/{%s}/weighted_votes", RestProposalID), weightedVoteHandlerFn(cdc, cliCtx)).Methods("POST")
 
 	r.HandleFunc(
 		fmt.Sprintf("/gov/parameters/{%s}", RestParamsType),
@@ -62,6 +66,18 @@ type PostProposalReq struct {
 	InitialDeposit sdk.Coins      `json:"initial_deposit"` // Coins to add to the proposal's deposit
 }
 
+// PostCommunitySpendProposalReq defines the properties of a community spend proposal request's body.
+type PostCommunitySpendProposalReq struct {
+	BaseReq        rest.BaseReq   `json:"base_req"`
+	Title          string         `json:"title"`           // Title of the proposal
+	Description    string         `json:"description"`     // Description of the proposal
+	Recipient      sdk.AccAddress `json:"recipient"`       // Address receiving the funds
+	Amount         sdk.Coins      `json:"amount"`          // Coins paid from the community pool
+	Justification  string         `json:"justification"`   // Why the community pool should fund the recipient
+	Proposer       sdk.AccAddress `json:"proposer"`        // Address of the proposer
+	InitialDeposit sdk.Coins      `json:"initial_deposit"` // Coins to add to the proposal's deposit
+}
+
 // DepositReq defines the properties of a deposit request's body.
 type DepositReq struct {
 	BaseReq   rest.BaseReq   `json:"base_req"`
@@ -76,6 +92,13 @@ type VoteReq struct {
 	Option  string         `json:"option"` // option from OptionSet chosen by the voter
 }
 
+// WeightedVoteReq defines the properties of a weighted vote request's body.
+type WeightedVoteReq struct {
+	BaseReq rest.BaseReq   `json:"base_req"`
+	Voter   sdk.AccAddress `json:"voter"`   // address of the voter
+	Options string         `json:"options"` // weighted options chosen by the voter, e.g. "yes=0.6,no=0.4"
+}
+
 func postProposalHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		var req PostProposalReq
@@ -105,6 +128,31 @@ func postProposalHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.Han
 	}
 }
 
+func postCommunitySpendProposalHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req PostCommunitySpendProposalReq
+		if !rest.ReadRESTReq(w, r, cdc, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		// create the message
+		msg := gov.NewMsgSubmitCommunitySpendProposal(
+			req.Title, req.Description, req.Recipient, req.Amount, req.Justification, req.Proposer, req.InitialDeposit,
+		)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		clientrest.WriteGenerateStdTxResponse(w, cdc, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
 func depositHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		vars := mux.Vars(r)
@@ -185,6 +233,49 @@ func voteHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc
 	}
 }
 
+func weightedVoteHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		strProposalID := vars[RestProposalID]
+
+		if len(strProposalID) == 0 {
+			err := errors.New("proposalId required but not specified")
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		proposalID, ok := rest.ParseUint64OrReturnBadRequest(w, strProposalID)
+		if !ok {
+			return
+		}
+
+		var req WeightedVoteReq
+		if !rest.ReadRESTReq(w, r, cdc, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		options, err := govClientUtils.ParseWeightedVoteOptions(req.Options)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// create the message
+		msg := gov.NewMsgVoteWeighted(req.Voter, proposalID, options)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		clientrest.WriteGenerateStdTxResponse(w, cdc, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
 func queryParamsHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		vars := mux.Vars(r)
@@ -517,7 +608,9 @@ func queryProposalsWithParameterFn(cdc *codec.Codec, cliCtx context.CLIContext)
 		bechVoterAddr := r.URL.Query().Get(RestVoter)
 		bechDepositorAddr := r.URL.Query().Get(RestDepositor)
 		strProposalStatus := r.URL.Query().Get(RestProposalStatus)
+		strProposalType := r.URL.Query().Get(RestProposalType)
 		strNumLimit := r.URL.Query().Get(RestNumLimit)
+		strPage := r.URL.Query().Get(RestPage)
 
 		params := gov.QueryProposalsParams{}
 
@@ -547,6 +640,14 @@ func queryProposalsWithParameterFn(cdc *codec.Codec, cliCtx context.CLIContext)
 			}
 			params.ProposalStatus = proposalStatus
 		}
+		if len(strProposalType) != 0 {
+			proposalType, err := gov.ProposalTypeFromString(govClientUtils.NormalizeProposalType(strProposalType))
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+			params.ProposalType = proposalType
+		}
 		if len(strNumLimit) != 0 {
 			numLimit, ok := rest.ParseUint64OrReturnBadRequest(w, strNumLimit)
 			if !ok {
@@ -554,6 +655,13 @@ func queryProposalsWithParameterFn(cdc *codec.Codec, cliCtx context.CLIContext)
 			}
 			params.Limit = numLimit
 		}
+		if len(strPage) != 0 {
+			page, ok := rest.ParseUint64OrReturnBadRequest(w, strPage)
+			if !ok {
+				return
+			}
+			params.Page = page
+		}
 
 		bz, err := cdc.MarshalJSON(params)
 		if err != nil {
@@ -2,10 +2,12 @@ package utils
 
 import (
 	"fmt"
+	"sort"
 
 	"github.com/PhenixChain/PhenixChain/client/context"
 	"github.com/PhenixChain/PhenixChain/client/tx"
 	"github.com/PhenixChain/PhenixChain/codec"
+	sdk "github.com/PhenixChain/PhenixChain/types"
 	"github.com/PhenixChain/PhenixChain/x/gov"
 	"github.com/PhenixChain/PhenixChain/x/gov/tags"
 )
@@ -55,7 +57,7 @@ func QueryDepositsByTxQuery(
 
 	var deposits []gov.Deposit
 
-	for _, info := range infos {
+	for _, info := range infos.Txs {
 		for _, msg := range info.Tx.GetMsgs() {
 			if msg.Type() == gov.TypeMsgDeposit {
 				depMsg := msg.(gov.MsgDeposit)
@@ -87,30 +89,31 @@ func QueryVotesByTxQuery(
 ) ([]byte, error) {
 
 	tags := []string{
-		fmt.Sprintf("%s='%s'", tags.Action, gov.MsgVote{}.Type()),
 		fmt.Sprintf("%s='%s'", tags.ProposalID, []byte(fmt.Sprintf("%d", params.ProposalID))),
 	}
 
-	// NOTE: SearchTxs is used to facilitate the txs query which does not currently
-	// support configurable pagination.
-	infos, err := tx.SearchTxs(cliCtx, cdc, tags, defaultPage, defaultLimit)
+	infos, err := searchVoteTxs(cliCtx, cdc, tags)
 	if err != nil {
 		return nil, err
 	}
 
+	// a voter may change its vote during the voting period, only the last one counts
 	var votes []gov.Vote
+	voteIndex := make(map[string]int)
 
 	for _, info := range infos {
 		for _, msg := range info.Tx.GetMsgs() {
-			if msg.Type() == gov.TypeMsgVote {
-				voteMsg := msg.(gov.MsgVote)
+			vote, ok := voteFromMsg(msg, params.ProposalID)
+			if !ok {
+				continue
+			}
 
-				votes = append(votes, gov.Vote{
-					Voter:      voteMsg.Voter,
-					ProposalID: params.ProposalID,
-					Option:     voteMsg.Option,
-				})
+			if i, found := voteIndex[vote.Voter.String()]; found {
+				votes[i] = vote
+				continue
 			}
+			voteIndex[vote.Voter.String()] = len(votes)
+			votes = append(votes, vote)
 		}
 	}
 
@@ -127,42 +130,68 @@ func QueryVoteByTxQuery(
 ) ([]byte, error) {
 
 	tags := []string{
-		fmt.Sprintf("%s='%s'", tags.Action, gov.MsgVote{}.Type()),
 		fmt.Sprintf("%s='%s'", tags.ProposalID, []byte(fmt.Sprintf("%d", params.ProposalID))),
 		fmt.Sprintf("%s='%s'", tags.Voter, []byte(params.Voter.String())),
 	}
 
-	// NOTE: SearchTxs is used to facilitate the txs query which does not currently
-	// support configurable pagination.
-	infos, err := tx.SearchTxs(cliCtx, cdc, tags, defaultPage, defaultLimit)
+	infos, err := searchVoteTxs(cliCtx, cdc, tags)
 	if err != nil {
 		return nil, err
 	}
 
-	for _, info := range infos {
-		for _, msg := range info.Tx.GetMsgs() {
-			// there should only be a single vote under the given conditions
-			if msg.Type() == gov.TypeMsgVote {
-				voteMsg := msg.(gov.MsgVote)
-
-				vote := gov.Vote{
-					Voter:      voteMsg.Voter,
-					ProposalID: params.ProposalID,
-					Option:     voteMsg.Option,
-				}
-
-				if cliCtx.Indent {
-					return cdc.MarshalJSONIndent(vote, "", "  ")
-				}
+	// walk backwards, the last vote of the voter is the one that counts
+	for i := len(infos) - 1; i >= 0; i-- {
+		msgs := infos[i].Tx.GetMsgs()
+		for j := len(msgs) - 1; j >= 0; j-- {
+			vote, ok := voteFromMsg(msgs[j], params.ProposalID)
+			if !ok || !vote.Voter.Equals(params.Voter) {
+				continue
+			}
 
-				return cdc.MarshalJSON(vote)
+			if cliCtx.Indent {
+				return cdc.MarshalJSONIndent(vote, "", "  ")
 			}
+
+			return cdc.MarshalJSON(vote)
 		}
 	}
 
 	return nil, fmt.Errorf("address '%s' did not vote on proposalID %d", params.Voter, params.ProposalID)
 }
 
+// searchVoteTxs returns the plain and weighted vote txs matching the given
+// tags, ordered by height.
+//
+// NOTE: SearchTxs is used to facilitate the txs query which does not currently
+// support configurable pagination.
+func searchVoteTxs(cliCtx context.CLIContext, cdc *codec.Codec, searchTags []string) ([]sdk.TxResponse, error) {
+	var infos []sdk.TxResponse
+	for _, msgType := range []string{gov.TypeMsgVote, gov.TypeMsgVoteWeighted} {
+		actionTags := append([]string{fmt.Sprintf("%s='%s'", tags.Action, msgType)}, searchTags...)
+
+		res, err := tx.SearchTxs(cliCtx, cdc, actionTags, defaultPage, defaultLimit)
+		if err != nil {
+			return nil, err
+		}
+		infos = append(infos, res.Txs...)
+	}
+
+	sort.SliceStable(infos, func(i, j int) bool { return infos[i].Height < infos[j].Height })
+	return infos, nil
+}
+
+// voteFromMsg builds the vote cast by a plain or weighted vote message.
+func voteFromMsg(msg sdk.Msg, proposalID uint64) (gov.Vote, bool) {
+	switch msg := msg.(type) {
+	case gov.MsgVote:
+		return gov.NewVote(proposalID, msg.Voter, gov.NewNonSplitVoteOption(msg.Option)), true
+	case gov.MsgVoteWeighted:
+		return gov.NewVote(proposalID, msg.Voter, msg.Options), true
+	default:
+		return gov.Vote{}, false
+	}
+}
+
 // QueryDepositByTxQuery will query for a single deposit via a direct txs tags
 // query.
 func QueryDepositByTxQuery(
@@ -182,7 +211,7 @@ func QueryDepositByTxQuery(
 		return nil, err
 	}
 
-	for _, info := range infos {
+	for _, info := range infos.Txs {
 		for _, msg := range info.Tx.GetMsgs() {
 			// there should only be a single deposit under the given conditions
 			if msg.Type() == gov.TypeMsgDeposit {
@@ -224,7 +253,7 @@ func QueryProposerByTxQuery(
 		return Proposer{}, err
 	}
 
-	for _, info := range infos {
+	for _, info := range infos.Txs {
 		for _, msg := range info.Tx.GetMsgs() {
 			// there should only be a single proposal under the given conditions
 			if msg.Type() == gov.TypeMsgSubmitProposal {
@@ -1,5 +1,13 @@
 package utils
 
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/PhenixChain/PhenixChain/types"
+	"github.com/PhenixChain/PhenixChain/x/gov"
+)
+
 // NormalizeVoteOption - normalize user specified vote option
 func NormalizeVoteOption(option string) string {
 	switch option {
@@ -24,6 +32,8 @@ func NormalizeProposalType(proposalType string) string {
 		return "ParameterChange"
 	case "SoftwareUpgrade", "software_upgrade":
 		return "SoftwareUpgrade"
+	case "CommunitySpend", "community_spend":
+		return "CommunitySpend"
 	}
 	return ""
 }
@@ -39,6 +49,35 @@ func NormalizeProposalStatus(status string) string {
 		return "Passed"
 	case "Rejected", "rejected":
 		return "Rejected"
+	case "Failed", "failed":
+		return "Failed"
+	case "Dropped", "dropped":
+		return "Dropped"
 	}
 	return ""
 }
+
+// ParseWeightedVoteOptions - parse user specified weighted vote options of the
+// form "yes=0.6,no=0.3,abstain=0.1"
+func ParseWeightedVoteOptions(s string) (gov.WeightedVoteOptions, error) {
+	var options gov.WeightedVoteOptions
+	for _, pair := range strings.Split(s, ",") {
+		fields := strings.Split(strings.TrimSpace(pair), "=")
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("'%s' is not a valid weighted vote option, expected option=weight", pair)
+		}
+
+		option, err := gov.VoteOptionFromString(NormalizeVoteOption(strings.TrimSpace(fields[0])))
+		if err != nil {
+			return nil, err
+		}
+
+		weight, err := sdk.NewDecFromStr(strings.TrimSpace(fields[1]))
+		if err != nil {
+			return nil, fmt.Errorf("'%s' is not a valid vote weight: %s", fields[1], err)
+		}
+
+		options = append(options, gov.NewWeightedVoteOption(option, weight))
+	}
+	return options, nil
+}
@@ -3,19 +3,28 @@ package gov
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	sdk "github.com/PhenixChain/PhenixChain/types"
 )
 
 // Vote
 type Vote struct {
-	Voter      sdk.AccAddress `json:"voter"`       //  address of the voter
-	ProposalID uint64         `json:"proposal_id"` //  proposalID of the proposal
-	Option     VoteOption     `json:"option"`      //  option from OptionSet chosen by the voter
+	Voter      sdk.AccAddress      `json:"voter"`       //  address of the voter
+	ProposalID uint64              `json:"proposal_id"` //  proposalID of the proposal
+	Options    WeightedVoteOptions `json:"options"`     //  weighted options from OptionSet chosen by the voter
+}
+
+func NewVote(proposalID uint64, voter sdk.AccAddress, options WeightedVoteOptions) Vote {
+	return Vote{
+		Voter:      voter,
+		ProposalID: proposalID,
+		Options:    options,
+	}
 }
 
 func (v Vote) String() string {
-	return fmt.Sprintf("Voter %s voted with option %s on proposal %d", v.Voter, v.Option, v.ProposalID)
+	return fmt.Sprintf("Voter %s voted with options %s on proposal %d", v.Voter, v.Options, v.ProposalID)
 }
 
 // Votes is a collection of Vote
@@ -24,14 +33,14 @@ type Votes []Vote
 func (v Votes) String() string {
 	out := fmt.Sprintf("Votes for Proposal %d:", v[0].ProposalID)
 	for _, vot := range v {
-		out += fmt.Sprintf("\n  %s: %s", vot.Voter, vot.Option)
+		out += fmt.Sprintf("\n  %s: %s", vot.Voter, vot.Options)
 	}
 	return out
 }
 
 // Returns whether 2 votes are equal
 func (v Vote) Equals(comp Vote) bool {
-	return v.Voter.Equals(comp.Voter) && v.ProposalID == comp.ProposalID && v.Option == comp.Option
+	return v.Voter.Equals(comp.Voter) && v.ProposalID == comp.ProposalID && v.Options.Equals(comp.Options)
 }
 
 // Returns whether a vote is empty
@@ -39,6 +48,77 @@ func (v Vote) Empty() bool {
 	return v.Equals(Vote{})
 }
 
+// WeightedVoteOption is a vote option together with the fraction of the
+// voting power cast for it
+type WeightedVoteOption struct {
+	Option VoteOption `json:"option"` //  option from OptionSet
+	Weight sdk.Dec    `json:"weight"` //  fraction of the voting power given to the option
+}
+
+func NewWeightedVoteOption(option VoteOption, weight sdk.Dec) WeightedVoteOption {
+	return WeightedVoteOption{
+		Option: option,
+		Weight: weight,
+	}
+}
+
+func (wvo WeightedVoteOption) String() string {
+	return fmt.Sprintf("%s=%s", wvo.Option, wvo.Weight)
+}
+
+// WeightedVoteOptions is a split of the voting power across vote options
+type WeightedVoteOptions []WeightedVoteOption
+
+// NewNonSplitVoteOption returns the options of a vote casting the whole
+// voting power for a single option
+func NewNonSplitVoteOption(option VoteOption) WeightedVoteOptions {
+	return WeightedVoteOptions{NewWeightedVoteOption(option, sdk.OneDec())}
+}
+
+func (options WeightedVoteOptions) String() string {
+	out := make([]string, len(options))
+	for i, option := range options {
+		out[i] = option.String()
+	}
+	return strings.Join(out, ",")
+}
+
+// Returns whether 2 sets of weighted options are equal
+func (options WeightedVoteOptions) Equals(comp WeightedVoteOptions) bool {
+	if len(options) != len(comp) {
+		return false
+	}
+	for i := range options {
+		if options[i].Option != comp[i].Option || !options[i].Weight.Equal(comp[i].Weight) {
+			return false
+		}
+	}
+	return true
+}
+
+// Is defined set of weighted options: every option is valid and used at most
+// once, every weight is positive and the weights sum up to one
+func validWeightedVoteOptions(options WeightedVoteOptions) bool {
+	if len(options) == 0 {
+		return false
+	}
+
+	seen := make(map[VoteOption]bool)
+	totalWeight := sdk.ZeroDec()
+	for _, option := range options {
+		if !validVoteOption(option.Option) || seen[option.Option] {
+			return false
+		}
+		if option.Weight.IsNil() || !option.Weight.IsPositive() {
+			return false
+		}
+		seen[option.Option] = true
+		totalWeight = totalWeight.Add(option.Weight)
+	}
+
+	return totalWeight.Equal(sdk.OneDec())
+}
+
 // Deposit
 type Deposit struct {
 	Depositor  sdk.AccAddress `json:"depositor"`   //  Address of the depositor
@@ -23,14 +23,19 @@ func EndBlocker(ctx sdk.Context, keeper Keeper) sdk.Tags {
 			panic(fmt.Sprintf("proposal %d does not exist", proposalID))
 		}
 
-		keeper.DeleteProposal(ctx, proposalID)
-		keeper.DeleteDeposits(ctx, proposalID) // delete any associated deposits (burned)
+		// keep the dropped proposal as a history record
+		keeper.RemoveFromInactiveProposalQueue(ctx, inactiveProposal.DepositEndTime, proposalID)
+		keeper.DeleteDeposits(ctx, proposalID) // burn any associated deposits
+		inactiveProposal.Status = StatusDropped
+		inactiveProposal.DepositOutcome = DepositOutcomeBurned
+		keeper.SetProposal(ctx, inactiveProposal)
+		keeper.recordProposalStatus(ctx, inactiveProposal.Status)
 
 		resTags = resTags.AppendTag(tags.ProposalID, fmt.Sprintf("%d", proposalID))
 		resTags = resTags.AppendTag(tags.ProposalResult, tags.ActionProposalDropped)
 
 		logger.Info(
-			fmt.Sprintf("proposal %d (%s) didn't meet minimum deposit of %s (had only %s); deleted",
+			fmt.Sprintf("proposal %d (%s) didn't meet minimum deposit of %s (had only %s); dropped",
 				inactiveProposal.ProposalID,
 				inactiveProposal.GetTitle(),
 				keeper.GetDepositParams(ctx).MinDeposit,
@@ -56,15 +61,31 @@ func EndBlocker(ctx sdk.Context, keeper Keeper) sdk.Tags {
 		if passes {
 			keeper.RefundDeposits(ctx, activeProposal.ProposalID)
 			activeProposal.Status = StatusPassed
+			activeProposal.DepositOutcome = DepositOutcomeRefunded
 			tagValue = tags.ActionProposalPassed
+
+			if err := keeper.ExecuteProposal(ctx, activeProposal); err != nil {
+				activeProposal.Status = StatusFailed
+				activeProposal.ExecutionResult = err.ABCILog()
+				tagValue = tags.ActionProposalFailed
+
+				logger.Info(
+					fmt.Sprintf(
+						"proposal %d (%s) passed but failed to execute: %s",
+						activeProposal.ProposalID, activeProposal.GetTitle(), err.ABCILog(),
+					),
+				)
+			}
 		} else {
 			keeper.DeleteDeposits(ctx, activeProposal.ProposalID)
 			activeProposal.Status = StatusRejected
+			activeProposal.DepositOutcome = DepositOutcomeBurned
 			tagValue = tags.ActionProposalRejected
 		}
 
 		activeProposal.FinalTallyResult = tallyResults
 		keeper.SetProposal(ctx, activeProposal)
+		keeper.recordProposalStatus(ctx, activeProposal.Status)
 		keeper.RemoveFromActiveProposalQueue(ctx, activeProposal.VotingEndTime, activeProposal.ProposalID)
 
 		logger.Info(
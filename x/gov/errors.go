@@ -21,6 +21,8 @@ const (
 	CodeInvalidVote             sdk.CodeType = 9
 	CodeInvalidGenesis          sdk.CodeType = 10
 	CodeInvalidProposalStatus   sdk.CodeType = 11
+	CodeInvalidJustification    sdk.CodeType = 12
+	CodeInvalidWeightedVote     sdk.CodeType = 13
 )
 
 // Error constructors
@@ -53,6 +55,10 @@ func ErrInvalidDescription(codespace sdk.CodespaceType, errorMsg string) sdk.Err
 	return sdk.NewError(codespace, CodeInvalidDescription, errorMsg)
 }
 
+func ErrInvalidJustification(codespace sdk.CodespaceType, errorMsg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidJustification, errorMsg)
+}
+
 func ErrInvalidProposalType(codespace sdk.CodespaceType, proposalType ProposalKind) sdk.Error {
 	return sdk.NewError(codespace, CodeInvalidProposalType, fmt.Sprintf("Proposal Type '%s' is not valid", proposalType))
 }
@@ -61,6 +67,11 @@ func ErrInvalidVote(codespace sdk.CodespaceType, voteOption VoteOption) sdk.Erro
 	return sdk.NewError(codespace, CodeInvalidVote, fmt.Sprintf("'%v' is not a valid voting option", voteOption))
 }
 
+func ErrInvalidWeightedVote(codespace sdk.CodespaceType, options WeightedVoteOptions) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidWeightedVote,
+		fmt.Sprintf("'%s' is not a valid weighted vote, options must be distinct with positive weights summing up to 1", options))
+}
+
 func ErrInvalidGenesis(codespace sdk.CodespaceType, msg string) sdk.Error {
 	return sdk.NewError(codespace, CodeInvalidVote, msg)
 }
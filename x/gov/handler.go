@@ -15,8 +15,12 @@ func NewHandler(keeper Keeper) sdk.Handler {
 			return handleMsgDeposit(ctx, keeper, msg)
 		case MsgSubmitProposal:
 			return handleMsgSubmitProposal(ctx, keeper, msg)
+		case MsgSubmitCommunitySpendProposal:
+			return handleMsgSubmitCommunitySpendProposal(ctx, keeper, msg)
 		case MsgVote:
 			return handleMsgVote(ctx, keeper, msg)
+		case MsgVoteWeighted:
+			return handleMsgVoteWeighted(ctx, keeper, msg)
 		default:
 			errMsg := fmt.Sprintf("Unrecognized gov msg type: %T", msg)
 			return sdk.ErrUnknownRequest(errMsg).Result()
@@ -34,6 +38,17 @@ func handleMsgSubmitProposal(ctx sdk.Context, keeper Keeper, msg MsgSubmitPropos
 	default:
 		return ErrInvalidProposalType(keeper.codespace, msg.ProposalType).Result()
 	}
+	return submitProposal(ctx, keeper, content, msg.Proposer, msg.InitialDeposit)
+}
+
+func handleMsgSubmitCommunitySpendProposal(ctx sdk.Context, keeper Keeper, msg MsgSubmitCommunitySpendProposal) sdk.Result {
+	content := NewCommunitySpendProposal(msg.Title, msg.Description, msg.Recipient, msg.Amount, msg.Justification)
+	return submitProposal(ctx, keeper, content, msg.Proposer, msg.InitialDeposit)
+}
+
+func submitProposal(ctx sdk.Context, keeper Keeper, content ProposalContent,
+	proposer sdk.AccAddress, initialDeposit sdk.Coins) sdk.Result {
+
 	proposal, err := keeper.SubmitProposal(ctx, content)
 	if err != nil {
 		return err.Result()
@@ -41,13 +56,13 @@ func handleMsgSubmitProposal(ctx sdk.Context, keeper Keeper, msg MsgSubmitPropos
 	proposalID := proposal.ProposalID
 	proposalIDStr := fmt.Sprintf("%d", proposalID)
 
-	err, votingStarted := keeper.AddDeposit(ctx, proposalID, msg.Proposer, msg.InitialDeposit)
+	err, votingStarted := keeper.AddDeposit(ctx, proposalID, proposer, initialDeposit)
 	if err != nil {
 		return err.Result()
 	}
 
 	resTags := sdk.NewTags(
-		tags.Proposer, []byte(msg.Proposer.String()),
+		tags.Proposer, []byte(proposer.String()),
 		tags.ProposalID, proposalIDStr,
 	)
 
@@ -83,7 +98,21 @@ func handleMsgDeposit(ctx sdk.Context, keeper Keeper, msg MsgDeposit) sdk.Result
 }
 
 func handleMsgVote(ctx sdk.Context, keeper Keeper, msg MsgVote) sdk.Result {
-	err := keeper.AddVote(ctx, msg.ProposalID, msg.Voter, msg.Option)
+	err := keeper.AddVote(ctx, msg.ProposalID, msg.Voter, NewNonSplitVoteOption(msg.Option))
+	if err != nil {
+		return err.Result()
+	}
+
+	return sdk.Result{
+		Tags: sdk.NewTags(
+			tags.Voter, msg.Voter.String(),
+			tags.ProposalID, fmt.Sprintf("%d", msg.ProposalID),
+		),
+	}
+}
+
+func handleMsgVoteWeighted(ctx sdk.Context, keeper Keeper, msg MsgVoteWeighted) sdk.Result {
+	err := keeper.AddVote(ctx, msg.ProposalID, msg.Voter, msg.Options)
 	if err != nil {
 		return err.Result()
 	}
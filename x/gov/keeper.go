@@ -4,6 +4,7 @@ import (
 	"time"
 
 	codec "github.com/PhenixChain/PhenixChain/codec"
+	"github.com/PhenixChain/PhenixChain/telemetry"
 	sdk "github.com/PhenixChain/PhenixChain/types"
 	"github.com/PhenixChain/PhenixChain/x/params"
 
@@ -34,8 +35,7 @@ var (
 	ParamStoreKeyTallyParams   = []byte("tallyparams")
 
 	// TODO: Find another way to implement this without using accounts, or find a cleaner way to implement it using accounts.
-	DepositedCoinsAccAddr     = sdk.AccAddress(crypto.AddressHash([]byte("govDepositedCoins")))
-	BurnedDepositCoinsAccAddr = sdk.AccAddress(crypto.AddressHash([]byte("govBurnedDepositCoins")))
+	DepositedCoinsAccAddr = sdk.AccAddress(crypto.AddressHash([]byte("govDepositedCoins")))
 )
 
 // Key declaration for parameters
@@ -64,6 +64,9 @@ type Keeper struct {
 	// The reference to the DelegationSet to get information about delegators
 	ds sdk.DelegationSet
 
+	// The reference to the DistributionKeeper to pay community spend proposals
+	dk DistributionKeeper
+
 	// The (unexposed) keys used to access the stores from the Context.
 	storeKey sdk.StoreKey
 
@@ -72,15 +75,19 @@ type Keeper struct {
 
 	// Reserved codespace
 	codespace sdk.CodespaceType
+
+	// The metrics proposal status changes are reported to
+	metrics *telemetry.Metrics
 }
 
 // NewKeeper returns a governance keeper. It handles:
 // - submitting governance proposals
 // - depositing funds into proposals, and activating upon sufficient funds being deposited
 // - users voting on proposals, with weight proportional to stake in the system
-// - and tallying the result of the vote.
-func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, paramsKeeper params.Keeper,
-	paramSpace params.Subspace, ck BankKeeper, ds sdk.DelegationSet, codespace sdk.CodespaceType) Keeper {
+// - tallying the result of the vote
+// - and executing passed proposals.
+func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, paramsKeeper params.Keeper, paramSpace params.Subspace,
+	ck BankKeeper, ds sdk.DelegationSet, dk DistributionKeeper, codespace sdk.CodespaceType) Keeper {
 
 	return Keeper{
 		storeKey:     key,
@@ -88,12 +95,29 @@ func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, paramsKeeper params.Keeper,
 		paramSpace:   paramSpace.WithKeyTable(ParamKeyTable()),
 		ck:           ck,
 		ds:           ds,
+		dk:           dk,
 		vs:           ds.GetValidatorSet(),
 		cdc:          cdc,
 		codespace:    codespace,
+		metrics:      telemetry.NopMetrics(),
 	}
 }
 
+// WithMetrics returns a copy of the keeper reporting the proposals entering
+// each status to the given metrics
+func (keeper Keeper) WithMetrics(metrics *telemetry.Metrics) Keeper {
+	keeper.metrics = metrics
+	return keeper
+}
+
+// recordProposalStatus reports a proposal entering a status to the metrics.
+// Changes made by a transaction are only counted once it succeeded.
+func (keeper Keeper) recordProposalStatus(ctx sdk.Context, status ProposalStatus) {
+	telemetry.RecordOnSuccess(ctx, func() {
+		keeper.metrics.GovProposals.With("status", status.String()).Add(1)
+	})
+}
+
 // Proposals
 func (keeper Keeper) SubmitProposal(ctx sdk.Context, content ProposalContent) (proposal Proposal, err sdk.Error) {
 	proposalID, err := keeper.getNewProposalID(ctx)
@@ -117,9 +141,27 @@ func (keeper Keeper) SubmitProposal(ctx sdk.Context, content ProposalContent) (p
 
 	keeper.SetProposal(ctx, proposal)
 	keeper.InsertInactiveProposalQueue(ctx, proposal.DepositEndTime, proposalID)
+	keeper.recordProposalStatus(ctx, proposal.Status)
 	return
 }
 
+// ExecuteProposal applies the effects of a passed proposal. Execution runs
+// in a cached context, so a failed proposal leaves no partial state behind.
+func (keeper Keeper) ExecuteProposal(ctx sdk.Context, proposal Proposal) sdk.Error {
+	cacheCtx, writeCache := ctx.CacheContext()
+
+	switch content := proposal.ProposalContent.(type) {
+	case CommunitySpendProposal:
+		err := keeper.dk.DistributeFeePool(cacheCtx, content.Amount, content.Recipient)
+		if err != nil {
+			return err
+		}
+	}
+
+	writeCache()
+	return nil
+}
+
 // Get Proposal from store by ProposalID
 func (keeper Keeper) GetProposal(ctx sdk.Context, proposalID uint64) (proposal Proposal, ok bool) {
 	store := ctx.KVStore(keeper.storeKey)
@@ -150,13 +192,14 @@ func (keeper Keeper) DeleteProposal(ctx sdk.Context, proposalID uint64) {
 	store.Delete(KeyProposal(proposalID))
 }
 
-// Get Proposal from store by ProposalID
-// voterAddr will filter proposals by whether or not that address has voted on them
-// depositorAddr will filter proposals by whether or not that address has deposited to them
-// status will filter proposals by status
-// numLatest will fetch a specified number of the most recent proposals, or 0 for all proposals
-func (keeper Keeper) GetProposalsFiltered(ctx sdk.Context, voterAddr sdk.AccAddress, depositorAddr sdk.AccAddress, status ProposalStatus, numLatest uint64) []Proposal {
-
+// GetProposalsFiltered returns the proposals, including the archived ones,
+// matching the given filters in ascending ID order
+// - voter will filter proposals by whether or not that address has voted on them
+// - depositor will filter proposals by whether or not that address has deposited to them
+// - status will filter proposals by status, and proposal type by content type
+// - page and limit paginate the matching proposals starting from the most
+// recent ones, page 1 holding the latest limit proposals; a zero limit returns all of them
+func (keeper Keeper) GetProposalsFiltered(ctx sdk.Context, params QueryProposalsParams) []Proposal {
 	maxProposalID, err := keeper.peekCurrentProposalID(ctx)
 	if err != nil {
 		return nil
@@ -164,20 +207,16 @@ func (keeper Keeper) GetProposalsFiltered(ctx sdk.Context, voterAddr sdk.AccAddr
 
 	matchingProposals := []Proposal{}
 
-	if numLatest == 0 {
-		numLatest = maxProposalID
-	}
-
-	for proposalID := maxProposalID - numLatest; proposalID < maxProposalID; proposalID++ {
-		if voterAddr != nil && len(voterAddr) != 0 {
-			_, found := keeper.GetVote(ctx, proposalID, voterAddr)
+	for proposalID := uint64(0); proposalID < maxProposalID; proposalID++ {
+		if len(params.Voter) != 0 {
+			_, found := keeper.GetVote(ctx, proposalID, params.Voter)
 			if !found {
 				continue
 			}
 		}
 
-		if depositorAddr != nil && len(depositorAddr) != 0 {
-			_, found := keeper.GetDeposit(ctx, proposalID, depositorAddr)
+		if len(params.Depositor) != 0 {
+			_, found := keeper.GetDeposit(ctx, proposalID, params.Depositor)
 			if !found {
 				continue
 			}
@@ -188,15 +227,37 @@ func (keeper Keeper) GetProposalsFiltered(ctx sdk.Context, voterAddr sdk.AccAddr
 			continue
 		}
 
-		if validProposalStatus(status) {
-			if proposal.Status != status {
-				continue
-			}
+		if validProposalStatus(params.ProposalStatus) && proposal.Status != params.ProposalStatus {
+			continue
+		}
+
+		if params.ProposalType != ProposalTypeNil && proposal.ProposalType() != params.ProposalType {
+			continue
 		}
 
 		matchingProposals = append(matchingProposals, proposal)
 	}
-	return matchingProposals
+
+	if params.Limit == 0 {
+		return matchingProposals
+	}
+
+	page := params.Page
+	if page == 0 {
+		page = 1
+	}
+
+	skipped := (page - 1) * params.Limit
+	if skipped >= uint64(len(matchingProposals)) {
+		return []Proposal{}
+	}
+
+	end := uint64(len(matchingProposals)) - skipped
+	start := uint64(0)
+	if end > params.Limit {
+		start = end - params.Limit
+	}
+	return matchingProposals[start:end]
 }
 
 // Set the initial proposal ID
@@ -251,6 +312,7 @@ func (keeper Keeper) activateVotingPeriod(ctx sdk.Context, proposal Proposal) {
 	proposal.VotingEndTime = proposal.VotingStartTime.Add(votingPeriod)
 	proposal.Status = StatusVotingPeriod
 	keeper.SetProposal(ctx, proposal)
+	keeper.recordProposalStatus(ctx, proposal.Status)
 
 	keeper.RemoveFromInactiveProposalQueue(ctx, proposal.DepositEndTime, proposal.ProposalID)
 	keeper.InsertActiveProposalQueue(ctx, proposal.VotingEndTime, proposal.ProposalID)
@@ -293,8 +355,8 @@ func (keeper Keeper) setTallyParams(ctx sdk.Context, tallyParams TallyParams) {
 
 // Votes
 
-// Adds a vote on a specific proposal
-func (keeper Keeper) AddVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress, option VoteOption) sdk.Error {
+// Adds or replaces the vote of a voter on a specific proposal
+func (keeper Keeper) AddVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress, options WeightedVoteOptions) sdk.Error {
 	proposal, ok := keeper.GetProposal(ctx, proposalID)
 	if !ok {
 		return ErrUnknownProposal(keeper.codespace, proposalID)
@@ -303,15 +365,11 @@ func (keeper Keeper) AddVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.A
 		return ErrInactiveProposal(keeper.codespace, proposalID)
 	}
 
-	if !validVoteOption(option) {
-		return ErrInvalidVote(keeper.codespace, option)
+	if !validWeightedVoteOptions(options) {
+		return ErrInvalidWeightedVote(keeper.codespace, options)
 	}
 
-	vote := Vote{
-		ProposalID: proposalID,
-		Voter:      voterAddr,
-		Option:     option,
-	}
+	vote := NewVote(proposalID, voterAddr, options)
 	keeper.setVote(ctx, proposalID, voterAddr, vote)
 
 	return nil
@@ -341,11 +399,6 @@ func (keeper Keeper) GetVotes(ctx sdk.Context, proposalID uint64) sdk.Iterator {
 	return sdk.KVStorePrefixIterator(store, KeyVotesSubspace(proposalID))
 }
 
-func (keeper Keeper) deleteVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress) {
-	store := ctx.KVStore(keeper.storeKey)
-	store.Delete(KeyVote(proposalID, voterAddr))
-}
-
 // Deposits
 
 // Gets the deposit of a specific depositor on a specific proposal
@@ -417,9 +470,9 @@ func (keeper Keeper) GetDeposits(ctx sdk.Context, proposalID uint64) sdk.Iterato
 	return sdk.KVStorePrefixIterator(store, KeyDepositsSubspace(proposalID))
 }
 
-// Refunds and deletes all the deposits on a specific proposal
+// Refunds all the deposits on a specific proposal. The deposit records are
+// kept as part of the proposal history.
 func (keeper Keeper) RefundDeposits(ctx sdk.Context, proposalID uint64) {
-	store := ctx.KVStore(keeper.storeKey)
 	depositsIterator := keeper.GetDeposits(ctx, proposalID)
 	defer depositsIterator.Close()
 	for ; depositsIterator.Valid(); depositsIterator.Next() {
@@ -430,14 +483,12 @@ func (keeper Keeper) RefundDeposits(ctx sdk.Context, proposalID uint64) {
 		if err != nil {
 			panic("should not happen")
 		}
-
-		store.Delete(depositsIterator.Key())
 	}
 }
 
-// Deletes all the deposits on a specific proposal without refunding them
+// Burns all the deposits on a specific proposal without refunding them. The
+// deposit records are kept as part of the proposal history.
 func (keeper Keeper) DeleteDeposits(ctx sdk.Context, proposalID uint64) {
-	store := ctx.KVStore(keeper.storeKey)
 	depositsIterator := keeper.GetDeposits(ctx, proposalID)
 	defer depositsIterator.Close()
 	for ; depositsIterator.Valid(); depositsIterator.Next() {
@@ -445,12 +496,10 @@ func (keeper Keeper) DeleteDeposits(ctx sdk.Context, proposalID uint64) {
 		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(depositsIterator.Value(), deposit)
 
 		// TODO: Find a way to do this without using accounts.
-		_, err := keeper.ck.SendCoins(ctx, DepositedCoinsAccAddr, BurnedDepositCoinsAccAddr, deposit.Amount)
+		_, err := keeper.ck.BurnCoins(ctx, DepositedCoinsAccAddr, deposit.Amount)
 		if err != nil {
 			panic("should not happen")
 		}
-
-		store.Delete(depositsIterator.Key())
 	}
 }
 
@@ -0,0 +1,128 @@
+package gov
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	dbm "github.com/tendermint/tendermint/libs/db"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/PhenixChain/PhenixChain/codec"
+	"github.com/PhenixChain/PhenixChain/store"
+	sdk "github.com/PhenixChain/PhenixChain/types"
+	"github.com/PhenixChain/PhenixChain/x/auth"
+	"github.com/PhenixChain/PhenixChain/x/bank"
+	distr "github.com/PhenixChain/PhenixChain/x/distribution"
+	"github.com/PhenixChain/PhenixChain/x/params"
+)
+
+func TestDroppedProposalsAreArchived(t *testing.T) {
+	ctx, keeper := setupTestInput(t, &mockStaking{})
+	ctx = ctx.WithBlockHeader(abci.Header{Time: time.Unix(0, 0).UTC()})
+
+	for i := 0; i < 5; i++ {
+		_, err := keeper.SubmitProposal(ctx, NewTextProposal("Test", "Archive"))
+		require.NoError(t, err)
+	}
+	_, err := keeper.SubmitProposal(ctx, NewCommunitySpendProposal("Grant", "Archive", nil, nil, "Tutorials"))
+	require.NoError(t, err)
+
+	// nobody deposits, so all proposals are dropped once the deposit period ends
+	depositPeriod := keeper.GetDepositParams(ctx).MaxDepositPeriod
+	ctx = ctx.WithBlockHeader(abci.Header{Time: ctx.BlockHeader().Time.Add(depositPeriod)})
+	EndBlocker(ctx, keeper)
+
+	proposal, found := keeper.GetProposal(ctx, 1)
+	require.True(t, found)
+	require.Equal(t, StatusDropped, proposal.Status)
+	require.Equal(t, DepositOutcomeBurned, proposal.DepositOutcome)
+	require.True(t, proposal.IsArchived())
+
+	filtered := func(status ProposalStatus, proposalType ProposalKind, page, limit uint64) []uint64 {
+		var ids []uint64
+		params := NewQueryProposalsParams(status, proposalType, page, limit, nil, nil)
+		for _, p := range keeper.GetProposalsFiltered(ctx, params) {
+			ids = append(ids, p.ProposalID)
+		}
+		return ids
+	}
+
+	require.Equal(t, []uint64{1, 2, 3, 4, 5, 6}, filtered(StatusDropped, ProposalTypeNil, 0, 0))
+	require.Empty(t, filtered(StatusPassed, ProposalTypeNil, 0, 0))
+	require.Equal(t, []uint64{6}, filtered(StatusNil, ProposalTypeCommunitySpend, 0, 0))
+
+	// pages count back from the latest proposals
+	require.Equal(t, []uint64{5, 6}, filtered(StatusNil, ProposalTypeNil, 1, 2))
+	require.Equal(t, []uint64{3, 4}, filtered(StatusNil, ProposalTypeNil, 2, 2))
+	require.Equal(t, []uint64{1, 2}, filtered(StatusNil, ProposalTypeNil, 3, 2))
+	require.Empty(t, filtered(StatusNil, ProposalTypeNil, 4, 2))
+	require.Equal(t, []uint64{1, 2, 3, 4, 5}, filtered(StatusNil, ProposalTypeText, 0, 0))
+
+	// archived proposals no longer accept deposits
+	err, _ = keeper.AddDeposit(ctx, 1, sdk.AccAddress([]byte("depositor")), sdk.NewCoins(sdk.NewInt64Coin("pnx", 10)))
+	require.Error(t, err)
+
+	// archived proposals survive an export
+	require.Len(t, ExportGenesis(ctx, keeper).Proposals, 6)
+}
+
+func setupSpendTestInput(t *testing.T) (sdk.Context, Keeper, bank.Keeper, distr.Keeper) {
+	db := dbm.NewMemDB()
+	cdc := codec.New()
+	RegisterCodec(cdc)
+	auth.RegisterBaseAccount(cdc)
+
+	keyGov := sdk.NewKVStoreKey(StoreKey)
+	keyAcc := sdk.NewKVStoreKey(auth.StoreKey)
+	keyAddress := sdk.NewKVStoreKey(auth.StoreAdrKey)
+	keyBank := sdk.NewKVStoreKey(bank.StoreKey)
+	keyDistr := sdk.NewKVStoreKey(distr.StoreKey)
+	keyParams := sdk.NewKVStoreKey(params.StoreKey)
+	tkeyParams := sdk.NewTransientStoreKey(params.TStoreKey)
+
+	ms := store.NewCommitMultiStore(db)
+	for _, key := range []sdk.StoreKey{keyGov, keyAcc, keyAddress, keyBank, keyDistr, keyParams} {
+		ms.MountStoreWithDB(key, sdk.StoreTypeIAVL, db)
+	}
+	ms.MountStoreWithDB(tkeyParams, sdk.StoreTypeTransient, db)
+	require.NoError(t, ms.LoadLatestVersion())
+	ctx := sdk.NewContext(ms, abci.Header{}, false, log.NewNopLogger())
+
+	pk := params.NewKeeper(cdc, keyParams, tkeyParams)
+	ak := auth.NewAccountKeeper(cdc, keyAcc, pk.Subspace(auth.DefaultParamspace), auth.ProtoBaseAccount)
+	bk := bank.NewBaseKeeper(ak, bank.NewTxKeeper(cdc, keyAddress), keyBank,
+		pk.Subspace(bank.DefaultParamspace), bank.DefaultCodespace)
+	dk := distr.NewKeeper(cdc, keyDistr, pk.Subspace(distr.DefaultParamspace), bk, nil, nil, distr.DefaultCodespace)
+	keeper := NewKeeper(cdc, keyGov, pk, pk.Subspace(DefaultParamspace), bk, &mockStaking{}, dk, DefaultCodespace)
+	InitGenesis(ctx, keeper, DefaultGenesisState())
+	return ctx, keeper, bk, dk
+}
+
+func TestExecuteCommunitySpendProposal(t *testing.T) {
+	ctx, keeper, bk, dk := setupSpendTestInput(t)
+	feePool := distr.InitialFeePool()
+	feePool.CommunityPool = sdk.NewDecCoins(sdk.NewCoins(sdk.NewInt64Coin("stake", 100)))
+	dk.SetFeePool(ctx, feePool)
+	recipient := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	spend := func(amount int64) sdk.Error {
+		content := NewCommunitySpendProposal("Grant", "Tutorials", recipient,
+			sdk.NewCoins(sdk.NewInt64Coin("stake", amount)), "Onboard new users")
+		proposal, err := keeper.SubmitProposal(ctx, content)
+		require.NoError(t, err)
+		return keeper.ExecuteProposal(ctx, proposal)
+	}
+
+	// a passed proposal pays the recipient out of the community pool
+	require.NoError(t, spend(60))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 60)), bk.GetCoins(ctx, recipient))
+	require.Equal(t, sdk.NewDecCoins(sdk.NewCoins(sdk.NewInt64Coin("stake", 40))), dk.GetFeePool(ctx).CommunityPool)
+
+	// the pool cannot pay more than it holds, and nothing is paid then
+	require.Error(t, spend(41))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 60)), bk.GetCoins(ctx, recipient))
+	require.Equal(t, sdk.NewDecCoins(sdk.NewCoins(sdk.NewInt64Coin("stake", 40))), dk.GetFeePool(ctx).CommunityPool)
+}
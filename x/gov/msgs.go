@@ -10,13 +10,17 @@ import (
 const (
 	TypeMsgDeposit        = "deposit"
 	TypeMsgVote           = "vote"
+	TypeMsgVoteWeighted   = "weighted_vote"
 	TypeMsgSubmitProposal = "submit_proposal"
 
-	MaxDescriptionLength int = 5000
-	MaxTitleLength       int = 140
+	TypeMsgSubmitCommunitySpendProposal = "submit_community_spend_proposal"
+
+	MaxDescriptionLength   int = 5000
+	MaxTitleLength         int = 140
+	MaxJustificationLength int = 5000
 )
 
-var _, _, _ sdk.Msg = MsgSubmitProposal{}, MsgDeposit{}, MsgVote{}
+var _, _, _, _, _ sdk.Msg = MsgSubmitProposal{}, MsgSubmitCommunitySpendProposal{}, MsgDeposit{}, MsgVote{}, MsgVoteWeighted{}
 
 // MsgSubmitProposal
 type MsgSubmitProposal struct {
@@ -43,31 +47,13 @@ func (msg MsgSubmitProposal) Type() string  { return TypeMsgSubmitProposal }
 
 // Implements Msg.
 func (msg MsgSubmitProposal) ValidateBasic() sdk.Error {
-	if len(msg.Title) == 0 {
-		return ErrInvalidTitle(DefaultCodespace, "No title present in proposal")
-	}
-	if len(msg.Title) > MaxTitleLength {
-		return ErrInvalidTitle(DefaultCodespace, fmt.Sprintf("Proposal title is longer than max length of %d", MaxTitleLength))
-	}
-	if len(msg.Description) == 0 {
-		return ErrInvalidDescription(DefaultCodespace, "No description present in proposal")
-	}
-	if len(msg.Description) > MaxDescriptionLength {
-		return ErrInvalidDescription(DefaultCodespace, fmt.Sprintf("Proposal description is longer than max length of %d", MaxDescriptionLength))
+	if err := validateTitleAndDescription(msg.Title, msg.Description); err != nil {
+		return err
 	}
 	if !validProposalType(msg.ProposalType) {
 		return ErrInvalidProposalType(DefaultCodespace, msg.ProposalType)
 	}
-	if msg.Proposer.Empty() {
-		return sdk.ErrInvalidAddress(msg.Proposer.String())
-	}
-	if !msg.InitialDeposit.IsValid() {
-		return sdk.ErrInvalidCoins(msg.InitialDeposit.String())
-	}
-	if msg.InitialDeposit.IsAnyNegative() {
-		return sdk.ErrInvalidCoins(msg.InitialDeposit.String())
-	}
-	return nil
+	return validateProposerAndDeposit(msg.Proposer, msg.InitialDeposit)
 }
 
 func (msg MsgSubmitProposal) String() string {
@@ -85,6 +71,101 @@ func (msg MsgSubmitProposal) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.Proposer}
 }
 
+// MsgSubmitCommunitySpendProposal submits a proposal to pay coins from the
+// distribution community pool to a recipient once it passes
+type MsgSubmitCommunitySpendProposal struct {
+	Title          string         `json:"title"`           //  Title of the proposal
+	Description    string         `json:"description"`     //  Description of the proposal
+	Recipient      sdk.AccAddress `json:"recipient"`       //  Address receiving the funds
+	Amount         sdk.Coins      `json:"amount"`          //  Coins paid from the community pool
+	Justification  string         `json:"justification"`   //  Why the community pool should fund the recipient
+	Proposer       sdk.AccAddress `json:"proposer"`        //  Address of the proposer
+	InitialDeposit sdk.Coins      `json:"initial_deposit"` //  Initial deposit paid by sender. Must be strictly positive.
+}
+
+func NewMsgSubmitCommunitySpendProposal(title, description string, recipient sdk.AccAddress, amount sdk.Coins,
+	justification string, proposer sdk.AccAddress, initialDeposit sdk.Coins) MsgSubmitCommunitySpendProposal {
+
+	return MsgSubmitCommunitySpendProposal{
+		Title:          title,
+		Description:    description,
+		Recipient:      recipient,
+		Amount:         amount,
+		Justification:  justification,
+		Proposer:       proposer,
+		InitialDeposit: initialDeposit,
+	}
+}
+
+//nolint
+func (msg MsgSubmitCommunitySpendProposal) Route() string { return RouterKey }
+func (msg MsgSubmitCommunitySpendProposal) Type() string  { return TypeMsgSubmitCommunitySpendProposal }
+
+// Implements Msg.
+func (msg MsgSubmitCommunitySpendProposal) ValidateBasic() sdk.Error {
+	if err := validateTitleAndDescription(msg.Title, msg.Description); err != nil {
+		return err
+	}
+	if msg.Recipient.Empty() {
+		return sdk.ErrInvalidAddress(msg.Recipient.String())
+	}
+	if !msg.Amount.IsValid() || msg.Amount.Empty() {
+		return sdk.ErrInvalidCoins(msg.Amount.String())
+	}
+	if len(msg.Justification) == 0 {
+		return ErrInvalidJustification(DefaultCodespace, "No justification present in proposal")
+	}
+	if len(msg.Justification) > MaxJustificationLength {
+		return ErrInvalidJustification(DefaultCodespace, fmt.Sprintf("Proposal justification is longer than max length of %d", MaxJustificationLength))
+	}
+	return validateProposerAndDeposit(msg.Proposer, msg.InitialDeposit)
+}
+
+func (msg MsgSubmitCommunitySpendProposal) String() string {
+	return fmt.Sprintf("MsgSubmitCommunitySpendProposal{%s, %s, %s, %v, %v}",
+		msg.Title, msg.Description, msg.Recipient, msg.Amount, msg.InitialDeposit)
+}
+
+// Implements Msg.
+func (msg MsgSubmitCommunitySpendProposal) GetSignBytes() []byte {
+	bz := msgCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// Implements Msg.
+func (msg MsgSubmitCommunitySpendProposal) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Proposer}
+}
+
+func validateTitleAndDescription(title, description string) sdk.Error {
+	if len(title) == 0 {
+		return ErrInvalidTitle(DefaultCodespace, "No title present in proposal")
+	}
+	if len(title) > MaxTitleLength {
+		return ErrInvalidTitle(DefaultCodespace, fmt.Sprintf("Proposal title is longer than max length of %d", MaxTitleLength))
+	}
+	if len(description) == 0 {
+		return ErrInvalidDescription(DefaultCodespace, "No description present in proposal")
+	}
+	if len(description) > MaxDescriptionLength {
+		return ErrInvalidDescription(DefaultCodespace, fmt.Sprintf("Proposal description is longer than max length of %d", MaxDescriptionLength))
+	}
+	return nil
+}
+
+func validateProposerAndDeposit(proposer sdk.AccAddress, initialDeposit sdk.Coins) sdk.Error {
+	if proposer.Empty() {
+		return sdk.ErrInvalidAddress(proposer.String())
+	}
+	if !initialDeposit.IsValid() {
+		return sdk.ErrInvalidCoins(initialDeposit.String())
+	}
+	if initialDeposit.IsAnyNegative() {
+		return sdk.ErrInvalidCoins(initialDeposit.String())
+	}
+	return nil
+}
+
 // MsgDeposit
 type MsgDeposit struct {
 	ProposalID uint64         `json:"proposal_id"` // ID of the proposal
@@ -185,3 +266,49 @@ func (msg MsgVote) GetSignBytes() []byte {
 func (msg MsgVote) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.Voter}
 }
+
+// MsgVoteWeighted splits the voting power of the voter across several options
+type MsgVoteWeighted struct {
+	ProposalID uint64              `json:"proposal_id"` // ID of the proposal
+	Voter      sdk.AccAddress      `json:"voter"`       //  address of the voter
+	Options    WeightedVoteOptions `json:"options"`     //  weighted options from OptionSet chosen by the voter
+}
+
+func NewMsgVoteWeighted(voter sdk.AccAddress, proposalID uint64, options WeightedVoteOptions) MsgVoteWeighted {
+	return MsgVoteWeighted{
+		ProposalID: proposalID,
+		Voter:      voter,
+		Options:    options,
+	}
+}
+
+// Implements Msg.
+// nolint
+func (msg MsgVoteWeighted) Route() string { return RouterKey }
+func (msg MsgVoteWeighted) Type() string  { return TypeMsgVoteWeighted }
+
+// Implements Msg.
+func (msg MsgVoteWeighted) ValidateBasic() sdk.Error {
+	if msg.Voter.Empty() {
+		return sdk.ErrInvalidAddress(msg.Voter.String())
+	}
+	if !validWeightedVoteOptions(msg.Options) {
+		return ErrInvalidWeightedVote(DefaultCodespace, msg.Options)
+	}
+	return nil
+}
+
+func (msg MsgVoteWeighted) String() string {
+	return fmt.Sprintf("MsgVoteWeighted{%v - %s}", msg.ProposalID, msg.Options)
+}
+
+// Implements Msg.
+func (msg MsgVoteWeighted) GetSignBytes() []byte {
+	bz := msgCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// Implements Msg.
+func (msg MsgVoteWeighted) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Voter}
+}
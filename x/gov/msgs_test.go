@@ -0,0 +1,80 @@
+package gov
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+
+	sdk "github.com/PhenixChain/PhenixChain/types"
+)
+
+func TestMsgSubmitCommunitySpendProposal(t *testing.T) {
+	proposer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	recipient := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	amount := sdk.NewCoins(sdk.NewInt64Coin("pnx", 5000))
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("pnx", 10))
+
+	tests := []struct {
+		title, description string
+		recipient          sdk.AccAddress
+		amount             sdk.Coins
+		justification      string
+		expectPass         bool
+	}{
+		{"Grant", "Tutorial series", recipient, amount, "Twelve tutorials", true},
+		{"", "Tutorial series", recipient, amount, "Twelve tutorials", false},
+		{"Grant", "Tutorial series", nil, amount, "Twelve tutorials", false},
+		{"Grant", "Tutorial series", recipient, sdk.Coins{}, "Twelve tutorials", false},
+		{"Grant", "Tutorial series", recipient, amount, "", false},
+		{"Grant", "Tutorial series", recipient, amount, strings.Repeat("#", MaxJustificationLength+1), false},
+	}
+
+	for i, tc := range tests {
+		msg := NewMsgSubmitCommunitySpendProposal(
+			tc.title, tc.description, tc.recipient, tc.amount, tc.justification, proposer, deposit,
+		)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+
+	content := NewCommunitySpendProposal("Grant", "Tutorial series", recipient, amount, "Twelve tutorials")
+	require.Equal(t, ProposalTypeCommunitySpend, content.ProposalType())
+
+	pt, err := ProposalTypeFromString(content.ProposalType().String())
+	require.NoError(t, err)
+	require.Equal(t, ProposalTypeCommunitySpend, pt)
+}
+
+func TestMsgVoteWeighted(t *testing.T) {
+	voter := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	half := sdk.NewDecWithPrec(5, 1)
+
+	tests := []struct {
+		voter      sdk.AccAddress
+		options    WeightedVoteOptions
+		expectPass bool
+	}{
+		{voter, NewNonSplitVoteOption(OptionYes), true},
+		{voter, WeightedVoteOptions{NewWeightedVoteOption(OptionYes, half), NewWeightedVoteOption(OptionNoWithVeto, half)}, true},
+		{nil, NewNonSplitVoteOption(OptionYes), false},
+		{voter, WeightedVoteOptions{}, false},
+		{voter, NewNonSplitVoteOption(OptionEmpty), false},
+		{voter, WeightedVoteOptions{NewWeightedVoteOption(OptionYes, half), NewWeightedVoteOption(OptionYes, half)}, false},
+		{voter, WeightedVoteOptions{NewWeightedVoteOption(OptionYes, half), NewWeightedVoteOption(OptionNo, sdk.NewDecWithPrec(4, 1))}, false},
+		{voter, WeightedVoteOptions{NewWeightedVoteOption(OptionYes, sdk.NewDec(2)), NewWeightedVoteOption(OptionNo, sdk.NewDec(-1))}, false},
+	}
+
+	for i, tc := range tests {
+		msg := NewMsgVoteWeighted(tc.voter, 1, tc.options)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
@@ -16,7 +16,7 @@ type Proposal struct {
 
 	ProposalID uint64 `json:"proposal_id"` //  ID of the proposal
 
-	Status           ProposalStatus `json:"proposal_status"`    //  Status of the Proposal {Pending, Active, Passed, Rejected}
+	Status           ProposalStatus `json:"proposal_status"`    //  Status of the Proposal {Pending, Active, Passed, Rejected, Failed, Dropped}
 	FinalTallyResult TallyResult    `json:"final_tally_result"` //  Result of Tallys
 
 	SubmitTime     time.Time `json:"submit_time"`      //  Time of the block where TxGovSubmitProposal was included
@@ -25,11 +25,21 @@ type Proposal struct {
 
 	VotingStartTime time.Time `json:"voting_start_time"` //  Time of the block where MinDeposit was reached. -1 if MinDeposit is not reached
 	VotingEndTime   time.Time `json:"voting_end_time"`   // Time that the VotingPeriod for this proposal will end and votes will be tallied
+
+	DepositOutcome  DepositOutcome `json:"deposit_outcome"`  //  Whether the deposits were refunded or burned once the proposal left the deposit or voting period
+	ExecutionResult string         `json:"execution_result"` //  Error returned when executing a passed proposal failed
+}
+
+// IsArchived returns true once the proposal left the deposit and voting
+// periods; it is kept in state with its final tally as a history record
+func (p Proposal) IsArchived() bool {
+	return p.Status == StatusDropped || p.Status == StatusPassed ||
+		p.Status == StatusRejected || p.Status == StatusFailed
 }
 
 // nolint
 func (p Proposal) String() string {
-	return fmt.Sprintf(`Proposal %d:
+	out := fmt.Sprintf(`Proposal %d:
   Title:              %s
   Type:               %s
   Status:             %s
@@ -43,6 +53,16 @@ func (p Proposal) String() string {
 		p.Status, p.SubmitTime, p.DepositEndTime,
 		p.TotalDeposit, p.VotingStartTime, p.VotingEndTime, p.GetDescription(),
 	)
+	if p.IsArchived() {
+		out += fmt.Sprintf(`
+  Deposit Outcome:    %s
+  %s`, p.DepositOutcome, p.FinalTallyResult)
+	}
+	if p.ExecutionResult != "" {
+		out += fmt.Sprintf(`
+  Execution Result:   %s`, p.ExecutionResult)
+	}
+	return out
 }
 
 // ProposalContent is an interface that has title, description, and proposaltype
@@ -107,6 +127,29 @@ var _ ProposalContent = SoftwareUpgradeProposal{}
 // nolint
 func (sup SoftwareUpgradeProposal) ProposalType() ProposalKind { return ProposalTypeSoftwareUpgrade }
 
+// Community Spend Proposals
+type CommunitySpendProposal struct {
+	TextProposal
+	Recipient     sdk.AccAddress `json:"recipient"`     //  Address receiving the funds
+	Amount        sdk.Coins      `json:"amount"`        //  Coins paid from the community pool
+	Justification string         `json:"justification"` //  Why the community pool should fund the recipient
+}
+
+func NewCommunitySpendProposal(title, description string, recipient sdk.AccAddress, amount sdk.Coins, justification string) CommunitySpendProposal {
+	return CommunitySpendProposal{
+		TextProposal:  NewTextProposal(title, description),
+		Recipient:     recipient,
+		Amount:        amount,
+		Justification: justification,
+	}
+}
+
+// Implements Proposal Interface
+var _ ProposalContent = CommunitySpendProposal{}
+
+// nolint
+func (csp CommunitySpendProposal) ProposalType() ProposalKind { return ProposalTypeCommunitySpend }
+
 // ProposalQueue
 type ProposalQueue []uint64
 
@@ -121,6 +164,7 @@ const (
 	ProposalTypeText            ProposalKind = 0x01
 	ProposalTypeParameterChange ProposalKind = 0x02
 	ProposalTypeSoftwareUpgrade ProposalKind = 0x03
+	ProposalTypeCommunitySpend  ProposalKind = 0x04
 )
 
 // String to proposalType byte. Returns 0xff if invalid.
@@ -132,6 +176,8 @@ func ProposalTypeFromString(str string) (ProposalKind, error) {
 		return ProposalTypeParameterChange, nil
 	case "SoftwareUpgrade":
 		return ProposalTypeSoftwareUpgrade, nil
+	case "CommunitySpend":
+		return ProposalTypeCommunitySpend, nil
 	default:
 		return ProposalKind(0xff), fmt.Errorf("'%s' is not a valid proposal type", str)
 	}
@@ -188,6 +234,8 @@ func (pt ProposalKind) String() string {
 		return "ParameterChange"
 	case ProposalTypeSoftwareUpgrade:
 		return "SoftwareUpgrade"
+	case ProposalTypeCommunitySpend:
+		return "CommunitySpend"
 	default:
 		return ""
 	}
@@ -217,6 +265,8 @@ const (
 	StatusVotingPeriod  ProposalStatus = 0x02
 	StatusPassed        ProposalStatus = 0x03
 	StatusRejected      ProposalStatus = 0x04
+	StatusFailed        ProposalStatus = 0x05
+	StatusDropped       ProposalStatus = 0x06
 )
 
 // ProposalStatusToString turns a string into a ProposalStatus
@@ -230,6 +280,10 @@ func ProposalStatusFromString(str string) (ProposalStatus, error) {
 		return StatusPassed, nil
 	case "Rejected":
 		return StatusRejected, nil
+	case "Failed":
+		return StatusFailed, nil
+	case "Dropped":
+		return StatusDropped, nil
 	case "":
 		return StatusNil, nil
 	default:
@@ -242,7 +296,9 @@ func validProposalStatus(status ProposalStatus) bool {
 	if status == StatusDepositPeriod ||
 		status == StatusVotingPeriod ||
 		status == StatusPassed ||
-		status == StatusRejected {
+		status == StatusRejected ||
+		status == StatusFailed ||
+		status == StatusDropped {
 		return true
 	}
 	return false
@@ -291,6 +347,10 @@ func (status ProposalStatus) String() string {
 		return "Passed"
 	case StatusRejected:
 		return "Rejected"
+	case StatusFailed:
+		return "Failed"
+	case StatusDropped:
+		return "Dropped"
 	default:
 		return ""
 	}
@@ -308,6 +368,76 @@ func (status ProposalStatus) Format(s fmt.State, verb rune) {
 	}
 }
 
+// DepositOutcome
+
+// Type that represents what happened to the deposits of a proposal as a byte
+type DepositOutcome byte
+
+//nolint
+const (
+	DepositOutcomeNil      DepositOutcome = 0x00
+	DepositOutcomeRefunded DepositOutcome = 0x01
+	DepositOutcomeBurned   DepositOutcome = 0x02
+)
+
+// DepositOutcomeFromString turns a string into a DepositOutcome
+func DepositOutcomeFromString(str string) (DepositOutcome, error) {
+	switch str {
+	case "Refunded":
+		return DepositOutcomeRefunded, nil
+	case "Burned":
+		return DepositOutcomeBurned, nil
+	case "":
+		return DepositOutcomeNil, nil
+	default:
+		return DepositOutcome(0xff), fmt.Errorf("'%s' is not a valid deposit outcome", str)
+	}
+}
+
+// Marshal needed for protobuf compatibility
+func (outcome DepositOutcome) Marshal() ([]byte, error) {
+	return []byte{byte(outcome)}, nil
+}
+
+// Unmarshal needed for protobuf compatibility
+func (outcome *DepositOutcome) Unmarshal(data []byte) error {
+	*outcome = DepositOutcome(data[0])
+	return nil
+}
+
+// Marshals to JSON using string
+func (outcome DepositOutcome) MarshalJSON() ([]byte, error) {
+	return json.Marshal(outcome.String())
+}
+
+// Unmarshals from JSON using string
+func (outcome *DepositOutcome) UnmarshalJSON(data []byte) error {
+	var s string
+	err := json.Unmarshal(data, &s)
+	if err != nil {
+		return err
+	}
+
+	bz2, err := DepositOutcomeFromString(s)
+	if err != nil {
+		return err
+	}
+	*outcome = bz2
+	return nil
+}
+
+// Turns DepositOutcome byte to String
+func (outcome DepositOutcome) String() string {
+	switch outcome {
+	case DepositOutcomeRefunded:
+		return "Refunded"
+	case DepositOutcomeBurned:
+		return "Burned"
+	default:
+		return ""
+	}
+}
+
 // Tally Results
 type TallyResult struct {
 	Yes        sdk.Int `json:"yes"`
@@ -258,15 +258,21 @@ type QueryProposalsParams struct {
 	Voter          sdk.AccAddress
 	Depositor      sdk.AccAddress
 	ProposalStatus ProposalStatus
+	ProposalType   ProposalKind
+	Page           uint64
 	Limit          uint64
 }
 
 // creates a new instance of QueryProposalsParams
-func NewQueryProposalsParams(status ProposalStatus, limit uint64, voter, depositor sdk.AccAddress) QueryProposalsParams {
+func NewQueryProposalsParams(status ProposalStatus, proposalType ProposalKind, page, limit uint64,
+	voter, depositor sdk.AccAddress) QueryProposalsParams {
+
 	return QueryProposalsParams{
 		Voter:          voter,
 		Depositor:      depositor,
 		ProposalStatus: status,
+		ProposalType:   proposalType,
+		Page:           page,
 		Limit:          limit,
 	}
 }
@@ -279,7 +285,7 @@ func queryProposals(ctx sdk.Context, path []string, req abci.RequestQuery, keepe
 		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
 	}
 
-	proposals := keeper.GetProposalsFiltered(ctx, params.Voter, params.Depositor, params.ProposalStatus, params.Limit)
+	proposals := keeper.GetProposalsFiltered(ctx, params)
 
 	bz, err := codec.MarshalJSONIndent(keeper.cdc, proposals)
 	if err != nil {
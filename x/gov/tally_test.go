@@ -0,0 +1,131 @@
+package gov
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	dbm "github.com/tendermint/tendermint/libs/db"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/PhenixChain/PhenixChain/codec"
+	"github.com/PhenixChain/PhenixChain/store"
+	sdk "github.com/PhenixChain/PhenixChain/types"
+	"github.com/PhenixChain/PhenixChain/x/params"
+	"github.com/PhenixChain/PhenixChain/x/staking/types"
+)
+
+// mockStaking serves a fixed set of bonded validators and delegations
+type mockStaking struct {
+	sdk.ValidatorSet
+	validators  []types.Validator
+	delegations map[string][]types.Delegation
+}
+
+func (ms *mockStaking) GetValidatorSet() sdk.ValidatorSet { return ms }
+
+func (ms *mockStaking) IterateBondedValidatorsByPower(_ sdk.Context, fn func(int64, sdk.Validator) bool) {
+	for i, val := range ms.validators {
+		if fn(int64(i), val) {
+			return
+		}
+	}
+}
+
+func (ms *mockStaking) TotalBondedTokens(_ sdk.Context) sdk.Int {
+	total := sdk.ZeroInt()
+	for _, val := range ms.validators {
+		total = total.Add(val.GetBondedTokens())
+	}
+	return total
+}
+
+func (ms *mockStaking) IterateDelegations(_ sdk.Context, delegator sdk.AccAddress, fn func(int64, sdk.Delegation) bool) {
+	for i, del := range ms.delegations[delegator.String()] {
+		if fn(int64(i), del) {
+			return
+		}
+	}
+}
+
+func newBondedValidator(tokens int64) types.Validator {
+	pk := ed25519.GenPrivKey().PubKey()
+	val := types.NewValidator(sdk.ValAddress(pk.Address()), pk, types.Description{})
+	val.Status = sdk.Bonded
+	val.Tokens = sdk.NewInt(tokens)
+	val.DelegatorShares = sdk.NewDec(tokens)
+	return val
+}
+
+func setupTestInput(t *testing.T, ds sdk.DelegationSet) (sdk.Context, Keeper) {
+	db := dbm.NewMemDB()
+	cdc := codec.New()
+	RegisterCodec(cdc)
+
+	keyGov := sdk.NewKVStoreKey(StoreKey)
+	keyParams := sdk.NewKVStoreKey(params.StoreKey)
+	tkeyParams := sdk.NewTransientStoreKey(params.TStoreKey)
+
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(keyGov, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(tkeyParams, sdk.StoreTypeTransient, db)
+	require.NoError(t, ms.LoadLatestVersion())
+	ctx := sdk.NewContext(ms, abci.Header{}, false, log.NewNopLogger())
+
+	pk := params.NewKeeper(cdc, keyParams, tkeyParams)
+	keeper := NewKeeper(cdc, keyGov, pk, pk.Subspace(DefaultParamspace), nil, ds, nil, DefaultCodespace)
+	InitGenesis(ctx, keeper, DefaultGenesisState())
+	return ctx, keeper
+}
+
+func TestTallyWeightedVotes(t *testing.T) {
+	val1, val2 := newBondedValidator(100), newBondedValidator(100)
+	delegator := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	staking := &mockStaking{
+		validators: []types.Validator{val1, val2},
+		delegations: map[string][]types.Delegation{
+			delegator.String(): {types.NewDelegation(delegator, val1.OperatorAddress, sdk.NewDec(40))},
+		},
+	}
+	ctx, keeper := setupTestInput(t, staking)
+
+	proposal := Proposal{
+		ProposalContent: NewTextProposal("Test", "Weighted votes"),
+		ProposalID:      1,
+		Status:          StatusVotingPeriod,
+	}
+	keeper.SetProposal(ctx, proposal)
+
+	half := sdk.NewDecWithPrec(5, 1)
+	quarter := sdk.NewDecWithPrec(25, 2)
+
+	// weights must sum up to one
+	require.Error(t, keeper.AddVote(ctx, 1, delegator, WeightedVoteOptions{
+		NewWeightedVoteOption(OptionYes, half), NewWeightedVoteOption(OptionNo, quarter),
+	}))
+
+	require.NoError(t, keeper.AddVote(ctx, 1, sdk.AccAddress(val1.OperatorAddress), NewNonSplitVoteOption(OptionYes)))
+	require.NoError(t, keeper.AddVote(ctx, 1, sdk.AccAddress(val2.OperatorAddress), WeightedVoteOptions{
+		NewWeightedVoteOption(OptionNo, quarter), NewWeightedVoteOption(OptionAbstain, sdk.NewDecWithPrec(75, 2)),
+	}))
+
+	// the delegator changes its mind, only the last vote is tallied
+	require.NoError(t, keeper.AddVote(ctx, 1, delegator, NewNonSplitVoteOption(OptionNoWithVeto)))
+	require.NoError(t, keeper.AddVote(ctx, 1, delegator, WeightedVoteOptions{
+		NewWeightedVoteOption(OptionYes, half), NewWeightedVoteOption(OptionNo, half),
+	}))
+
+	vote, found := keeper.GetVote(ctx, 1, delegator)
+	require.True(t, found)
+	require.Len(t, vote.Options, 2)
+
+	// val1 inherits 60 yes after the delegator deduction, the delegator splits 40
+	passes, tallyResults := tally(ctx, keeper, proposal)
+	require.True(t, passes)
+	require.Equal(t, int64(80), tallyResults.Yes.Int64())
+	require.Equal(t, int64(45), tallyResults.No.Int64())
+	require.Equal(t, int64(75), tallyResults.Abstain.Int64())
+	require.True(t, tallyResults.NoWithVeto.IsZero())
+}
@@ -19,10 +19,11 @@ func NewHandler(ibcm Mapper, ck BankKeeper) sdk.Handler {
 }
 
 // MsgIBCTransfer deducts coins from the account and creates an egress IBC packet.
+// The coins leave the chain, so they are removed from the total supply.
 func handleIBCTransferMsg(ctx sdk.Context, ibcm Mapper, ck BankKeeper, msg MsgIBCTransfer) sdk.Result {
 	packet := msg.IBCPacket
 
-	_, _, err := ck.SubtractCoins(ctx, packet.SrcAddr, packet.Coins)
+	_, err := ck.BurnCoins(ctx, packet.SrcAddr, packet.Coins)
 	if err != nil {
 		return err.Result()
 	}
@@ -36,6 +37,7 @@ func handleIBCTransferMsg(ctx sdk.Context, ibcm Mapper, ck BankKeeper, msg MsgIB
 }
 
 // MsgIBCReceive adds coins to the destination address and creates an ingress IBC packet.
+// The coins enter the chain, so they are added to the total supply.
 func handleIBCReceiveMsg(ctx sdk.Context, ibcm Mapper, ck BankKeeper, msg MsgIBCReceive) sdk.Result {
 	packet := msg.IBCPacket
 
@@ -45,7 +47,7 @@ func handleIBCReceiveMsg(ctx sdk.Context, ibcm Mapper, ck BankKeeper, msg MsgIBC
 	}
 
 	// XXX Check that packet.Coins is valid and positive (nonzero)
-	_, _, err := ck.AddCoins(ctx, packet.DestAddr, packet.Coins)
+	_, err := ck.MintCoins(ctx, packet.DestAddr, packet.Coins)
 	if err != nil {
 		return err.Result()
 	}
@@ -0,0 +1,35 @@
+package params
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/PhenixChain/PhenixChain/codec"
+)
+
+// storedParam is a parameter of the params store with the subspace and name it
+// is stored under
+type storedParam struct {
+	Subspace string          `json:"subspace"`
+	Key      string          `json:"key"`
+	Value    json.RawMessage `json:"value"`
+}
+
+// DecodeStore decodes a key/value pair of the params store into JSON. Values
+// are stored as JSON under the name of their subspace and key separated by a
+// slash.
+func DecodeStore(_ *codec.Codec, key, value []byte) (json.RawMessage, error) {
+	i := bytes.IndexByte(key, '/')
+	if i < 0 {
+		return nil, fmt.Errorf("unknown key %X", key)
+	}
+	if !json.Valid(value) {
+		return nil, fmt.Errorf("invalid JSON value of parameter %s", key)
+	}
+	return json.Marshal(storedParam{
+		Subspace: string(key[:i]),
+		Key:      string(key[i+1:]),
+		Value:    value,
+	})
+}
@@ -3,4 +3,6 @@ package cli
 // nolint
 const (
 	FlagAddressValidator = "validator"
+	FlagThreshold        = "threshold"
+	FlagInterval         = "interval"
 )
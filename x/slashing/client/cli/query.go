@@ -3,8 +3,10 @@ package cli
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 
 	"github.com/PhenixChain/PhenixChain/client/context"
 	"github.com/PhenixChain/PhenixChain/codec" // XXX fix
@@ -74,3 +76,128 @@ $ gaiacli query slashing params
 		},
 	}
 }
+
+// GetCmdQueryLiveness implements the command to query the liveness of a
+// validator over the current signed blocks window.
+func GetCmdQueryLiveness(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "liveness [validator-cons-addr]",
+		Short: "Query a validator's missed blocks, uptime and predicted jail height",
+		Long: strings.TrimSpace(`Query the missed-blocks bitmap over the current signed blocks window, the
+uptime percentage and the height at which the validator would be jailed for
+downtime if it keeps missing blocks at its current rate:
+
+$ phenixcli query slashing liveness cosmosvalcons1lcck2cxh7dzgkrfk53kysg9ktdrsjj6jfwlnm2
+`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			consAddr, err := parseConsAddress(args[0])
+			if err != nil {
+				return err
+			}
+
+			liveness, err := queryLiveness(cliCtx, cdc, consAddr)
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(liveness)
+		},
+	}
+}
+
+// GetCmdWatchLiveness implements the command to follow the liveness of a
+// validator block by block and alert when its missed blocks cross a threshold.
+func GetCmdWatchLiveness(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "watch [validator-cons-addr]",
+		Short: "Watch a validator's missed blocks and alert when they cross a threshold",
+		Long: strings.TrimSpace(`Poll the liveness of a validator on every new block and print its missed
+blocks counter and uptime. An alert is printed when the missed blocks in the
+current window rise above --threshold, and again once they recover:
+
+$ phenixcli query slashing watch cosmosvalcons1lcck2cxh7dzgkrfk53kysg9ktdrsjj6jfwlnm2 --threshold=50
+`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			consAddr, err := parseConsAddress(args[0])
+			if err != nil {
+				return err
+			}
+
+			threshold := viper.GetInt64(FlagThreshold)
+			interval := viper.GetDuration(FlagInterval)
+			if interval <= 0 {
+				return fmt.Errorf("--%s must be positive", FlagInterval)
+			}
+
+			var lastHeight int64
+			alerting := false
+			for {
+				liveness, err := queryLiveness(cliCtx, cdc, consAddr)
+				if err != nil {
+					return err
+				}
+
+				if liveness.Height != lastHeight {
+					lastHeight = liveness.Height
+					fmt.Printf("height=%d missed=%d/%d uptime=%s%%\n", liveness.Height,
+						liveness.MissedBlocksCounter, liveness.SignedBlocksWindow, liveness.Uptime)
+
+					switch {
+					case !alerting && liveness.MissedBlocksCounter > threshold:
+						alerting = true
+						fmt.Printf("ALERT: validator %s missed %d blocks in the current window (threshold %d), predicted jail height: %d\n",
+							consAddr, liveness.MissedBlocksCounter, threshold, liveness.PredictedJailHeight)
+					case alerting && liveness.MissedBlocksCounter <= threshold:
+						alerting = false
+						fmt.Printf("RECOVERED: validator %s missed %d blocks in the current window (threshold %d)\n",
+							consAddr, liveness.MissedBlocksCounter, threshold)
+					}
+				}
+
+				time.Sleep(interval)
+			}
+		},
+	}
+
+	cmd.Flags().Int64(FlagThreshold, 50, "number of missed blocks in the current window above which to alert")
+	cmd.Flags().Duration(FlagInterval, 5*time.Second, "how often to poll the node for a new block")
+	return cmd
+}
+
+func queryLiveness(cliCtx context.CLIContext, cdc *codec.Codec, consAddr sdk.ConsAddress) (liveness slashing.ValidatorLiveness, err error) {
+	bz, err := cdc.MarshalJSON(slashing.NewQueryLivenessParams(consAddr))
+	if err != nil {
+		return
+	}
+
+	route := fmt.Sprintf("custom/%s/%s", slashing.QuerierRoute, slashing.QueryLiveness)
+	res, err := cliCtx.QueryWithData(route, bz)
+	if err != nil {
+		return
+	}
+
+	err = cdc.UnmarshalJSON(res, &liveness)
+	return
+}
+
+// parseConsAddress accepts either a bech32 consensus address or a bech32
+// consensus public key.
+func parseConsAddress(arg string) (sdk.ConsAddress, error) {
+	consAddr, err := sdk.ConsAddressFromBech32(arg)
+	if err == nil {
+		return consAddr, nil
+	}
+
+	pk, pkErr := sdk.GetConsPubKeyBech32(arg)
+	if pkErr != nil {
+		return nil, err
+	}
+
+	return sdk.ConsAddress(pk.Address()), nil
+}
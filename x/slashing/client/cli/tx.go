@@ -1,6 +1,10 @@
 package cli
 
 import (
+	"io/ioutil"
+
+	tmtypes "github.com/tendermint/tendermint/types"
+
 	"github.com/PhenixChain/PhenixChain/client/context"
 	"github.com/PhenixChain/PhenixChain/client/utils"
 	"github.com/PhenixChain/PhenixChain/codec"
@@ -34,3 +38,40 @@ $ gaiacli tx slashing unjail --from mykey
 		},
 	}
 }
+
+// GetCmdSubmitEvidence implements the submit evidence command.
+func GetCmdSubmitEvidence(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "submit-evidence [evidence-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "submit evidence of a validator signing two conflicting votes",
+		Long: `submit the two conflicting votes of a double-signing validator. The file
+holds the JSON encoded Tendermint duplicate vote evidence:
+
+$ phenixcli tx slashing submit-evidence evidence.json --from mykey
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := authtxb.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithAccountDecoder(cdc)
+
+			contents, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			var evidence tmtypes.DuplicateVoteEvidence
+			if err := cdc.UnmarshalJSON(contents, &evidence); err != nil {
+				return err
+			}
+
+			msg := slashing.NewMsgSubmitEvidence(cliCtx.GetFromAddress(), slashing.NewDuplicateVote(&evidence))
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg}, false)
+		},
+	}
+}
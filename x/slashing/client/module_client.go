@@ -31,6 +31,8 @@ func (mc ModuleClient) GetQueryCmd() *cobra.Command {
 		client.GetCommands(
 			cli.GetCmdQuerySigningInfo(mc.storeKey, mc.cdc),
 			cli.GetCmdQueryParams(mc.cdc),
+			cli.GetCmdQueryLiveness(mc.cdc),
+			cli.GetCmdWatchLiveness(mc.cdc),
 		)...,
 	)
 
@@ -47,6 +49,7 @@ func (mc ModuleClient) GetTxCmd() *cobra.Command {
 
 	slashingTxCmd.AddCommand(client.PostCommands(
 		cli.GetCmdUnjail(mc.cdc),
+		cli.GetCmdSubmitEvidence(mc.cdc),
 	)...)
 
 	return slashingTxCmd
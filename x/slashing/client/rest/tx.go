@@ -5,6 +5,7 @@ import (
 	"net/http"
 
 	"github.com/gorilla/mux"
+	tmtypes "github.com/tendermint/tendermint/types"
 
 	"github.com/PhenixChain/PhenixChain/client/context"
 	clientrest "github.com/PhenixChain/PhenixChain/client/rest"
@@ -20,6 +21,11 @@ func registerTxRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Codec
 		"/slashing/validators/{validatorAddr}/unjail",
 		unjailRequestHandlerFn(cdc, kb, cliCtx),
 	).Methods("POST")
+
+	r.HandleFunc(
+		"/slashing/evidence",
+		submitEvidenceRequestHandlerFn(cdc, kb, cliCtx),
+	).Methods("POST")
 }
 
 // Unjail TX body
@@ -70,3 +76,38 @@ func unjailRequestHandlerFn(cdc *codec.Codec, kb keys.Keybase, cliCtx context.CL
 		clientrest.WriteGenerateStdTxResponse(w, cdc, cliCtx, req.BaseReq, []sdk.Msg{msg})
 	}
 }
+
+// SubmitEvidence TX body
+type SubmitEvidenceReq struct {
+	BaseReq  rest.BaseReq                   `json:"base_req"`
+	Evidence *tmtypes.DuplicateVoteEvidence `json:"evidence"`
+}
+
+func submitEvidenceRequestHandlerFn(cdc *codec.Codec, kb keys.Keybase, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req SubmitEvidenceReq
+		if !rest.ReadRESTReq(w, r, cdc, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := slashing.NewMsgSubmitEvidence(fromAddr, slashing.NewDuplicateVote(req.Evidence))
+		err = msg.ValidateBasic()
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		clientrest.WriteGenerateStdTxResponse(w, cdc, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
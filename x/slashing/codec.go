@@ -7,6 +7,25 @@ import (
 // Register concrete types on codec codec
 func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgUnjail{}, "cosmos-sdk/MsgUnjail", nil)
+	cdc.RegisterConcrete(MsgSubmitEvidence{}, "cosmos-sdk/MsgSubmitEvidence", nil)
+	registerEvidence(cdc)
+}
+
+// registerEvidence registers the evidence interface and its concrete types
+func registerEvidence(cdc *codec.Codec) {
+	cdc.RegisterInterface((*Evidence)(nil), nil)
+	cdc.RegisterConcrete(Equivocation{}, "cosmos-sdk/Equivocation", nil)
+	cdc.RegisterConcrete(DuplicateVote{}, "cosmos-sdk/DuplicateVote", nil)
 }
 
 var cdcEmpty = codec.New()
+
+// evidenceCdc encodes the evidence held by MsgSubmitEvidence. The msgs are
+// not registered on it, nor on the cdc the other sign bytes are built with,
+// so that signers keep signing the bare msg structs.
+var evidenceCdc = codec.New()
+
+func init() {
+	registerEvidence(evidenceCdc)
+	codec.RegisterCrypto(evidenceCdc)
+}
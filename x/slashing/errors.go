@@ -2,6 +2,10 @@
 package slashing
 
 import (
+	"fmt"
+
+	cmn "github.com/tendermint/tendermint/libs/common"
+
 	sdk "github.com/PhenixChain/PhenixChain/types"
 )
 
@@ -17,6 +21,11 @@ const (
 	CodeValidatorNotJailed    CodeType = 103
 	CodeMissingSelfDelegation CodeType = 104
 	CodeSelfDelegationTooLow  CodeType = 105
+	CodeNoSigningInfoFound    CodeType = 106
+	CodeValidatorTombstoned   CodeType = 107
+	CodeInvalidEvidence       CodeType = 108
+	CodeEvidenceExists        CodeType = 109
+	CodeNoEvidenceHandler     CodeType = 110
 )
 
 func ErrNoValidatorForAddress(codespace sdk.CodespaceType) sdk.Error {
@@ -42,3 +51,23 @@ func ErrMissingSelfDelegation(codespace sdk.CodespaceType) sdk.Error {
 func ErrSelfDelegationTooLowToUnjail(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeValidatorNotJailed, "validator's self delegation less than MinSelfDelegation, cannot be unjailed")
 }
+
+func ErrNoSigningInfoFound(codespace sdk.CodespaceType, consAddr sdk.ConsAddress) sdk.Error {
+	return sdk.NewError(codespace, CodeNoSigningInfoFound, fmt.Sprintf("no signing info found for validator %s", consAddr))
+}
+
+func ErrValidatorTombstoned(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeValidatorTombstoned, "validator has been tombstoned for double signing")
+}
+
+func ErrInvalidEvidence(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidEvidence, fmt.Sprintf("invalid evidence: %s", msg))
+}
+
+func ErrEvidenceExists(codespace sdk.CodespaceType, hash cmn.HexBytes) sdk.Error {
+	return sdk.NewError(codespace, CodeEvidenceExists, fmt.Sprintf("evidence %s has already been submitted", hash))
+}
+
+func ErrNoEvidenceHandlerExists(codespace sdk.CodespaceType, route string) sdk.Error {
+	return sdk.NewError(codespace, CodeNoEvidenceHandler, fmt.Sprintf("no handler exists for evidence type %s", route))
+}
@@ -0,0 +1,304 @@
+package slashing
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	cmn "github.com/tendermint/tendermint/libs/common"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	sdk "github.com/PhenixChain/PhenixChain/types"
+)
+
+// Routes of the evidence types handled by default
+const (
+	RouteEquivocation  = "equivocation"
+	RouteDuplicateVote = "duplicatevote"
+)
+
+// Evidence defines an infraction which can be handled by the slashing
+// evidence subsystem. Each evidence type is routed to its own handler.
+type Evidence interface {
+	Route() string
+	Hash() cmn.HexBytes
+	ValidateBasic() sdk.Error
+	GetConsensusAddress() sdk.ConsAddress
+	GetHeight() int64
+	GetTime() time.Time
+	String() string
+}
+
+// EvidenceHandler processes evidence of a given type, slashing the validator
+// at fault if the evidence is valid.
+type EvidenceHandler func(ctx sdk.Context, evidence Evidence) sdk.Error
+
+// EvidenceRouter provides handlers for each evidence type.
+type EvidenceRouter interface {
+	AddRoute(r string, h EvidenceHandler) (rtr EvidenceRouter)
+	HasRoute(r string) bool
+	GetRoute(path string) (h EvidenceHandler)
+}
+
+type evidenceRouter struct {
+	routes map[string]EvidenceHandler
+}
+
+// NewEvidenceRouter returns a reference to a new evidence router.
+func NewEvidenceRouter() EvidenceRouter {
+	return &evidenceRouter{
+		routes: make(map[string]EvidenceHandler),
+	}
+}
+
+// AddRoute adds a handler for an evidence type. It panics if a handler has
+// already been registered for the type.
+func (rtr *evidenceRouter) AddRoute(path string, h EvidenceHandler) EvidenceRouter {
+	if rtr.HasRoute(path) {
+		panic(fmt.Sprintf("evidence route %s has already been initialized", path))
+	}
+
+	rtr.routes[path] = h
+	return rtr
+}
+
+// HasRoute returns true if a handler is registered for the evidence type.
+func (rtr *evidenceRouter) HasRoute(path string) bool {
+	return rtr.routes[path] != nil
+}
+
+// GetRoute returns the handler for a given evidence type.
+func (rtr *evidenceRouter) GetRoute(path string) EvidenceHandler {
+	return rtr.routes[path]
+}
+
+//______________________________________________________________________
+
+var _ Evidence = Equivocation{}
+
+// Equivocation is a double-sign reported by Tendermint at the beginning of a
+// block. It is trusted as-is and can't be submitted through a transaction.
+type Equivocation struct {
+	Height           int64           `json:"height"`
+	Time             time.Time       `json:"time"`
+	Power            int64           `json:"power"`
+	ConsensusAddress sdk.ConsAddress `json:"consensus_address"`
+}
+
+// NewEquivocation creates Equivocation evidence from an infraction reported
+// by Tendermint.
+func NewEquivocation(height int64, time time.Time, power int64, consAddr sdk.ConsAddress) Equivocation {
+	return Equivocation{
+		Height:           height,
+		Time:             time,
+		Power:            power,
+		ConsensusAddress: consAddr,
+	}
+}
+
+//nolint
+func (e Equivocation) Route() string                        { return RouteEquivocation }
+func (e Equivocation) GetConsensusAddress() sdk.ConsAddress { return e.ConsensusAddress }
+func (e Equivocation) GetHeight() int64                     { return e.Height }
+func (e Equivocation) GetTime() time.Time                   { return e.Time }
+
+// Hash returns the hash of the amino encoded evidence.
+func (e Equivocation) Hash() cmn.HexBytes {
+	return tmhash.Sum(evidenceCdc.MustMarshalBinaryBare(e))
+}
+
+// ValidateBasic performs stateless checks on the evidence.
+func (e Equivocation) ValidateBasic() sdk.Error {
+	if e.ConsensusAddress.Empty() {
+		return ErrInvalidEvidence(DefaultCodespace, "missing consensus address")
+	}
+	if e.Height < 0 {
+		return ErrInvalidEvidence(DefaultCodespace, "negative infraction height")
+	}
+	if e.Power <= 0 {
+		return ErrInvalidEvidence(DefaultCodespace, "non-positive validator power")
+	}
+	return nil
+}
+
+// Return human readable equivocation
+func (e Equivocation) String() string {
+	return fmt.Sprintf(`Equivocation:
+  Consensus Address: %s
+  Height:            %d
+  Time:              %v
+  Power:             %d`,
+		e.ConsensusAddress, e.Height, e.Time, e.Power)
+}
+
+//______________________________________________________________________
+
+var _ Evidence = DuplicateVote{}
+
+// DuplicateVote is a double-sign proven by two conflicting votes signed by the
+// same validator. It can be submitted by anyone through MsgSubmitEvidence.
+type DuplicateVote struct {
+	Evidence *tmtypes.DuplicateVoteEvidence `json:"evidence"`
+}
+
+// NewDuplicateVote creates DuplicateVote evidence from two conflicting votes.
+func NewDuplicateVote(evidence *tmtypes.DuplicateVoteEvidence) DuplicateVote {
+	return DuplicateVote{
+		Evidence: evidence,
+	}
+}
+
+//nolint
+func (dv DuplicateVote) Route() string      { return RouteDuplicateVote }
+func (dv DuplicateVote) Hash() cmn.HexBytes { return dv.Evidence.Hash() }
+func (dv DuplicateVote) GetHeight() int64   { return dv.Evidence.Height() }
+func (dv DuplicateVote) GetTime() time.Time { return dv.Evidence.VoteA.Timestamp }
+func (dv DuplicateVote) GetConsensusAddress() sdk.ConsAddress {
+	return sdk.ConsAddress(dv.Evidence.Address())
+}
+
+// ValidateBasic performs stateless checks on the evidence. The signatures are
+// verified by the handler against the validator's stored public key.
+func (dv DuplicateVote) ValidateBasic() sdk.Error {
+	if dv.Evidence == nil || dv.Evidence.PubKey == nil {
+		return ErrInvalidEvidence(DefaultCodespace, "missing duplicate vote evidence")
+	}
+	if err := dv.Evidence.ValidateBasic(); err != nil {
+		return ErrInvalidEvidence(DefaultCodespace, err.Error())
+	}
+	return nil
+}
+
+// Return human readable duplicate vote
+func (dv DuplicateVote) String() string {
+	if dv.Evidence == nil {
+		return "DuplicateVote: <nil>"
+	}
+	return fmt.Sprintf("DuplicateVote: %s", dv.Evidence)
+}
+
+//______________________________________________________________________
+
+// SubmitEvidence routes evidence to the handler registered for its type. The
+// evidence is stored once handled so that it can't be processed again.
+func (k Keeper) SubmitEvidence(ctx sdk.Context, evidence Evidence) sdk.Error {
+	if _, found := k.GetEvidence(ctx, evidence.Hash()); found {
+		return ErrEvidenceExists(k.codespace, evidence.Hash())
+	}
+	if !k.router.HasRoute(evidence.Route()) {
+		return ErrNoEvidenceHandlerExists(k.codespace, evidence.Route())
+	}
+
+	// only persist the state changes of the handler if the evidence is valid
+	cacheCtx, writeCache := ctx.CacheContext()
+	if err := k.router.GetRoute(evidence.Route())(cacheCtx, evidence); err != nil {
+		return err
+	}
+	writeCache()
+
+	k.SetEvidence(ctx, evidence)
+	return nil
+}
+
+// EvidenceRouter returns the router of the evidence handlers, allowing apps to
+// register handlers for their own evidence types.
+func (k Keeper) EvidenceRouter() EvidenceRouter {
+	return k.router
+}
+
+// GetEvidence returns the handled evidence with the given hash
+func (k Keeper) GetEvidence(ctx sdk.Context, hash cmn.HexBytes) (evidence Evidence, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(GetEvidenceKey(hash))
+	if bz == nil {
+		return nil, false
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &evidence)
+	return evidence, true
+}
+
+// SetEvidence stores handled evidence by its hash
+func (k Keeper) SetEvidence(ctx sdk.Context, evidence Evidence) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(evidence)
+	store.Set(GetEvidenceKey(evidence.Hash()), bz)
+}
+
+// IterateEvidence iterates over all the handled evidence
+func (k Keeper) IterateEvidence(ctx sdk.Context, handler func(evidence Evidence) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, EvidenceKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var evidence Evidence
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &evidence)
+		if handler(evidence) {
+			break
+		}
+	}
+}
+
+// PruneExpiredEvidence deletes the handled evidence and the voting power
+// history older than the max evidence age. Such evidence is rejected by its
+// age, so it needs neither to be remembered nor its power to be known.
+func (k Keeper) PruneExpiredEvidence(ctx sdk.Context) {
+	cutoff := ctx.BlockHeader().Time.Add(-k.MaxEvidenceAge(ctx))
+
+	var expired []cmn.HexBytes
+	k.IterateEvidence(ctx, func(evidence Evidence) bool {
+		if evidence.GetTime().Before(cutoff) {
+			expired = append(expired, evidence.Hash())
+		}
+		return false
+	})
+	store := ctx.KVStore(k.storeKey)
+	for _, hash := range expired {
+		store.Delete(GetEvidenceKey(hash))
+	}
+
+	k.pruneValidatorPowers(ctx, cutoff)
+}
+
+//______________________________________________________________________
+
+// NewEquivocationHandler returns the handler for double-signs reported by
+// Tendermint.
+func NewEquivocationHandler(k Keeper) EvidenceHandler {
+	return func(ctx sdk.Context, evidence Evidence) sdk.Error {
+		e, ok := evidence.(Equivocation)
+		if !ok {
+			return ErrInvalidEvidence(k.codespace, fmt.Sprintf("unexpected evidence type %T", evidence))
+		}
+		return k.handleDoubleSign(ctx, crypto.Address(e.ConsensusAddress), e.Height, e.Time, e.Power)
+	}
+}
+
+// NewDuplicateVoteHandler returns the handler for submitted duplicate votes.
+// The votes are verified against the validator's public key and the validator
+// is slashed with the voting power it signed the votes' height with.
+func NewDuplicateVoteHandler(k Keeper) EvidenceHandler {
+	return func(ctx sdk.Context, evidence Evidence) sdk.Error {
+		dv, ok := evidence.(DuplicateVote)
+		if !ok {
+			return ErrInvalidEvidence(k.codespace, fmt.Sprintf("unexpected evidence type %T", evidence))
+		}
+
+		consAddr := dv.GetConsensusAddress()
+		pubkey, err := k.getPubkey(ctx, crypto.Address(consAddr))
+		if err != nil {
+			return ErrNoValidatorForAddress(k.codespace)
+		}
+		if err := dv.Evidence.Verify(ctx.ChainID(), pubkey); err != nil {
+			return ErrInvalidEvidence(k.codespace, err.Error())
+		}
+
+		power, found := k.getValidatorPower(ctx, consAddr, dv.GetHeight())
+		if !found {
+			return ErrInvalidEvidence(k.codespace,
+				fmt.Sprintf("validator %s had no voting power at height %d", consAddr, dv.GetHeight()))
+		}
+
+		return k.handleDoubleSign(ctx, crypto.Address(consAddr), dv.GetHeight(), dv.GetTime(), power)
+	}
+}
@@ -0,0 +1,261 @@
+package slashing
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	dbm "github.com/tendermint/tendermint/libs/db"
+	"github.com/tendermint/tendermint/libs/log"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/PhenixChain/PhenixChain/codec"
+	"github.com/PhenixChain/PhenixChain/store"
+	sdk "github.com/PhenixChain/PhenixChain/types"
+	"github.com/PhenixChain/PhenixChain/x/params"
+	"github.com/PhenixChain/PhenixChain/x/staking/types"
+)
+
+// slashEvent records a call of Slash
+type slashEvent struct {
+	consAddr sdk.ConsAddress
+	height   int64
+	power    int64
+}
+
+// mockValidatorSet serves a single validator and records its punishments
+type mockValidatorSet struct {
+	sdk.ValidatorSet
+	validator types.Validator
+	slashes   []slashEvent
+}
+
+func (vs *mockValidatorSet) ValidatorByConsAddr(_ sdk.Context, consAddr sdk.ConsAddress) sdk.Validator {
+	if !vs.validator.GetConsAddr().Equals(consAddr) {
+		return nil
+	}
+	return vs.validator
+}
+
+func (vs *mockValidatorSet) Slash(_ sdk.Context, consAddr sdk.ConsAddress, height, power int64, _ sdk.Dec) {
+	vs.slashes = append(vs.slashes, slashEvent{consAddr, height, power})
+}
+
+func (vs *mockValidatorSet) Jail(_ sdk.Context, _ sdk.ConsAddress) {
+	vs.validator.Jailed = true
+}
+
+func setupTestInput(t *testing.T, vs sdk.ValidatorSet) (sdk.Context, Keeper) {
+	db := dbm.NewMemDB()
+	cdc := codec.New()
+	RegisterCodec(cdc)
+	codec.RegisterCrypto(cdc)
+
+	keySlashing := sdk.NewKVStoreKey(StoreKey)
+	keyParams := sdk.NewKVStoreKey(params.StoreKey)
+	tkeyParams := sdk.NewTransientStoreKey(params.TStoreKey)
+
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(keySlashing, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(tkeyParams, sdk.StoreTypeTransient, db)
+	require.NoError(t, ms.LoadLatestVersion())
+	ctx := sdk.NewContext(ms, abci.Header{ChainID: "test-chain", Time: time.Unix(1000, 0).UTC()}, false, log.NewNopLogger())
+
+	pk := params.NewKeeper(cdc, keyParams, tkeyParams)
+	keeper := NewKeeper(cdc, keySlashing, vs, pk.Subspace(DefaultParamspace), DefaultCodespace)
+	defaultParams := DefaultParams()
+	keeper.paramspace.SetParamSet(ctx, &defaultParams)
+	return ctx, keeper
+}
+
+// duplicateVote signs two prevotes of a height for the given blocks
+func duplicateVote(t *testing.T, chainID string, priv ed25519.PrivKeyEd25519, height int64, blockA, blockB string) DuplicateVote {
+	vote := func(block string) *tmtypes.Vote {
+		vote := &tmtypes.Vote{
+			ValidatorAddress: priv.PubKey().Address(),
+			Height:           height,
+			Type:             tmtypes.PrevoteType,
+			Timestamp:        time.Unix(900, 0).UTC(),
+			BlockID:          tmtypes.BlockID{Hash: tmhash.Sum([]byte(block))},
+		}
+		sig, err := priv.Sign(vote.SignBytes(chainID))
+		require.NoError(t, err)
+		vote.Signature = sig
+		return vote
+	}
+	return NewDuplicateVote(&tmtypes.DuplicateVoteEvidence{PubKey: priv.PubKey(), VoteA: vote(blockA), VoteB: vote(blockB)})
+}
+
+func TestDuplicateVoteEvidence(t *testing.T) {
+	priv := ed25519.GenPrivKey()
+	validator := types.NewValidator(sdk.ValAddress(priv.PubKey().Address()), priv.PubKey(), types.Description{})
+	validator.Status = sdk.Bonded
+	vs := &mockValidatorSet{validator: validator}
+	ctx, keeper := setupTestInput(t, vs)
+
+	consAddr := sdk.ConsAddress(priv.PubKey().Address())
+	keeper.addPubkey(ctx, priv.PubKey())
+	keeper.SetValidatorSigningInfo(ctx, consAddr, NewValidatorSigningInfo(0, 0, time.Unix(0, 0), false, 0))
+
+	// the validator signed heights 5 to 7 with a power of 10, then 20
+	for height, power := range map[int64]int64{5: 10, 6: 10, 7: 10, 8: 20, 9: 20} {
+		keeper.setValidatorPower(ctx, consAddr, height, power)
+	}
+	submit := func(evidence Evidence) sdk.Error {
+		return keeper.SubmitEvidence(ctx, evidence)
+	}
+
+	// votes signed by another key are rejected
+	forged := duplicateVote(t, ctx.ChainID(), ed25519.GenPrivKey(), 6, "a", "b")
+	forged.Evidence.PubKey = priv.PubKey()
+	forged.Evidence.VoteA.ValidatorAddress = priv.PubKey().Address()
+	forged.Evidence.VoteB.ValidatorAddress = priv.PubKey().Address()
+	require.Equal(t, CodeInvalidEvidence, submit(forged).Code())
+
+	// two votes for the same block are no double-sign
+	require.Equal(t, CodeInvalidEvidence, submit(duplicateVote(t, ctx.ChainID(), priv, 6, "a", "a")).Code())
+
+	// votes of a height the validator had no power at are rejected
+	require.Equal(t, CodeInvalidEvidence, submit(duplicateVote(t, ctx.ChainID(), priv, 4, "a", "b")).Code())
+	require.Empty(t, vs.slashes)
+	_, found := keeper.GetEvidence(ctx, forged.Hash())
+	require.False(t, found)
+
+	// the validator is slashed with its power at the infraction height, not
+	// its current one, then tombstoned
+	evidence := duplicateVote(t, ctx.ChainID(), priv, 6, "a", "b")
+	require.NoError(t, submit(evidence))
+	require.Equal(t, []slashEvent{{consAddr, 6 - sdk.ValidatorUpdateDelay, 10}}, vs.slashes)
+	require.True(t, vs.validator.IsJailed())
+	signInfo, _ := keeper.getValidatorSigningInfo(ctx, consAddr)
+	require.True(t, signInfo.Tombstoned)
+	require.True(t, DoubleSignJailEndTime.Equal(signInfo.JailedUntil))
+	_, found = keeper.GetEvidence(ctx, evidence.Hash())
+	require.True(t, found)
+
+	// the same evidence is only handled once
+	require.Equal(t, CodeEvidenceExists, submit(evidence).Code())
+
+	// and a tombstoned validator is not slashed again for other infractions
+	require.Equal(t, CodeValidatorTombstoned, submit(duplicateVote(t, ctx.ChainID(), priv, 8, "a", "b")).Code())
+	require.Equal(t, CodeValidatorTombstoned,
+		submit(NewEquivocation(9, time.Unix(950, 0).UTC(), 20, consAddr)).Code())
+	require.Len(t, vs.slashes, 1)
+}
+
+func TestValidatorPowerHistory(t *testing.T) {
+	ctx, keeper := setupTestInput(t, &mockValidatorSet{})
+	consAddr := sdk.ConsAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	keeper.setValidatorPower(ctx, consAddr, 3, 10)
+	keeper.setValidatorPower(ctx, consAddr, 4, 10)
+	keeper.setValidatorPower(ctx, consAddr, 6, 30)
+
+	tests := []struct {
+		height int64
+		power  int64
+		found  bool
+	}{
+		{2, 0, false},
+		{3, 10, true},
+		{5, 10, true},
+		{6, 30, true},
+		{100, 30, true},
+	}
+	for _, tc := range tests {
+		power, found := keeper.getValidatorPower(ctx, consAddr, tc.height)
+		require.Equal(t, tc.found, found, "height %d", tc.height)
+		require.Equal(t, tc.power, power, "height %d", tc.height)
+	}
+
+	// unchanged powers are not stored again
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(keeper.storeKey), GetValidatorPowerPrefixKey(consAddr))
+	defer iter.Close()
+	var stored int
+	for ; iter.Valid(); iter.Next() {
+		stored++
+	}
+	require.Equal(t, 2, stored)
+}
+
+func TestMsgUnjailSignBytes(t *testing.T) {
+	// registering the evidence types must not wrap the sign bytes of MsgUnjail
+	operator := sdk.ValAddress([]byte("operator"))
+	require.Equal(t, `{"address":"`+operator.String()+`"}`, string(NewMsgUnjail(operator).GetSignBytes()))
+}
+
+func TestPruneExpiredEvidence(t *testing.T) {
+	ctx, keeper := setupTestInput(t, &mockValidatorSet{})
+	consAddr := sdk.ConsAddress(ed25519.GenPrivKey().PubKey().Address())
+	other := sdk.ConsAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	// the powers are recorded a block after the height they were signed for
+	for _, power := range []ValidatorPower{
+		NewValidatorPower(1, 10, time.Unix(700, 0).UTC()),
+		NewValidatorPower(2, 20, time.Unix(800, 0).UTC()),
+		NewValidatorPower(3, 30, time.Unix(850, 0).UTC()),
+		NewValidatorPower(5, 50, time.Unix(950, 0).UTC()),
+	} {
+		keeper.setValidatorPower(ctx.WithBlockTime(power.Time), consAddr, power.Height, power.Power)
+	}
+	keeper.setValidatorPower(ctx.WithBlockTime(time.Unix(100, 0).UTC()), other, 1, 10)
+
+	expired := NewEquivocation(3, time.Unix(850, 0).UTC(), 30, consAddr)
+	recent := NewEquivocation(4, time.Unix(900, 0).UTC(), 30, consAddr)
+	keeper.SetEvidence(ctx, expired)
+	keeper.SetEvidence(ctx, recent)
+
+	// at a block time of 1000s evidence older than the max evidence age of
+	// 120s is dropped, along with the powers only used by heights that old
+	keeper.PruneExpiredEvidence(ctx)
+	_, found := keeper.GetEvidence(ctx, expired.Hash())
+	require.False(t, found)
+	_, found = keeper.GetEvidence(ctx, recent.Hash())
+	require.True(t, found)
+
+	var heights []int64
+	keeper.iterateValidatorPowers(ctx, func(address sdk.ConsAddress, power ValidatorPower) bool {
+		if address.Equals(consAddr) {
+			heights = append(heights, power.Height)
+		}
+		return false
+	})
+	require.Equal(t, []int64{3, 5}, heights)
+	power, found := keeper.getValidatorPower(ctx, consAddr, 4)
+	require.True(t, found)
+	require.Equal(t, int64(30), power)
+
+	// the latest power of a validator is kept however old it is
+	power, found = keeper.getValidatorPower(ctx, other, 100)
+	require.True(t, found)
+	require.Equal(t, int64(10), power)
+}
+
+func TestPowerHistoryGenesis(t *testing.T) {
+	ctx, keeper := setupTestInput(t, &mockValidatorSet{})
+	consAddr := sdk.ConsAddress(ed25519.GenPrivKey().PubKey().Address())
+	keeper.setValidatorPower(ctx, consAddr, 3, 10)
+	keeper.setValidatorPower(ctx, consAddr, 6, 30)
+
+	genesis := ExportGenesis(ctx, keeper)
+	require.NoError(t, ValidateGenesis(genesis))
+	require.Equal(t, map[string][]ValidatorPower{consAddr.String(): {
+		NewValidatorPower(3, 10, ctx.BlockHeader().Time),
+		NewValidatorPower(6, 30, ctx.BlockHeader().Time),
+	}}, genesis.Powers)
+
+	// a restarted chain slashes duplicate votes with the same powers
+	ctx, keeper = setupTestInput(t, &mockValidatorSet{})
+	InitGenesis(ctx, keeper, genesis, nil)
+	power, found := keeper.getValidatorPower(ctx, consAddr, 5)
+	require.True(t, found)
+	require.Equal(t, int64(10), power)
+	require.Equal(t, genesis, ExportGenesis(ctx, keeper))
+
+	genesis.Powers["invalid"] = nil
+	require.Error(t, ValidateGenesis(genesis))
+}
@@ -12,6 +12,8 @@ type GenesisState struct {
 	Params       Params                          `json:"params"`
 	SigningInfos map[string]ValidatorSigningInfo `json:"signing_infos"`
 	MissedBlocks map[string][]MissedBlock        `json:"missed_blocks"`
+	Evidence     []Evidence                      `json:"evidence"`
+	Powers       map[string][]ValidatorPower     `json:"powers"`
 }
 
 // MissedBlock
@@ -26,6 +28,8 @@ func DefaultGenesisState() GenesisState {
 		Params:       DefaultParams(),
 		SigningInfos: make(map[string]ValidatorSigningInfo),
 		MissedBlocks: make(map[string][]MissedBlock),
+		Evidence:     []Evidence{},
+		Powers:       make(map[string][]ValidatorPower),
 	}
 }
 
@@ -61,6 +65,23 @@ func ValidateGenesis(data GenesisState) error {
 		return fmt.Errorf("Signed blocks window must be at least 10, is %d", signedWindow)
 	}
 
+	for _, evidence := range data.Evidence {
+		if err := evidence.ValidateBasic(); err != nil {
+			return fmt.Errorf("Invalid evidence %s: %s", evidence.Hash(), err.ABCILog())
+		}
+	}
+
+	for addr, powers := range data.Powers {
+		if _, err := sdk.ConsAddressFromBech32(addr); err != nil {
+			return fmt.Errorf("Invalid validator address %s in the power history: %s", addr, err)
+		}
+		for _, power := range powers {
+			if power.Height < 0 {
+				return fmt.Errorf("Invalid power history height %d of validator %s", power.Height, addr)
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -89,6 +110,20 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState, validators [
 		}
 	}
 
+	for _, evidence := range data.Evidence {
+		keeper.SetEvidence(ctx, evidence)
+	}
+
+	for addr, powers := range data.Powers {
+		address, err := sdk.ConsAddressFromBech32(addr)
+		if err != nil {
+			panic(err)
+		}
+		for _, power := range powers {
+			keeper.storeValidatorPower(ctx, address, power)
+		}
+	}
+
 	keeper.paramspace.SetParamSet(ctx, &data.Params)
 }
 
@@ -115,9 +150,24 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) (data GenesisState) {
 		return false
 	})
 
+	evidence := []Evidence{}
+	keeper.IterateEvidence(ctx, func(e Evidence) (stop bool) {
+		evidence = append(evidence, e)
+		return false
+	})
+
+	powers := make(map[string][]ValidatorPower)
+	keeper.iterateValidatorPowers(ctx, func(address sdk.ConsAddress, power ValidatorPower) (stop bool) {
+		bechAddr := address.String()
+		powers[bechAddr] = append(powers[bechAddr], power)
+		return false
+	})
+
 	return GenesisState{
 		Params:       params,
 		SigningInfos: signingInfos,
 		MissedBlocks: missedBlocks,
+		Evidence:     evidence,
+		Powers:       powers,
 	}
 }
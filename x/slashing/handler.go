@@ -11,6 +11,8 @@ func NewHandler(k Keeper) sdk.Handler {
 		switch msg := msg.(type) {
 		case MsgUnjail:
 			return handleMsgUnjail(ctx, msg, k)
+		case MsgSubmitEvidence:
+			return handleMsgSubmitEvidence(ctx, msg, k)
 		default:
 			return sdk.ErrTxDecode("invalid message parse in staking module").Result()
 		}
@@ -49,7 +51,7 @@ func handleMsgUnjail(ctx sdk.Context, msg MsgUnjail, k Keeper) sdk.Result {
 
 	// cannot be unjailed if tombstoned
 	if info.Tombstoned {
-		return ErrValidatorJailed(k.codespace).Result()
+		return ErrValidatorTombstoned(k.codespace).Result()
 	}
 
 	// cannot be unjailed until out of jail
@@ -69,3 +71,23 @@ func handleMsgUnjail(ctx sdk.Context, msg MsgUnjail, k Keeper) sdk.Result {
 		Tags: tags,
 	}
 }
+
+// Anyone can submit evidence of an infraction, which is routed to the
+// handler registered for its type
+func handleMsgSubmitEvidence(ctx sdk.Context, msg MsgSubmitEvidence, k Keeper) sdk.Result {
+	err := k.SubmitEvidence(ctx, msg.Evidence)
+	if err != nil {
+		return err.Result()
+	}
+
+	tags := sdk.NewTags(
+		tags.Action, tags.ActionEvidenceSubmitted,
+		tags.Validator, msg.Evidence.GetConsensusAddress().String(),
+		tags.Submitter, msg.Submitter.String(),
+		tags.EvidenceHash, msg.Evidence.Hash().String(),
+	)
+
+	return sdk.Result{
+		Tags: tags,
+	}
+}
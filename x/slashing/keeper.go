@@ -17,6 +17,7 @@ type Keeper struct {
 	cdc          *codec.Codec
 	validatorSet sdk.ValidatorSet
 	paramspace   params.Subspace
+	router       EvidenceRouter
 
 	// codespace
 	codespace sdk.CodespaceType
@@ -31,12 +32,18 @@ func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, vs sdk.ValidatorSet, paramspa
 		paramspace:   paramspace.WithKeyTable(ParamKeyTable()),
 		codespace:    codespace,
 	}
+
+	keeper.router = NewEvidenceRouter().
+		AddRoute(RouteEquivocation, NewEquivocationHandler(keeper)).
+		AddRoute(RouteDuplicateVote, NewDuplicateVoteHandler(keeper))
 	return keeper
 }
 
 // handle a validator signing two blocks at the same height
 // power: power of the double-signing validator at the height of infraction
-func (k Keeper) handleDoubleSign(ctx sdk.Context, addr crypto.Address, infractionHeight int64, timestamp time.Time, power int64) {
+// Once slashed for a double sign the validator is tombstoned: it is jailed
+// forever and any further evidence against it is rejected.
+func (k Keeper) handleDoubleSign(ctx sdk.Context, addr crypto.Address, infractionHeight int64, timestamp time.Time, power int64) sdk.Error {
 	logger := ctx.Logger().With("module", "x/slashing")
 
 	// calculate the age of the evidence
@@ -56,14 +63,14 @@ func (k Keeper) handleDoubleSign(ctx sdk.Context, addr crypto.Address, infractio
 		// allowable but none of the disallowed evidence types.  Instead of
 		// getting this coordination right, it is easier to relax the
 		// constraints and ignore evidence that cannot be handled.
-		return
+		return ErrNoValidatorForAddress(k.codespace)
 	}
 
 	// Reject evidence if the double-sign is too old
 	if age > k.MaxEvidenceAge(ctx) {
 		logger.Info(fmt.Sprintf("Ignored double sign from %s at height %d, age of %d past max age of %d",
 			pubkey.Address(), infractionHeight, age, k.MaxEvidenceAge(ctx)))
-		return
+		return ErrInvalidEvidence(k.codespace, fmt.Sprintf("evidence age of %s is past max age of %s", age, k.MaxEvidenceAge(ctx)))
 	}
 
 	// Get validator and signing info
@@ -72,7 +79,7 @@ func (k Keeper) handleDoubleSign(ctx sdk.Context, addr crypto.Address, infractio
 		// Defensive.
 		// Simulation doesn't take unbonding periods into account, and
 		// Tendermint might break this assumption at some point.
-		return
+		return ErrNoValidatorForAddress(k.codespace)
 	}
 
 	// fetch the validator signing info
@@ -84,7 +91,7 @@ func (k Keeper) handleDoubleSign(ctx sdk.Context, addr crypto.Address, infractio
 	// validator is already tombstoned
 	if signInfo.Tombstoned {
 		logger.Info(fmt.Sprintf("Ignored double sign from %s at height %d, validator already tombstoned", pubkey.Address(), infractionHeight))
-		return
+		return ErrValidatorTombstoned(k.codespace)
 	}
 
 	// double sign confirmed
@@ -120,6 +127,7 @@ func (k Keeper) handleDoubleSign(ctx sdk.Context, addr crypto.Address, infractio
 
 	// Set validator signing info
 	k.SetValidatorSigningInfo(ctx, consAddr, signInfo)
+	return nil
 }
 
 // handle a validator signature, must be called once per validator per block
@@ -139,6 +147,10 @@ func (k Keeper) handleValidatorSignature(ctx sdk.Context, addr crypto.Address, p
 		panic(fmt.Sprintf("Expected signing info for validator %s but not found", consAddr))
 	}
 
+	// the last commit was signed for the previous height, remember the power
+	// it was signed with to slash duplicate votes of that height
+	k.setValidatorPower(ctx, consAddr, height-1, power)
+
 	// this is a relative index, so it counts blocks the validator *should* have signed
 	// will use the 0-value default signing info if not present, except for start height
 	index := signInfo.IndexOffset % k.SignedBlocksWindow(ctx)
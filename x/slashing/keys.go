@@ -26,6 +26,8 @@ var (
 	ValidatorMissedBlockBitArrayKey = []byte{0x02} // Prefix for missed block bit array
 	ValidatorSlashingPeriodKey      = []byte{0x03} // Prefix for slashing period
 	AddrPubkeyRelationKey           = []byte{0x04} // Prefix for address-pubkey relation
+	EvidenceKey                     = []byte{0x05} // Prefix for handled evidence
+	ValidatorPowerKey               = []byte{0x06} // Prefix for the voting power history
 )
 
 // stored by *Tendermint* address (not operator address)
@@ -54,6 +56,32 @@ func GetValidatorMissedBlockBitArrayKey(v sdk.ConsAddress, i int64) []byte {
 	return append(GetValidatorMissedBlockBitArrayPrefixKey(v), b...)
 }
 
+// stored by evidence hash
+func GetEvidenceKey(hash []byte) []byte {
+	return append(EvidenceKey, hash...)
+}
+
+// stored by *Tendermint* address (not operator address)
+func GetValidatorPowerPrefixKey(v sdk.ConsAddress) []byte {
+	return append(ValidatorPowerKey, v.Bytes()...)
+}
+
+// stored by *Tendermint* address (not operator address) followed by the height
+// the power was first signed with
+func GetValidatorPowerKey(v sdk.ConsAddress, height int64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, uint64(height))
+	return append(GetValidatorPowerPrefixKey(v), b...)
+}
+
+// extract the address from a validator power key
+func GetValidatorPowerAddress(key []byte) sdk.ConsAddress {
+	if len(key) != 1+sdk.AddrLen+8 {
+		panic("unexpected key length")
+	}
+	return sdk.ConsAddress(key[1 : 1+sdk.AddrLen])
+}
+
 // stored by *Tendermint* address (not operator address)
 func GetValidatorSlashingPeriodPrefix(v sdk.ConsAddress) []byte {
 	return append(ValidatorSlashingPeriodKey, v.Bytes()...)
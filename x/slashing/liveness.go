@@ -0,0 +1,130 @@
+package slashing
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/PhenixChain/PhenixChain/types"
+)
+
+// ValidatorLiveness summarises how a validator has been signing over the
+// current signed blocks window.
+type ValidatorLiveness struct {
+	Address             sdk.ConsAddress `json:"address"`
+	Height              int64           `json:"height"`                // height the liveness was computed at
+	SignedBlocksWindow  int64           `json:"signed_blocks_window"`  // size of the sliding window
+	MinSignedPerWindow  int64           `json:"min_signed_per_window"` // blocks that must be signed per window
+	MissedBlocks        []bool          `json:"missed_blocks"`         // missed-block bitmap, oldest block first
+	MissedBlocksCounter int64           `json:"missed_blocks_counter"` // number of missed blocks in the window
+	Uptime              sdk.Dec         `json:"uptime"`                // percentage of blocks signed in the window
+	PredictedJailHeight int64           `json:"predicted_jail_height"` // 0 if the current miss rate does not lead to jailing
+}
+
+// Return human readable liveness
+func (l ValidatorLiveness) String() string {
+	var bitmap strings.Builder
+	for _, missed := range l.MissedBlocks {
+		if missed {
+			bitmap.WriteByte('x')
+		} else {
+			bitmap.WriteByte('.')
+		}
+	}
+
+	predicted := "none"
+	if l.PredictedJailHeight > 0 {
+		predicted = fmt.Sprintf("%d", l.PredictedJailHeight)
+	}
+
+	return fmt.Sprintf(`Address:               %s
+Height:                %d
+Signed Blocks Window:  %d
+Min Signed Per Window: %d
+Missed Blocks Counter: %d
+Uptime:                %s%%
+Predicted Jail Height: %s
+Missed Blocks:         %s`,
+		l.Address, l.Height, l.SignedBlocksWindow, l.MinSignedPerWindow,
+		l.MissedBlocksCounter, l.Uptime, predicted, bitmap.String())
+}
+
+// GetValidatorLiveness computes the liveness of a validator over the current
+// signed blocks window from its signing info and missed-block bit array.
+func (k Keeper) GetValidatorLiveness(ctx sdk.Context, address sdk.ConsAddress) (liveness ValidatorLiveness, found bool) {
+	signInfo, found := k.getValidatorSigningInfo(ctx, address)
+	if !found {
+		return
+	}
+
+	window := k.SignedBlocksWindow(ctx)
+	minSigned := k.MinSignedPerWindow(ctx)
+
+	missedBlocks := trackedMissedBlocks(signInfo.IndexOffset, window, func(index int64) bool {
+		return k.getValidatorMissedBlockBitArray(ctx, address, index)
+	})
+	tracked := int64(len(missedBlocks))
+
+	// a jailed validator is out of the validator set and not jailed again
+	validator := k.validatorSet.ValidatorByConsAddr(ctx, address)
+	jailed := validator != nil && validator.IsJailed()
+
+	uptime := sdk.NewDec(100)
+	if tracked > 0 {
+		uptime = sdk.NewDec(tracked - signInfo.MissedBlocksCounter).MulInt64(100).QuoInt64(tracked)
+	}
+
+	liveness = ValidatorLiveness{
+		Address:             address,
+		Height:              ctx.BlockHeight(),
+		SignedBlocksWindow:  window,
+		MinSignedPerWindow:  minSigned,
+		MissedBlocks:        missedBlocks,
+		MissedBlocksCounter: signInfo.MissedBlocksCounter,
+		Uptime:              uptime,
+		PredictedJailHeight: predictJailHeight(ctx.BlockHeight(), signInfo, jailed, tracked, window, minSigned),
+	}
+	return liveness, true
+}
+
+// trackedMissedBlocks walks the missed-block ring buffer of a validator from
+// the oldest tracked entry to the newest. Only the blocks the validator should
+// have signed since it started (or was last reset) are part of the window.
+func trackedMissedBlocks(indexOffset, window int64, missed func(index int64) bool) []bool {
+	tracked := indexOffset
+	if tracked > window {
+		tracked = window
+	}
+
+	missedBlocks := make([]bool, tracked)
+	for i := int64(0); i < tracked; i++ {
+		missedBlocks[i] = missed((indexOffset - tracked + i) % window)
+	}
+	return missedBlocks
+}
+
+// predictJailHeight estimates the height at which a validator will be jailed
+// for downtime if it keeps missing blocks at its current rate. It returns 0
+// if the validator is already jailed, is not missing blocks, or if its miss
+// rate stays under the downtime threshold once the window is full.
+func predictJailHeight(height int64, signInfo ValidatorSigningInfo, jailed bool, tracked, window, minSigned int64) int64 {
+	missed := signInfo.MissedBlocksCounter
+	maxMissed := window - minSigned
+	if jailed || missed == 0 || tracked == 0 || missed*window <= maxMissed*tracked {
+		return 0
+	}
+
+	// the validator is jailed once the counter exceeds maxMissed, at a rate of
+	// missed/tracked blocks per block
+	remaining := maxMissed - missed + 1
+	predicted := height + 1
+	if remaining > 0 {
+		predicted = height + (remaining*tracked+missed-1)/missed
+	}
+
+	// downtime is only punished once the validator is past its first window
+	minHeight := signInfo.StartHeight + window + 1
+	if predicted < minHeight {
+		predicted = minHeight
+	}
+	return predicted
+}
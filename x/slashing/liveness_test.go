@@ -0,0 +1,65 @@
+package slashing
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTrackedMissedBlocks(t *testing.T) {
+	tests := []struct {
+		name        string
+		indexOffset int64
+		missed      []int64 // indexes of the ring buffer set as missed
+		expected    []bool
+	}{
+		{"nothing tracked", 0, []int64{0}, []bool{}},
+		{"partial window", 3, []int64{1}, []bool{false, true, false}},
+		{"full window", 5, []int64{0, 4}, []bool{true, false, false, false, true}},
+		{"wrap-around", 7, []int64{0}, []bool{false, false, false, true, false}},
+		{"several wrap-arounds", 13, []int64{2, 3}, []bool{true, false, false, false, true}},
+	}
+
+	for _, tc := range tests {
+		bitArray := make(map[int64]bool)
+		for _, index := range tc.missed {
+			bitArray[index] = true
+		}
+
+		missedBlocks := trackedMissedBlocks(tc.indexOffset, 5, func(index int64) bool {
+			require.True(t, index >= 0 && index < 5, tc.name)
+			return bitArray[index]
+		})
+		require.Equal(t, tc.expected, missedBlocks, tc.name)
+	}
+}
+
+func TestPredictJailHeight(t *testing.T) {
+	// a window of 100 blocks of which 50 must be signed
+	const window, minSigned = 100, 50
+
+	tests := []struct {
+		name        string
+		height      int64
+		startHeight int64
+		jailed      bool
+		tracked     int64
+		missed      int64
+		expected    int64
+	}{
+		{"no missed blocks", 1000, 0, false, 100, 0, 0},
+		{"nothing tracked", 1000, 0, false, 0, 0, 0},
+		{"just under the threshold", 1000, 0, false, 100, 50, 0},
+		{"just over the threshold", 1000, 0, false, 100, 51, 1001},
+		{"under the rate in a partial window", 500, 400, false, 20, 10, 0},
+		{"over the rate in a partial window", 500, 400, false, 20, 15, 548},
+		{"over the rate in the first window", 20, 0, false, 20, 15, 101},
+		{"already jailed", 1000, 0, true, 100, 80, 0},
+	}
+
+	for _, tc := range tests {
+		signInfo := ValidatorSigningInfo{StartHeight: tc.startHeight, MissedBlocksCounter: tc.missed}
+		predicted := predictJailHeight(tc.height, signInfo, tc.jailed, tc.tracked, window, minSigned)
+		require.Equal(t, tc.expected, predicted, tc.name)
+	}
+}
@@ -8,7 +8,7 @@ import (
 var cdc = codec.New()
 
 // verify interface at compile time
-var _ sdk.Msg = &MsgUnjail{}
+var _, _ sdk.Msg = &MsgUnjail{}, &MsgSubmitEvidence{}
 
 // MsgUnjail - struct for unjailing jailed validator
 type MsgUnjail struct {
@@ -41,3 +41,44 @@ func (msg MsgUnjail) ValidateBasic() sdk.Error {
 	}
 	return nil
 }
+
+// MsgSubmitEvidence - struct for submitting evidence of an infraction
+type MsgSubmitEvidence struct {
+	Submitter sdk.AccAddress `json:"submitter"`
+	Evidence  Evidence       `json:"evidence"`
+}
+
+func NewMsgSubmitEvidence(submitter sdk.AccAddress, evidence Evidence) MsgSubmitEvidence {
+	return MsgSubmitEvidence{
+		Submitter: submitter,
+		Evidence:  evidence,
+	}
+}
+
+//nolint
+func (msg MsgSubmitEvidence) Route() string { return RouterKey }
+func (msg MsgSubmitEvidence) Type() string  { return "submit_evidence" }
+func (msg MsgSubmitEvidence) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Submitter}
+}
+
+// get the bytes for the message signer to sign on
+func (msg MsgSubmitEvidence) GetSignBytes() []byte {
+	bz := evidenceCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// quick validity check
+func (msg MsgSubmitEvidence) ValidateBasic() sdk.Error {
+	if msg.Submitter.Empty() {
+		return sdk.ErrInvalidAddress(msg.Submitter.String())
+	}
+	if msg.Evidence == nil {
+		return ErrInvalidEvidence(DefaultCodespace, "missing evidence")
+	}
+	// equivocations are reported by Tendermint and can't be proven by a submitter
+	if _, ok := msg.Evidence.(Equivocation); ok {
+		return ErrInvalidEvidence(DefaultCodespace, "equivocation evidence can only be reported by Tendermint")
+	}
+	return msg.Evidence.ValidateBasic()
+}
@@ -10,14 +10,30 @@ import (
 // Query endpoints supported by the slashing querier
 const (
 	QueryParameters = "parameters"
+	QueryLiveness   = "liveness"
 )
 
+// QueryLivenessParams defines the params for the following queries:
+// - 'custom/slashing/liveness'
+type QueryLivenessParams struct {
+	ConsAddress sdk.ConsAddress
+}
+
+// NewQueryLivenessParams creates a new instance of QueryLivenessParams
+func NewQueryLivenessParams(consAddr sdk.ConsAddress) QueryLivenessParams {
+	return QueryLivenessParams{
+		ConsAddress: consAddr,
+	}
+}
+
 // NewQuerier creates a new querier for slashing clients.
 func NewQuerier(k Keeper, cdc *codec.Codec) sdk.Querier {
 	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, sdk.Error) {
 		switch path[0] {
 		case QueryParameters:
 			return queryParams(ctx, cdc, k)
+		case QueryLiveness:
+			return queryLiveness(ctx, cdc, req, k)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown staking query endpoint")
 		}
@@ -34,3 +50,24 @@ func queryParams(ctx sdk.Context, cdc *codec.Codec, k Keeper) ([]byte, sdk.Error
 
 	return res, nil
 }
+
+func queryLiveness(ctx sdk.Context, cdc *codec.Codec, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params QueryLivenessParams
+
+	err := cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	liveness, found := k.GetValidatorLiveness(ctx, params.ConsAddress)
+	if !found {
+		return nil, ErrNoSigningInfoFound(k.codespace, params.ConsAddress)
+	}
+
+	res, err := codec.MarshalJSONIndent(cdc, liveness)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to marshal JSON", err.Error()))
+	}
+
+	return res, nil
+}
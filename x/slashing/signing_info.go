@@ -80,6 +80,76 @@ func (k Keeper) setValidatorMissedBlockBitArray(ctx sdk.Context, address sdk.Con
 	store.Set(GetValidatorMissedBlockBitArrayKey(address, index), bz)
 }
 
+// Stored by *validator* address (not operator address). Only changes of the
+// power are stored, the power of a height being the latest one stored before.
+func (k Keeper) setValidatorPower(ctx sdk.Context, address sdk.ConsAddress, height, power int64) {
+	if previous, found := k.getValidatorPower(ctx, address, height); found && previous == power {
+		return
+	}
+	k.storeValidatorPower(ctx, address, NewValidatorPower(height, power, ctx.BlockHeader().Time))
+}
+
+// Stored by *validator* address (not operator address)
+func (k Keeper) storeValidatorPower(ctx sdk.Context, address sdk.ConsAddress, power ValidatorPower) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(power)
+	store.Set(GetValidatorPowerKey(address, power.Height), bz)
+}
+
+// Stored by *validator* address (not operator address)
+func (k Keeper) getValidatorPower(ctx sdk.Context, address sdk.ConsAddress, height int64) (power int64, found bool) {
+	if height < 0 {
+		return 0, false
+	}
+	store := ctx.KVStore(k.storeKey)
+	iter := store.ReverseIterator(GetValidatorPowerKey(address, 0), GetValidatorPowerKey(address, height+1))
+	defer iter.Close()
+	if !iter.Valid() {
+		return 0, false
+	}
+	var record ValidatorPower
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &record)
+	return record.Power, true
+}
+
+// iterate over the power history of all validators, ordered by address and height
+func (k Keeper) iterateValidatorPowers(ctx sdk.Context,
+	handler func(address sdk.ConsAddress, power ValidatorPower) (stop bool)) {
+
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, ValidatorPowerKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		address := GetValidatorPowerAddress(iter.Key())
+		var power ValidatorPower
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &power)
+		if handler(address, power) {
+			break
+		}
+	}
+}
+
+// pruneValidatorPowers deletes the powers of heights which are all older than
+// the cutoff, i.e. the powers followed by a change recorded before it. Evidence
+// of these heights is past the max evidence age and rejected anyway.
+func (k Keeper) pruneValidatorPowers(ctx sdk.Context, cutoff time.Time) {
+	var expired [][]byte
+	var previous ValidatorPower
+	var previousAddr sdk.ConsAddress
+	k.iterateValidatorPowers(ctx, func(address sdk.ConsAddress, power ValidatorPower) bool {
+		if address.Equals(previousAddr) && power.Time.Before(cutoff) {
+			expired = append(expired, GetValidatorPowerKey(previousAddr, previous.Height))
+		}
+		previous, previousAddr = power, address
+		return false
+	})
+
+	store := ctx.KVStore(k.storeKey)
+	for _, key := range expired {
+		store.Delete(key)
+	}
+}
+
 // Stored by *validator* address (not operator address)
 func (k Keeper) clearValidatorMissedBlockBitArray(ctx sdk.Context, address sdk.ConsAddress) {
 	store := ctx.KVStore(k.storeKey)
@@ -122,3 +192,20 @@ Missed Blocks Counter: %d`,
 		i.StartHeight, i.IndexOffset, i.JailedUntil,
 		i.Tombstoned, i.MissedBlocksCounter)
 }
+
+// ValidatorPower is the voting power a validator signed blocks with from a
+// height on, along with the time of the block the power was recorded in
+type ValidatorPower struct {
+	Height int64     `json:"height"`
+	Power  int64     `json:"power"`
+	Time   time.Time `json:"time"`
+}
+
+// Construct a new `ValidatorPower` struct
+func NewValidatorPower(height, power int64, time time.Time) ValidatorPower {
+	return ValidatorPower{
+		Height: height,
+		Power:  power,
+		Time:   time,
+	}
+}
@@ -7,7 +7,10 @@ import (
 // Slashing tags
 var (
 	ActionValidatorUnjailed = "validator-unjailed"
+	ActionEvidenceSubmitted = "evidence-submitted"
 
-	Action    = sdk.TagAction
-	Validator = "validator"
+	Action       = sdk.TagAction
+	Validator    = "validator"
+	Submitter    = "submitter"
+	EvidenceHash = "evidence-hash"
 )
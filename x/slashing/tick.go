@@ -12,6 +12,9 @@ import (
 // slashing begin block functionality
 func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, sk Keeper) sdk.Tags {
 
+	// Forget the evidence and voting powers too old to slash for
+	sk.PruneExpiredEvidence(ctx)
+
 	// Iterate over all the validators which *should* have signed this block
 	// store whether or not they have actually signed it and slash/unbond any
 	// which have missed too many blocks in a row (downtime slashing)
@@ -22,12 +25,16 @@ func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, sk Keeper) sdk.Ta
 	// Iterate through any newly discovered evidence of infraction
 	// Slash any validators (and since-unbonded stake within the unbonding period)
 	// who contributed to valid infractions
+	logger := ctx.Logger().With("module", "x/slashing")
 	for _, evidence := range req.ByzantineValidators {
 		switch evidence.Type {
 		case tmtypes.ABCIEvidenceTypeDuplicateVote:
-			sk.handleDoubleSign(ctx, evidence.Validator.Address, evidence.Height, evidence.Time, evidence.Validator.Power)
+			equivocation := NewEquivocation(evidence.Height, evidence.Time, evidence.Validator.Power, sdk.ConsAddress(evidence.Validator.Address))
+			if err := sk.SubmitEvidence(ctx, equivocation); err != nil {
+				logger.Info(fmt.Sprintf("ignored evidence %s: %s", equivocation.Hash(), err.ABCILog()))
+			}
 		default:
-			ctx.Logger().With("module", "x/slashing").Error(fmt.Sprintf("ignored unknown evidence type: %s", evidence.Type))
+			logger.Error(fmt.Sprintf("ignored unknown evidence type: %s", evidence.Type))
 		}
 	}
 
@@ -36,6 +36,16 @@ type (
 	QueryValidatorParams    = querier.QueryValidatorParams
 	QueryBondsParams        = querier.QueryBondsParams
 	QueryRedelegationParams = querier.QueryRedelegationParams
+	QueryQueueParams        = querier.QueryQueueParams
+
+	QueryHistoricalInfoParams = querier.QueryHistoricalInfoParams
+	HistoricalInfo            = types.HistoricalInfo
+
+	UnbondingQueueEntry      = types.UnbondingQueueEntry
+	UnbondingQueueEntries    = types.UnbondingQueueEntries
+	RedelegationQueueEntry   = types.RedelegationQueueEntry
+	RedelegationQueueEntries = types.RedelegationQueueEntries
+	QueueTotals              = types.QueueTotals
 )
 
 var (
@@ -67,6 +77,8 @@ var (
 	UnbondingQueueKey            = keeper.UnbondingQueueKey
 	RedelegationQueueKey         = keeper.RedelegationQueueKey
 	ValidatorQueueKey            = keeper.ValidatorQueueKey
+	HistoricalInfoKey            = keeper.HistoricalInfoKey
+	GetHistoricalInfoKey         = keeper.GetHistoricalInfoKey
 	RegisterInvariants           = keeper.RegisterInvariants
 	AllInvariants                = keeper.AllInvariants
 	SupplyInvariants             = keeper.SupplyInvariants
@@ -79,6 +91,8 @@ var (
 	KeyMaxValidators  = types.KeyMaxValidators
 	KeyBondDenom      = types.KeyBondDenom
 
+	KeyHistoricalEntries = types.KeyHistoricalEntries
+
 	DefaultParams         = types.DefaultParams
 	InitialPool           = types.InitialPool
 	NewValidator          = types.NewValidator
@@ -100,6 +114,10 @@ var (
 	NewQueryDelegatorParams = querier.NewQueryDelegatorParams
 	NewQueryValidatorParams = querier.NewQueryValidatorParams
 	NewQueryBondsParams     = querier.NewQueryBondsParams
+	NewQueryQueueParams     = querier.NewQueryQueueParams
+
+	NewQueryHistoricalInfoParams = querier.NewQueryHistoricalInfoParams
+	NewHistoricalInfo            = types.NewHistoricalInfo
 )
 
 const (
@@ -117,6 +135,10 @@ const (
 	QueryDelegatorValidator            = querier.QueryDelegatorValidator
 	QueryPool                          = querier.QueryPool
 	QueryParameters                    = querier.QueryParameters
+	QueryUnbondingQueue                = querier.QueryUnbondingQueue
+	QueryRedelegationQueue             = querier.QueryRedelegationQueue
+	QueryQueueTotals                   = querier.QueryQueueTotals
+	QueryHistoricalInfo                = querier.QueryHistoricalInfo
 )
 
 const (
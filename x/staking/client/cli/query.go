@@ -2,9 +2,12 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 
 	"github.com/PhenixChain/PhenixChain/client/context"
 	"github.com/PhenixChain/PhenixChain/codec"
@@ -444,3 +447,177 @@ $ gaiacli query staking params
 		},
 	}
 }
+
+// GetCmdQueryUnbondingQueue implements the command to query the pending
+// unbonding delegation entries by completion time.
+func GetCmdQueryUnbondingQueue(storeName string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unbonding-queue",
+		Args:  cobra.NoArgs,
+		Short: "Query the pending unbonding delegations by completion time",
+		Long: strings.TrimSpace(`Query the pending unbonding delegation entries, ordered by the time at which
+their tokens are released. Entries of all delegators are listed unless --delegator is given:
+
+$ phenixcli query staking unbonding-queue --until 2019-06-01T00:00:00Z --limit 100
+$ phenixcli query staking unbonding-queue --delegator cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			params, err := queueParamsFromFlags()
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", storeName, staking.QueryUnbondingQueue)
+			bz, err := cliCtx.QueryWithData(route, cdc.MustMarshalJSON(params))
+			if err != nil {
+				return err
+			}
+
+			var entries staking.UnbondingQueueEntries
+			cdc.MustUnmarshalJSON(bz, &entries)
+			return cliCtx.PrintOutput(entries)
+		},
+	}
+
+	addQueueFlags(cmd)
+	return cmd
+}
+
+// GetCmdQueryRedelegationQueue implements the command to query the pending
+// redelegation entries by completion time.
+func GetCmdQueryRedelegationQueue(storeName string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "redelegation-queue",
+		Args:  cobra.NoArgs,
+		Short: "Query the pending redelegations by completion time",
+		Long: strings.TrimSpace(`Query the pending redelegation entries, ordered by the time at which they
+complete. Entries of all delegators are listed unless --delegator is given:
+
+$ phenixcli query staking redelegation-queue --until 2019-06-01T00:00:00Z --limit 100
+$ phenixcli query staking redelegation-queue --delegator cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			params, err := queueParamsFromFlags()
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", storeName, staking.QueryRedelegationQueue)
+			bz, err := cliCtx.QueryWithData(route, cdc.MustMarshalJSON(params))
+			if err != nil {
+				return err
+			}
+
+			var entries staking.RedelegationQueueEntries
+			cdc.MustUnmarshalJSON(bz, &entries)
+			return cliCtx.PrintOutput(entries)
+		},
+	}
+
+	addQueueFlags(cmd)
+	return cmd
+}
+
+// GetCmdQueryQueueTotals implements the command to query the totals of the
+// unbonding and redelegation queues.
+func GetCmdQueryQueueTotals(storeName string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "queue-totals",
+		Args:  cobra.NoArgs,
+		Short: "Query the totals of the pending unbonding delegations and redelegations",
+		Long: strings.TrimSpace(`Query the number of pending unbonding delegation and redelegation entries, the
+tokens they hold and when the next ones complete, for the whole chain or for one delegator:
+
+$ phenixcli query staking queue-totals
+$ phenixcli query staking queue-totals --delegator cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			params, err := queueParamsFromFlags()
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", storeName, staking.QueryQueueTotals)
+			bz, err := cliCtx.QueryWithData(route, cdc.MustMarshalJSON(params))
+			if err != nil {
+				return err
+			}
+
+			var totals staking.QueueTotals
+			cdc.MustUnmarshalJSON(bz, &totals)
+			return cliCtx.PrintOutput(totals)
+		},
+	}
+
+	cmd.Flags().String(FlagAddressDelegator, "", "only include the entries of this delegator")
+	return cmd
+}
+
+func addQueueFlags(cmd *cobra.Command) {
+	cmd.Flags().String(FlagAddressDelegator, "", "only list the entries of this delegator")
+	cmd.Flags().String(FlagUntil, "", "only list the entries completing at or before this RFC3339 time")
+	cmd.Flags().Int(FlagLimit, 0, "maximum number of entries to list, 0 for all")
+}
+
+func queueParamsFromFlags() (staking.QueryQueueParams, error) {
+	var (
+		delegatorAddr sdk.AccAddress
+		endTime       time.Time
+		err           error
+	)
+
+	if bech := viper.GetString(FlagAddressDelegator); bech != "" {
+		delegatorAddr, err = sdk.AccAddressFromBech32(bech)
+		if err != nil {
+			return staking.QueryQueueParams{}, err
+		}
+	}
+	if until := viper.GetString(FlagUntil); until != "" {
+		endTime, err = time.Parse(time.RFC3339, until)
+		if err != nil {
+			return staking.QueryQueueParams{}, err
+		}
+	}
+
+	return staking.NewQueryQueueParams(delegatorAddr, endTime, viper.GetInt(FlagLimit)), nil
+}
+
+// GetCmdQueryHistoricalInfo implements the historical info query command
+func GetCmdQueryHistoricalInfo(storeName string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "historical-info [height]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query historical info at given height",
+		Long: strings.TrimSpace(`Query the header and the bonded validator set stored for a recent height.
+Only the last historical_entries heights are kept, and only by chains mounting the
+staking module:
+
+$ phenixcli query staking historical-info 5
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			height, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil || height <= 0 {
+				return fmt.Errorf("height argument provided must be a positive integer, got %s", args[0])
+			}
+
+			bz := cdc.MustMarshalJSON(staking.NewQueryHistoricalInfoParams(height))
+			route := fmt.Sprintf("custom/%s/%s", storeName, staking.QueryHistoricalInfo)
+			res, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var hi staking.HistoricalInfo
+			cdc.MustUnmarshalJSON(res, &hi)
+			return cliCtx.PrintOutput(hi)
+		},
+	}
+}
@@ -69,12 +69,13 @@ func GetCmdEditValidator(cdc *codec.Codec) *cobra.Command {
 				WithAccountDecoder(cdc)
 
 			valAddr := cliCtx.GetFromAddress()
-			description := staking.Description{
-				Moniker:  viper.GetString(FlagMoniker),
-				Identity: viper.GetString(FlagIdentity),
-				Website:  viper.GetString(FlagWebsite),
-				Details:  viper.GetString(FlagDetails),
-			}
+			description := staking.NewDescription(
+				viper.GetString(FlagMoniker),
+				viper.GetString(FlagIdentity),
+				viper.GetString(FlagWebsite),
+				viper.GetString(FlagSecurityContact),
+				viper.GetString(FlagDetails),
+			)
 
 			var newRate *sdk.Dec
 
@@ -236,6 +237,7 @@ func BuildCreateValidatorMsg(cliCtx context.CLIContext, txBldr authtxb.TxBuilder
 		viper.GetString(FlagMoniker),
 		viper.GetString(FlagIdentity),
 		viper.GetString(FlagWebsite),
+		viper.GetString(FlagSecurityContact),
 		viper.GetString(FlagDetails),
 	)
 
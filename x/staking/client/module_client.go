@@ -38,7 +38,11 @@ func (mc ModuleClient) GetQueryCmd() *cobra.Command {
 		cli.GetCmdQueryValidatorUnbondingDelegations(mc.storeKey, mc.cdc),
 		cli.GetCmdQueryValidatorRedelegations(mc.storeKey, mc.cdc),
 		cli.GetCmdQueryParams(mc.storeKey, mc.cdc),
-		cli.GetCmdQueryPool(mc.storeKey, mc.cdc))...)
+		cli.GetCmdQueryPool(mc.storeKey, mc.cdc),
+		cli.GetCmdQueryUnbondingQueue(mc.storeKey, mc.cdc),
+		cli.GetCmdQueryRedelegationQueue(mc.storeKey, mc.cdc),
+		cli.GetCmdQueryQueueTotals(mc.storeKey, mc.cdc),
+		cli.GetCmdQueryHistoricalInfo(mc.storeKey, mc.cdc))...)
 
 	return stakingQueryCmd
 
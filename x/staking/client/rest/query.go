@@ -1,7 +1,9 @@
 package rest
 
 import (
+	"fmt"
 	"net/http"
+	"strconv"
 	"strings"
 
 	"github.com/PhenixChain/PhenixChain/client/context"
@@ -100,6 +102,30 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Co
 		paramsHandlerFn(cliCtx, cdc),
 	).Methods("GET")
 
+	// Get the header and bonded validator set of a recent height
+	r.HandleFunc(
+		"/staking/historical_info/{height}",
+		historicalInfoHandlerFn(cliCtx, cdc),
+	).Methods("GET")
+
+	// Get the pending unbonding delegations by completion time (filters in query params)
+	r.HandleFunc(
+		"/staking/unbonding_queue",
+		queueHandlerFn(cliCtx, cdc, "custom/staking/unbondingQueue"),
+	).Methods("GET")
+
+	// Get the pending redelegations by completion time (filters in query params)
+	r.HandleFunc(
+		"/staking/redelegation_queue",
+		queueHandlerFn(cliCtx, cdc, "custom/staking/redelegationQueue"),
+	).Methods("GET")
+
+	// Get the totals of the unbonding and redelegation queues (filters in query params)
+	r.HandleFunc(
+		"/staking/queue_totals",
+		queueHandlerFn(cliCtx, cdc, "custom/staking/queueTotals"),
+	).Methods("GET")
+
 }
 
 // HTTP request handler to query a delegator delegations
@@ -296,3 +322,28 @@ func paramsHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.HandlerFu
 		rest.PostProcessResponse(w, cdc, res, cliCtx.Indent)
 	}
 }
+
+// HTTP request handler to query the historical info of a height
+func historicalInfoHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		heightStr := mux.Vars(r)["height"]
+		height, err := strconv.ParseInt(heightStr, 10, 64)
+		if err != nil || height <= 0 {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("invalid height %q", heightStr))
+			return
+		}
+
+		bz, err := cdc.MarshalJSON(staking.NewQueryHistoricalInfoParams(height))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, err := cliCtx.QueryWithData("custom/staking/historicalInfo", bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		rest.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+	}
+}
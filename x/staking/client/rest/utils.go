@@ -3,6 +3,8 @@ package rest
 import (
 	"fmt"
 	"net/http"
+	"strconv"
+	"time"
 
 	"github.com/gorilla/mux"
 
@@ -34,7 +36,11 @@ func queryTxs(cliCtx context.CLIContext, cdc *codec.Codec, tag string, delegator
 		fmt.Sprintf("%s='%s'", tags.Delegator, delegatorAddr),
 	}
 
-	return tx.SearchTxs(cliCtx, cdc, tags, page, limit)
+	res, err := tx.SearchTxs(cliCtx, cdc, tags, page, limit)
+	if err != nil {
+		return nil, err
+	}
+	return res.Txs, nil
 }
 
 func queryBonds(cliCtx context.CLIContext, cdc *codec.Codec, endpoint string) http.HandlerFunc {
@@ -122,3 +128,53 @@ func queryValidator(cliCtx context.CLIContext, cdc *codec.Codec, endpoint string
 		rest.PostProcessResponse(w, cdc, res, cliCtx.Indent)
 	}
 }
+
+func queueHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec, endpoint string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var params staking.QueryQueueParams
+
+		bechDelegatorAddr := r.URL.Query().Get("delegator")
+		until := r.URL.Query().Get("until")
+		limit := r.URL.Query().Get("limit")
+
+		if len(bechDelegatorAddr) != 0 {
+			delegatorAddr, err := sdk.AccAddressFromBech32(bechDelegatorAddr)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+			params.DelegatorAddr = delegatorAddr
+		}
+
+		if len(until) != 0 {
+			endTime, err := time.Parse(time.RFC3339, until)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+			params.EndTime = endTime
+		}
+
+		if len(limit) != 0 {
+			n, err := strconv.Atoi(limit)
+			if err != nil || n < 0 {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("invalid limit %q", limit))
+				return
+			}
+			params.Limit = n
+		}
+
+		bz, err := cdc.MarshalJSON(params)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, err := cliCtx.QueryWithData(endpoint, bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		rest.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+	}
+}
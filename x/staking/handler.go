@@ -33,6 +33,15 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 	}
 }
 
+// BeginBlocker will persist the current header and validator set as a
+// historical entry and prune the oldest entry based on the HistoricalEntries
+// parameter. Historical info is only recorded by applications mounting staking
+// and calling it from their BeginBlocker; the phenix app mounts neither, so
+// its historical info queries find nothing.
+func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
+	k.TrackHistoricalInfo(ctx)
+}
+
 // Called every block, update validator set
 func EndBlocker(ctx sdk.Context, k keeper.Keeper) ([]abci.ValidatorUpdate, sdk.Tags) {
 	resTags := sdk.NewTags()
@@ -83,6 +92,7 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) ([]abci.ValidatorUpdate, sdk.T
 		))
 	}
 
+	k.RecordBondedRatio(ctx)
 	return validatorUpdates, resTags
 }
 
@@ -110,6 +110,7 @@ func (k Keeper) InflateSupply(ctx sdk.Context, newTokens sdk.Int) {
 	pool := k.GetPool(ctx)
 	pool.NotBondedTokens = pool.NotBondedTokens.Add(newTokens)
 	k.SetPool(ctx, pool)
+	k.bankKeeper.InflateSupply(ctx, sdk.NewCoins(sdk.NewCoin(k.BondDenom(ctx), newTokens)))
 }
 
 // Implements DelegationSet
@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	sdk "github.com/PhenixChain/PhenixChain/types"
+	"github.com/PhenixChain/PhenixChain/x/staking/types"
+)
+
+// GetHistoricalInfo gets the historical info at a given height
+func (k Keeper) GetHistoricalInfo(ctx sdk.Context, height int64) (hi types.HistoricalInfo, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	value := store.Get(GetHistoricalInfoKey(height))
+	if value == nil {
+		return hi, false
+	}
+
+	hi = types.MustUnmarshalHistoricalInfo(k.cdc, value)
+	return hi, true
+}
+
+// SetHistoricalInfo sets the historical info at a given height
+func (k Keeper) SetHistoricalInfo(ctx sdk.Context, height int64, hi types.HistoricalInfo) {
+	store := ctx.KVStore(k.storeKey)
+	value := types.MustMarshalHistoricalInfo(k.cdc, hi)
+	store.Set(GetHistoricalInfoKey(height), value)
+}
+
+// DeleteHistoricalInfo deletes the historical info at a given height
+func (k Keeper) DeleteHistoricalInfo(ctx sdk.Context, height int64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(GetHistoricalInfoKey(height))
+}
+
+// TrackHistoricalInfo saves the latest historical info and deletes the
+// historical info which is older than the number of historical entries. If
+// the number of historical entries is lowered, the extra entries are deleted
+// in the same block.
+func (k Keeper) TrackHistoricalInfo(ctx sdk.Context) {
+	entries := int64(k.HistoricalEntries(ctx))
+
+	// prune the entries up to height - entries, inclusive
+	if pruneEnd := ctx.BlockHeight() - entries + 1; pruneEnd > 0 {
+		store := ctx.KVStore(k.storeKey)
+		iterator := store.Iterator(HistoricalInfoKey, GetHistoricalInfoKey(pruneEnd))
+		defer iterator.Close()
+		for ; iterator.Valid(); iterator.Next() {
+			store.Delete(iterator.Key())
+		}
+	}
+
+	// nothing to save if historical entries are disabled
+	if entries == 0 {
+		return
+	}
+
+	lastVals := k.GetLastValidators(ctx)
+	hi := types.NewHistoricalInfo(ctx.BlockHeader(), lastVals)
+	k.SetHistoricalInfo(ctx, ctx.BlockHeight(), hi)
+}
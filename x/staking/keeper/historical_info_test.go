@@ -0,0 +1,67 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
+
+	sdk "github.com/PhenixChain/PhenixChain/types"
+	"github.com/PhenixChain/PhenixChain/x/staking/types"
+)
+
+func TestTrackHistoricalInfo(t *testing.T) {
+	ctx, _, _, k := CreateTestInput(t)
+
+	params := k.GetParams(ctx)
+	params.HistoricalEntries = 3
+	k.SetParams(ctx, params)
+
+	for i, valAddr := range []sdk.ValAddress{sdk.ValAddress(addr1), sdk.ValAddress(addr2)} {
+		val := types.NewValidator(valAddr, []crypto.PubKey{pk1, pk2}[i], types.Description{})
+		val.Status = sdk.Bonded
+		val.Tokens = sdk.TokensFromTendermintPower(int64(10 * (i + 1)))
+		val.DelegatorShares = val.Tokens.ToDec()
+		k.SetValidator(ctx, val)
+		k.SetLastValidatorPower(ctx, val.OperatorAddress, val.GetTendermintPower())
+	}
+
+	track := func(height int64) {
+		header := abci.Header{ChainID: "test-chain-id", Height: height}
+		k.TrackHistoricalInfo(ctx.WithBlockHeader(header).WithBlockHeight(height))
+	}
+	stored := func() (heights []int64) {
+		for height := int64(1); height <= 10; height++ {
+			if _, found := k.GetHistoricalInfo(ctx, height); found {
+				heights = append(heights, height)
+			}
+		}
+		return heights
+	}
+
+	for height := int64(1); height <= 5; height++ {
+		track(height)
+	}
+
+	// only the last entries are kept
+	require.Equal(t, []int64{3, 4, 5}, stored())
+
+	hi, found := k.GetHistoricalInfo(ctx, 5)
+	require.True(t, found)
+	require.Equal(t, int64(5), hi.Header.Height)
+	require.Len(t, hi.ValSet, 2)
+	require.Equal(t, sdk.ValAddress(addr2), hi.ValSet[0].OperatorAddress)
+
+	// lowering the number of entries prunes the extra ones in the same block
+	params.HistoricalEntries = 1
+	k.SetParams(ctx, params)
+	track(6)
+	require.Equal(t, []int64{6}, stored())
+
+	// and disabling them deletes them all
+	params.HistoricalEntries = 0
+	k.SetParams(ctx, params)
+	track(7)
+	require.Empty(t, stored())
+}
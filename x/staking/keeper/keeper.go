@@ -2,8 +2,10 @@ package keeper
 
 import (
 	"container/list"
+	"strconv"
 
 	"github.com/PhenixChain/PhenixChain/codec"
+	"github.com/PhenixChain/PhenixChain/telemetry"
 	sdk "github.com/PhenixChain/PhenixChain/types"
 
 	"github.com/PhenixChain/PhenixChain/x/params"
@@ -22,6 +24,7 @@ type Keeper struct {
 	paramstore         params.Subspace
 	validatorCache     map[string]cachedValidator
 	validatorCacheList *list.List
+	metrics            *telemetry.Metrics
 
 	// codespace
 	codespace sdk.CodespaceType
@@ -39,11 +42,28 @@ func NewKeeper(cdc *codec.Codec, key, tkey sdk.StoreKey, bk types.BankKeeper,
 		hooks:              nil,
 		validatorCache:     make(map[string]cachedValidator, aminoCacheSize),
 		validatorCacheList: list.New(),
+		metrics:            telemetry.NopMetrics(),
 		codespace:          codespace,
 	}
 	return keeper
 }
 
+// WithMetrics returns a copy of the keeper reporting the bonded ratio to the
+// given metrics
+func (k Keeper) WithMetrics(metrics *telemetry.Metrics) Keeper {
+	k.metrics = metrics
+	return k
+}
+
+// RecordBondedRatio reports the current bonded ratio to the metrics
+func (k Keeper) RecordBondedRatio(ctx sdk.Context) {
+	ratio, err := strconv.ParseFloat(k.BondedRatio(ctx).String(), 64)
+	if err != nil {
+		panic(err)
+	}
+	k.metrics.StakingBondedRatio.Set(ratio)
+}
+
 // Set the validator hooks
 func (k *Keeper) SetHooks(sh sdk.StakingHooks) *Keeper {
 	if k.hooks != nil {
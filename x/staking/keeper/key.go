@@ -33,6 +33,8 @@ var (
 	UnbondingQueueKey    = []byte{0x41} // prefix for the timestamps in unbonding queue
 	RedelegationQueueKey = []byte{0x42} // prefix for the timestamps in redelegations queue
 	ValidatorQueueKey    = []byte{0x43} // prefix for the timestamps in validator queue
+
+	HistoricalInfoKey = []byte{0x50} // prefix for the historical info of a height
 )
 
 // gets the key for the validator with address
@@ -280,3 +282,11 @@ func cp(bz []byte) (ret []byte) {
 	copy(ret, bz)
 	return ret
 }
+
+// gets the key for the historical info of a height, heights are big endian so
+// that the historical info is sorted by height
+func GetHistoricalInfoKey(height int64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(height))
+	return append(HistoricalInfoKey, bz...)
+}
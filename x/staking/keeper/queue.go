@@ -0,0 +1,206 @@
+package keeper
+
+import (
+	"sort"
+	"time"
+
+	sdk "github.com/PhenixChain/PhenixChain/types"
+	"github.com/PhenixChain/PhenixChain/x/staking/types"
+)
+
+// iterate over the pending unbonding delegation entries of all delegators by
+// completion time, following the unbonding queue
+func (k Keeper) IterateUBDQueueEntries(ctx sdk.Context, handler func(entry types.UnbondingQueueEntry) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, UnbondingQueueKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		completionTime, err := sdk.ParseTimeBytes(iterator.Key()[len(UnbondingQueueKey):])
+		if err != nil {
+			panic(err)
+		}
+
+		var timeslice []types.DVPair
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &timeslice)
+		for i, dvPair := range timeslice {
+			// a pair is queued once per entry, only visit its entries once
+			if containsDVPair(timeslice[:i], dvPair) {
+				continue
+			}
+			ubd, found := k.GetUnbondingDelegation(ctx, dvPair.DelegatorAddress, dvPair.ValidatorAddress)
+			if !found {
+				continue
+			}
+			for _, entry := range ubd.Entries {
+				if !entry.CompletionTime.Equal(completionTime) {
+					continue
+				}
+				if handler(types.NewUnbondingQueueEntry(ubd.DelegatorAddress, ubd.ValidatorAddress, entry)) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// iterate over the pending redelegation entries of all delegators by
+// completion time, following the redelegation queue
+func (k Keeper) IterateRedelegationQueueEntries(ctx sdk.Context, handler func(entry types.RedelegationQueueEntry) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, RedelegationQueueKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		completionTime, err := sdk.ParseTimeBytes(iterator.Key()[len(RedelegationQueueKey):])
+		if err != nil {
+			panic(err)
+		}
+
+		var timeslice []types.DVVTriplet
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &timeslice)
+		for i, dvvTriplet := range timeslice {
+			// a triplet is queued once per entry, only visit its entries once
+			if containsDVVTriplet(timeslice[:i], dvvTriplet) {
+				continue
+			}
+			red, found := k.GetRedelegation(ctx, dvvTriplet.DelegatorAddress,
+				dvvTriplet.ValidatorSrcAddress, dvvTriplet.ValidatorDstAddress)
+			if !found {
+				continue
+			}
+			for _, entry := range red.Entries {
+				if !entry.CompletionTime.Equal(completionTime) {
+					continue
+				}
+				queueEntry := types.NewRedelegationQueueEntry(red.DelegatorAddress,
+					red.ValidatorSrcAddress, red.ValidatorDstAddress, entry)
+				if handler(queueEntry) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// return the pending unbonding delegation entries of a delegator by
+// completion time, up to limit entries maturing at or before endTime. A zero
+// endTime or limit does not restrict the entries.
+func (k Keeper) GetDelegatorUBDQueueEntries(ctx sdk.Context, delegator sdk.AccAddress,
+	endTime time.Time, limit int) types.UnbondingQueueEntries {
+
+	entries := types.UnbondingQueueEntries{}
+	for _, ubd := range k.GetAllUnbondingDelegations(ctx, delegator) {
+		for _, entry := range ubd.Entries {
+			if !endTime.IsZero() && entry.CompletionTime.After(endTime) {
+				continue
+			}
+			entries = append(entries, types.NewUnbondingQueueEntry(ubd.DelegatorAddress, ubd.ValidatorAddress, entry))
+		}
+	}
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].CompletionTime.Before(entries[j].CompletionTime)
+	})
+	if limit > 0 && len(entries) > limit {
+		entries = entries[:limit]
+	}
+	return entries
+}
+
+// return the pending redelegation entries of a delegator by completion time,
+// up to limit entries maturing at or before endTime. A zero endTime or limit
+// does not restrict the entries.
+func (k Keeper) GetDelegatorRedelegationQueueEntries(ctx sdk.Context, delegator sdk.AccAddress,
+	endTime time.Time, limit int) types.RedelegationQueueEntries {
+
+	entries := types.RedelegationQueueEntries{}
+	for _, red := range k.GetAllRedelegations(ctx, delegator, nil, nil) {
+		for _, entry := range red.Entries {
+			if !endTime.IsZero() && entry.CompletionTime.After(endTime) {
+				continue
+			}
+			entries = append(entries, types.NewRedelegationQueueEntry(red.DelegatorAddress,
+				red.ValidatorSrcAddress, red.ValidatorDstAddress, entry))
+		}
+	}
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].CompletionTime.Before(entries[j].CompletionTime)
+	})
+	if limit > 0 && len(entries) > limit {
+		entries = entries[:limit]
+	}
+	return entries
+}
+
+// return the totals of the pending unbonding and redelegation entries of a
+// delegator, or of all delegators if the delegator is empty
+func (k Keeper) GetQueueTotals(ctx sdk.Context, delegator sdk.AccAddress) types.QueueTotals {
+	totals := types.NewQueueTotals()
+	if !delegator.Empty() {
+		for _, entry := range k.GetDelegatorUBDQueueEntries(ctx, delegator, time.Time{}, 0) {
+			totals = totals.AddUnbonding(entry)
+		}
+		for _, entry := range k.GetDelegatorRedelegationQueueEntries(ctx, delegator, time.Time{}, 0) {
+			totals = totals.AddRedelegation(entry)
+		}
+		return totals
+	}
+
+	k.IterateUBDQueueEntries(ctx, func(entry types.UnbondingQueueEntry) (stop bool) {
+		totals = totals.AddUnbonding(entry)
+		return false
+	})
+	k.IterateRedelegationQueueEntries(ctx, func(entry types.RedelegationQueueEntry) (stop bool) {
+		totals = totals.AddRedelegation(entry)
+		return false
+	})
+	return totals
+}
+
+// return the unbonding delegation entries maturing at or before a time, of
+// all delegators
+func (k Keeper) GetUBDQueueEntriesUntil(ctx sdk.Context, endTime time.Time, limit int) types.UnbondingQueueEntries {
+	entries := types.UnbondingQueueEntries{}
+	k.IterateUBDQueueEntries(ctx, func(entry types.UnbondingQueueEntry) (stop bool) {
+		if !endTime.IsZero() && entry.CompletionTime.After(endTime) {
+			return true
+		}
+		entries = append(entries, entry)
+		return limit > 0 && len(entries) >= limit
+	})
+	return entries
+}
+
+// return the redelegation entries maturing at or before a time, of all
+// delegators
+func (k Keeper) GetRedelegationQueueEntriesUntil(ctx sdk.Context, endTime time.Time, limit int) types.RedelegationQueueEntries {
+	entries := types.RedelegationQueueEntries{}
+	k.IterateRedelegationQueueEntries(ctx, func(entry types.RedelegationQueueEntry) (stop bool) {
+		if !endTime.IsZero() && entry.CompletionTime.After(endTime) {
+			return true
+		}
+		entries = append(entries, entry)
+		return limit > 0 && len(entries) >= limit
+	})
+	return entries
+}
+
+func containsDVPair(pairs []types.DVPair, pair types.DVPair) bool {
+	for _, p := range pairs {
+		if p.DelegatorAddress.Equals(pair.DelegatorAddress) && p.ValidatorAddress.Equals(pair.ValidatorAddress) {
+			return true
+		}
+	}
+	return false
+}
+
+func containsDVVTriplet(triplets []types.DVVTriplet, triplet types.DVVTriplet) bool {
+	for _, t := range triplets {
+		if t.DelegatorAddress.Equals(triplet.DelegatorAddress) &&
+			t.ValidatorSrcAddress.Equals(triplet.ValidatorSrcAddress) &&
+			t.ValidatorDstAddress.Equals(triplet.ValidatorDstAddress) {
+			return true
+		}
+	}
+	return false
+}
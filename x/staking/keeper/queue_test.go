@@ -0,0 +1,75 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/PhenixChain/PhenixChain/types"
+	"github.com/PhenixChain/PhenixChain/x/staking/types"
+)
+
+// setQueueEntries queues unbonding and redelegation entries of two delegators
+// completing at the given times, with balances 1, 2, ... in that order
+func setQueueEntries(ctx sdk.Context, k Keeper, delAddrs []sdk.AccAddress, times []time.Time) {
+	valSrc, valDst := sdk.ValAddress(addr1), sdk.ValAddress(addr2)
+	for i, completionTime := range times {
+		delAddr := delAddrs[i%len(delAddrs)]
+		balance := sdk.NewInt(int64(i + 1))
+		ubd := k.SetUnbondingDelegationEntry(ctx, delAddr, valSrc, int64(i), completionTime, balance)
+		k.InsertUBDQueue(ctx, ubd, completionTime)
+		red := k.SetRedelegationEntry(ctx, delAddr, valSrc, valDst, int64(i), completionTime,
+			balance, balance.ToDec(), balance.ToDec())
+		k.InsertRedelegationQueue(ctx, red, completionTime)
+	}
+}
+
+func TestQueueEntries(t *testing.T) {
+	ctx, _, _, k := CreateTestInput(t)
+
+	delAddr1, delAddr2 := sdk.AccAddress(addr1), sdk.AccAddress(addr2)
+	start := time.Date(2019, 6, 1, 0, 0, 0, 0, time.UTC)
+	day := 24 * time.Hour
+	// queued out of order, delegator 1 has the first and third entries, and
+	// the third and fourth complete at the same time
+	times := []time.Time{start.Add(3 * day), start.Add(day), start.Add(2 * day), start.Add(2 * day)}
+	setQueueEntries(ctx, k, []sdk.AccAddress{delAddr1, delAddr2}, times)
+
+	ubdBalances := func(entries types.UnbondingQueueEntries) (balances []int64) {
+		for _, entry := range entries {
+			balances = append(balances, entry.Balance.Int64())
+		}
+		return balances
+	}
+	redBalances := func(entries types.RedelegationQueueEntries) (balances []int64) {
+		for _, entry := range entries {
+			balances = append(balances, entry.InitialBalance.Int64())
+		}
+		return balances
+	}
+
+	// all delegators by completion time, each entry once
+	require.Equal(t, []int64{2, 3, 4, 1}, ubdBalances(k.GetUBDQueueEntriesUntil(ctx, time.Time{}, 0)))
+	require.Equal(t, []int64{2, 3, 4, 1}, redBalances(k.GetRedelegationQueueEntriesUntil(ctx, time.Time{}, 0)))
+	require.Equal(t, []int64{2, 3, 4}, ubdBalances(k.GetUBDQueueEntriesUntil(ctx, start.Add(2*day), 0)))
+	require.Equal(t, []int64{2, 3}, redBalances(k.GetRedelegationQueueEntriesUntil(ctx, time.Time{}, 2)))
+
+	// one delegator, with the same filters
+	require.Equal(t, []int64{3, 1}, ubdBalances(k.GetDelegatorUBDQueueEntries(ctx, delAddr1, time.Time{}, 0)))
+	require.Equal(t, []int64{3}, ubdBalances(k.GetDelegatorUBDQueueEntries(ctx, delAddr1, start.Add(2*day), 0)))
+	require.Equal(t, []int64{2}, redBalances(k.GetDelegatorRedelegationQueueEntries(ctx, delAddr2, time.Time{}, 1)))
+	require.Empty(t, k.GetDelegatorUBDQueueEntries(ctx, delAddr2, start, 0))
+
+	// totals of the whole chain and of a delegator
+	totals := k.GetQueueTotals(ctx, nil)
+	require.Equal(t, int64(4), totals.UnbondingEntries)
+	require.Equal(t, sdk.NewInt(10), totals.UnbondingBalance)
+	require.True(t, start.Add(day).Equal(totals.NextUnbondingTime))
+	require.Equal(t, int64(4), totals.RedelegationEntries)
+
+	totals = k.GetQueueTotals(ctx, delAddr1)
+	require.Equal(t, int64(2), totals.UnbondingEntries)
+	require.Equal(t, sdk.NewInt(4), totals.RedelegationBalance)
+	require.True(t, start.Add(2*day).Equal(totals.NextRedelegationTime))
+}
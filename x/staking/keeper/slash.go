@@ -119,10 +119,8 @@ func (k Keeper) Slash(ctx sdk.Context, consAddr sdk.ConsAddress, infractionHeigh
 	// The deducted tokens are returned to pool.NotBondedTokens.
 	// TODO: Move the token accounting outside of `RemoveValidatorTokens` so it is less confusing
 	validator = k.RemoveValidatorTokens(ctx, validator, tokensToBurn)
-	pool := k.GetPool(ctx)
 	// Burn the slashed tokens, which are now loose.
-	pool.NotBondedTokens = pool.NotBondedTokens.Sub(tokensToBurn)
-	k.SetPool(ctx, pool)
+	k.burnNotBondedTokens(ctx, tokensToBurn)
 
 	// Log that a slash occurred!
 	logger.Info(fmt.Sprintf(
@@ -195,12 +193,10 @@ func (k Keeper) slashUnbondingDelegation(ctx sdk.Context, unbondingDelegation ty
 		entry.Balance = entry.Balance.Sub(unbondingSlashAmount)
 		unbondingDelegation.Entries[i] = entry
 		k.SetUnbondingDelegation(ctx, unbondingDelegation)
-		pool := k.GetPool(ctx)
 
 		// Burn not-bonded tokens
 		// Ref https://github.com/cosmos/cosmos-sdk/pull/1278#discussion_r198657760
-		pool.NotBondedTokens = pool.NotBondedTokens.Sub(unbondingSlashAmount)
-		k.SetPool(ctx, pool)
+		k.burnNotBondedTokens(ctx, unbondingSlashAmount)
 	}
 
 	return totalSlashAmount
@@ -256,10 +252,16 @@ func (k Keeper) slashRedelegation(ctx sdk.Context, validator types.Validator, re
 		}
 
 		// Burn not-bonded tokens
-		pool := k.GetPool(ctx)
-		pool.NotBondedTokens = pool.NotBondedTokens.Sub(tokensToBurn)
-		k.SetPool(ctx, pool)
+		k.burnNotBondedTokens(ctx, tokensToBurn)
 	}
 
 	return totalSlashAmount
 }
+
+// burn not-bonded tokens, removing them from the pool and the total supply
+func (k Keeper) burnNotBondedTokens(ctx sdk.Context, amt sdk.Int) {
+	pool := k.GetPool(ctx)
+	pool.NotBondedTokens = pool.NotBondedTokens.Sub(amt)
+	k.SetPool(ctx, pool)
+	k.bankKeeper.DeflateSupply(ctx, sdk.NewCoins(sdk.NewCoin(k.BondDenom(ctx), amt)))
+}
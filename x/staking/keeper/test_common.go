@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	dbm "github.com/tendermint/tendermint/libs/db"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/PhenixChain/PhenixChain/codec"
+	"github.com/PhenixChain/PhenixChain/store"
+	sdk "github.com/PhenixChain/PhenixChain/types"
+	"github.com/PhenixChain/PhenixChain/x/auth"
+	"github.com/PhenixChain/PhenixChain/x/bank"
+	"github.com/PhenixChain/PhenixChain/x/params"
+	"github.com/PhenixChain/PhenixChain/x/staking/types"
+)
+
+// CreateTestInput returns a context on a new in-memory store along with the
+// account, bank and staking keepers using it. Staking holds its default
+// params and an initial pool.
+func CreateTestInput(t *testing.T) (sdk.Context, auth.AccountKeeper, bank.BaseKeeper, Keeper) {
+	db := dbm.NewMemDB()
+
+	cdc := codec.New()
+	auth.RegisterBaseAccount(cdc)
+	types.RegisterCodec(cdc)
+
+	keyAcc := sdk.NewKVStoreKey(auth.StoreKey)
+	keyAddress := sdk.NewKVStoreKey(auth.StoreAdrKey)
+	keyBank := sdk.NewKVStoreKey(bank.StoreKey)
+	keyParams := sdk.NewKVStoreKey(params.StoreKey)
+	tkeyParams := sdk.NewTransientStoreKey(params.TStoreKey)
+	keyStaking := sdk.NewKVStoreKey(types.StoreKey)
+	tkeyStaking := sdk.NewTransientStoreKey(types.TStoreKey)
+
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(keyAcc, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyAddress, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyBank, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(tkeyParams, sdk.StoreTypeTransient, db)
+	ms.MountStoreWithDB(keyStaking, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(tkeyStaking, sdk.StoreTypeTransient, db)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	pk := params.NewKeeper(cdc, keyParams, tkeyParams)
+	ak := auth.NewAccountKeeper(cdc, keyAcc, pk.Subspace(auth.DefaultParamspace), auth.ProtoBaseAccount)
+	bk := bank.NewBaseKeeper(ak, bank.NewTxKeeper(cdc, keyAddress), keyBank, pk.Subspace(bank.DefaultParamspace), bank.DefaultCodespace)
+	k := NewKeeper(cdc, keyStaking, tkeyStaking, bk, pk.Subspace(DefaultParamspace), types.DefaultCodespace)
+
+	ctx := sdk.NewContext(ms, abci.Header{ChainID: "test-chain-id"}, false, log.NewNopLogger())
+	k.SetParams(ctx, types.DefaultParams())
+	k.SetPool(ctx, types.InitialPool())
+
+	return ctx, ak, bk, k
+}
@@ -2,6 +2,7 @@ package querier
 
 import (
 	"fmt"
+	"time"
 
 	abci "github.com/tendermint/tendermint/abci/types"
 
@@ -28,6 +29,10 @@ const (
 	QueryDelegatorValidator            = "delegatorValidator"
 	QueryPool                          = "pool"
 	QueryParameters                    = "parameters"
+	QueryUnbondingQueue                = "unbondingQueue"
+	QueryRedelegationQueue             = "redelegationQueue"
+	QueryQueueTotals                   = "queueTotals"
+	QueryHistoricalInfo                = "historicalInfo"
 )
 
 // creates a querier for staking REST endpoints
@@ -60,6 +65,14 @@ func NewQuerier(k keep.Keeper, cdc *codec.Codec) sdk.Querier {
 			return queryPool(ctx, cdc, k)
 		case QueryParameters:
 			return queryParameters(ctx, cdc, k)
+		case QueryUnbondingQueue:
+			return queryUnbondingQueue(ctx, cdc, req, k)
+		case QueryRedelegationQueue:
+			return queryRedelegationQueue(ctx, cdc, req, k)
+		case QueryQueueTotals:
+			return queryQueueTotals(ctx, cdc, req, k)
+		case QueryHistoricalInfo:
+			return queryHistoricalInfo(ctx, cdc, req, k)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown staking query endpoint")
 		}
@@ -128,6 +141,39 @@ func NewQueryRedelegationParams(delegatorAddr sdk.AccAddress, srcValidatorAddr s
 	}
 }
 
+// defines the params for the following queries:
+// - 'custom/staking/unbondingQueue'
+// - 'custom/staking/redelegationQueue'
+// - 'custom/staking/queueTotals'
+// An empty delegator covers all delegators, a zero end time and a zero limit
+// are unbounded. The end time and limit don't apply to a single delegator or
+// to the totals.
+type QueryQueueParams struct {
+	DelegatorAddr sdk.AccAddress
+	EndTime       time.Time
+	Limit         int
+}
+
+func NewQueryQueueParams(delegatorAddr sdk.AccAddress, endTime time.Time, limit int) QueryQueueParams {
+	return QueryQueueParams{
+		DelegatorAddr: delegatorAddr,
+		EndTime:       endTime,
+		Limit:         limit,
+	}
+}
+
+// defines the params for the following queries:
+// - 'custom/staking/historicalInfo'
+type QueryHistoricalInfoParams struct {
+	Height int64
+}
+
+func NewQueryHistoricalInfoParams(height int64) QueryHistoricalInfoParams {
+	return QueryHistoricalInfoParams{
+		Height: height,
+	}
+}
+
 func queryValidators(ctx sdk.Context, cdc *codec.Codec, k keep.Keeper) (res []byte, err sdk.Error) {
 	stakingParams := k.GetParams(ctx)
 	validators := k.GetValidators(ctx, stakingParams.MaxValidators)
@@ -354,3 +400,84 @@ func queryParameters(ctx sdk.Context, cdc *codec.Codec, k keep.Keeper) (res []by
 	}
 	return res, nil
 }
+
+func queryUnbondingQueue(ctx sdk.Context, cdc *codec.Codec, req abci.RequestQuery, k keep.Keeper) (res []byte, err sdk.Error) {
+	var params QueryQueueParams
+
+	errRes := cdc.UnmarshalJSON(req.Data, &params)
+	if errRes != nil {
+		return []byte{}, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", errRes.Error()))
+	}
+
+	var entries types.UnbondingQueueEntries
+	if params.DelegatorAddr.Empty() {
+		entries = k.GetUBDQueueEntriesUntil(ctx, params.EndTime, params.Limit)
+	} else {
+		entries = k.GetDelegatorUBDQueueEntries(ctx, params.DelegatorAddr, params.EndTime, params.Limit)
+	}
+
+	res, errRes = codec.MarshalJSONIndent(cdc, entries)
+	if errRes != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", errRes.Error()))
+	}
+	return res, nil
+}
+
+func queryRedelegationQueue(ctx sdk.Context, cdc *codec.Codec, req abci.RequestQuery, k keep.Keeper) (res []byte, err sdk.Error) {
+	var params QueryQueueParams
+
+	errRes := cdc.UnmarshalJSON(req.Data, &params)
+	if errRes != nil {
+		return []byte{}, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", errRes.Error()))
+	}
+
+	var entries types.RedelegationQueueEntries
+	if params.DelegatorAddr.Empty() {
+		entries = k.GetRedelegationQueueEntriesUntil(ctx, params.EndTime, params.Limit)
+	} else {
+		entries = k.GetDelegatorRedelegationQueueEntries(ctx, params.DelegatorAddr, params.EndTime, params.Limit)
+	}
+
+	res, errRes = codec.MarshalJSONIndent(cdc, entries)
+	if errRes != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", errRes.Error()))
+	}
+	return res, nil
+}
+
+func queryQueueTotals(ctx sdk.Context, cdc *codec.Codec, req abci.RequestQuery, k keep.Keeper) (res []byte, err sdk.Error) {
+	var params QueryQueueParams
+
+	errRes := cdc.UnmarshalJSON(req.Data, &params)
+	if errRes != nil {
+		return []byte{}, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", errRes.Error()))
+	}
+
+	totals := k.GetQueueTotals(ctx, params.DelegatorAddr)
+
+	res, errRes = codec.MarshalJSONIndent(cdc, totals)
+	if errRes != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", errRes.Error()))
+	}
+	return res, nil
+}
+
+func queryHistoricalInfo(ctx sdk.Context, cdc *codec.Codec, req abci.RequestQuery, k keep.Keeper) (res []byte, err sdk.Error) {
+	var params QueryHistoricalInfoParams
+
+	errRes := cdc.UnmarshalJSON(req.Data, &params)
+	if errRes != nil {
+		return []byte{}, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", errRes.Error()))
+	}
+
+	hi, found := k.GetHistoricalInfo(ctx, params.Height)
+	if !found {
+		return []byte{}, types.ErrNoHistoricalInfo(types.DefaultCodespace)
+	}
+
+	res, errRes = codec.MarshalJSONIndent(cdc, hi)
+	if errRes != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", errRes.Error()))
+	}
+	return res, nil
+}
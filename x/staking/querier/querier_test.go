@@ -0,0 +1,77 @@
+package querier
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+
+	"github.com/PhenixChain/PhenixChain/codec"
+	sdk "github.com/PhenixChain/PhenixChain/types"
+	keep "github.com/PhenixChain/PhenixChain/x/staking/keeper"
+	"github.com/PhenixChain/PhenixChain/x/staking/types"
+)
+
+func TestQueryQueues(t *testing.T) {
+	ctx, _, _, k := keep.CreateTestInput(t)
+	cdc := codec.New()
+	types.RegisterCodec(cdc)
+	querier := NewQuerier(k, cdc)
+
+	delAddr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	other := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	valSrc := sdk.ValAddress(ed25519.GenPrivKey().PubKey().Address())
+	valDst := sdk.ValAddress(ed25519.GenPrivKey().PubKey().Address())
+	start := time.Date(2019, 6, 1, 0, 0, 0, 0, time.UTC)
+	for i, del := range []sdk.AccAddress{delAddr, other, delAddr, delAddr} {
+		completionTime := start.Add(time.Duration(i) * time.Hour)
+		balance := sdk.NewInt(int64(i + 1))
+		ubd := k.SetUnbondingDelegationEntry(ctx, del, valSrc, int64(i), completionTime, balance)
+		k.InsertUBDQueue(ctx, ubd, completionTime)
+		red := k.SetRedelegationEntry(ctx, del, valSrc, valDst, int64(i), completionTime,
+			balance, balance.ToDec(), balance.ToDec())
+		k.InsertRedelegationQueue(ctx, red, completionTime)
+	}
+
+	query := func(route string, params QueryQueueParams, res interface{}) {
+		req := abci.RequestQuery{Data: cdc.MustMarshalJSON(params)}
+		bz, err := querier(ctx, []string{route}, req)
+		require.Nil(t, err)
+		cdc.MustUnmarshalJSON(bz, res)
+	}
+
+	cases := map[string]struct {
+		params   QueryQueueParams
+		balances []int64
+	}{
+		"all":                      {NewQueryQueueParams(nil, time.Time{}, 0), []int64{1, 2, 3, 4}},
+		"all until":                {NewQueryQueueParams(nil, start.Add(time.Hour), 0), []int64{1, 2}},
+		"all limited":              {NewQueryQueueParams(nil, time.Time{}, 3), []int64{1, 2, 3}},
+		"delegator":                {NewQueryQueueParams(delAddr, time.Time{}, 0), []int64{1, 3, 4}},
+		"delegator until":          {NewQueryQueueParams(delAddr, start.Add(2*time.Hour), 0), []int64{1, 3}},
+		"delegator limited":        {NewQueryQueueParams(delAddr, time.Time{}, 1), []int64{1}},
+		"delegator until, limited": {NewQueryQueueParams(delAddr, start.Add(3*time.Hour), 2), []int64{1, 3}},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var ubds types.UnbondingQueueEntries
+			query(QueryUnbondingQueue, tc.params, &ubds)
+			var reds types.RedelegationQueueEntries
+			query(QueryRedelegationQueue, tc.params, &reds)
+
+			require.Len(t, ubds, len(tc.balances))
+			require.Len(t, reds, len(tc.balances))
+			for i, balance := range tc.balances {
+				require.Equal(t, balance, ubds[i].Balance.Int64())
+				require.Equal(t, balance, reds[i].InitialBalance.Int64())
+			}
+		})
+	}
+
+	var totals types.QueueTotals
+	query(QueryQueueTotals, NewQueryQueueParams(delAddr, time.Time{}, 0), &totals)
+	require.Equal(t, int64(3), totals.UnbondingEntries)
+	require.Equal(t, sdk.NewInt(8), totals.UnbondingBalance)
+}
@@ -17,6 +17,8 @@ type FeeCollectionKeeper interface {
 type BankKeeper interface {
 	DelegateCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) (sdk.Tags, sdk.Error)
 	UndelegateCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) (sdk.Tags, sdk.Error)
+	InflateSupply(ctx sdk.Context, amt sdk.Coins)
+	DeflateSupply(ctx sdk.Context, amt sdk.Coins)
 }
 
 // expected crisis keeper
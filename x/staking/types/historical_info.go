@@ -0,0 +1,85 @@
+package types
+
+import (
+	"fmt"
+	"sort"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/PhenixChain/PhenixChain/codec"
+)
+
+// HistoricalInfo contains the header and the bonded validator set of a past
+// height. It links the consensus power of each validator to its staking data
+// (operator, commission, delegator shares) and lets light clients, such as
+// IBC ones, verify the validator set of recent blocks.
+type HistoricalInfo struct {
+	Header abci.Header `json:"header"`
+	ValSet Validators  `json:"valset"`
+}
+
+// NewHistoricalInfo creates historical info from a header and the bonded
+// validators, sorted by decreasing power and then by operator address so
+// that the stored value is deterministic.
+func NewHistoricalInfo(header abci.Header, valSet Validators) HistoricalInfo {
+	sorted := make(Validators, len(valSet))
+	copy(sorted, valSet)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		pi, pj := sorted[i].GetTendermintPower(), sorted[j].GetTendermintPower()
+		if pi != pj {
+			return pi > pj
+		}
+		return sorted[i].OperatorAddress.String() < sorted[j].OperatorAddress.String()
+	})
+	return HistoricalInfo{
+		Header: header,
+		ValSet: sorted,
+	}
+}
+
+// MustMarshalHistoricalInfo wll marshal historical info and panic on error
+func MustMarshalHistoricalInfo(cdc *codec.Codec, hi HistoricalInfo) []byte {
+	return cdc.MustMarshalBinaryLengthPrefixed(hi)
+}
+
+// MustUnmarshalHistoricalInfo wll unmarshal historical info and panic on error
+func MustUnmarshalHistoricalInfo(cdc *codec.Codec, value []byte) HistoricalInfo {
+	hi, err := UnmarshalHistoricalInfo(cdc, value)
+	if err != nil {
+		panic(err)
+	}
+	return hi
+}
+
+// UnmarshalHistoricalInfo will unmarshal historical info and return any error
+func UnmarshalHistoricalInfo(cdc *codec.Codec, value []byte) (hi HistoricalInfo, err error) {
+	err = cdc.UnmarshalBinaryLengthPrefixed(value, &hi)
+	return hi, err
+}
+
+// ValidateBasic will ensure the historical info is valid
+func (hi HistoricalInfo) ValidateBasic() error {
+	if hi.Header.Height <= 0 {
+		return fmt.Errorf("historical info height must be positive, is %d", hi.Header.Height)
+	}
+	if len(hi.ValSet) == 0 {
+		return fmt.Errorf("historical info validator set is empty")
+	}
+	for i := 1; i < len(hi.ValSet); i++ {
+		if hi.ValSet[i-1].GetTendermintPower() < hi.ValSet[i].GetTendermintPower() {
+			return fmt.Errorf("historical info validator set is not sorted by power")
+		}
+	}
+	return nil
+}
+
+// String returns a human readable string representation of historical info
+func (hi HistoricalInfo) String() string {
+	return fmt.Sprintf(`Historical Info:
+  Height:      %d
+  Time:        %v
+  Chain ID:    %s
+  App Hash:    %X
+  Validators:
+%s`, hi.Header.Height, hi.Header.Time, hi.Header.ChainID, hi.Header.AppHash, hi.ValSet)
+}
@@ -0,0 +1,43 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/PhenixChain/PhenixChain/types"
+)
+
+func TestHistoricalInfo(t *testing.T) {
+	header := abci.Header{ChainID: "hello", Height: 5}
+	validators := Validators{
+		NewValidator(addr1, pk1, Description{}),
+		NewValidator(addr2, pk2, Description{}),
+		NewValidator(addr3, pk3, Description{}),
+	}
+	validators[0].Tokens = sdk.TokensFromTendermintPower(10)
+	validators[1].Tokens = sdk.TokensFromTendermintPower(30)
+	validators[2].Tokens = sdk.TokensFromTendermintPower(20)
+	for i := range validators {
+		validators[i].Status = sdk.Bonded
+	}
+
+	hi := NewHistoricalInfo(header, validators)
+	require.NoError(t, hi.ValidateBasic())
+	require.Equal(t, int64(30), hi.ValSet[0].GetTendermintPower())
+	require.Equal(t, int64(20), hi.ValSet[1].GetTendermintPower())
+	require.Equal(t, int64(10), hi.ValSet[2].GetTendermintPower())
+
+	value := MustMarshalHistoricalInfo(MsgCdc, hi)
+	recovered, err := UnmarshalHistoricalInfo(MsgCdc, value)
+	require.NoError(t, err)
+	require.Equal(t, hi.Header, recovered.Header)
+	for i := range hi.ValSet {
+		require.True(t, hi.ValSet[i].TestEquivalent(recovered.ValSet[i]))
+	}
+
+	require.Error(t, NewHistoricalInfo(abci.Header{}, validators).ValidateBasic())
+	require.Error(t, NewHistoricalInfo(header, nil).ValidateBasic())
+	require.NotEmpty(t, hi.String())
+}
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -20,27 +21,29 @@ func TestMsgCreateValidator(t *testing.T) {
 	commission2 := NewCommissionMsg(sdk.NewDec(5), sdk.NewDec(5), sdk.NewDec(5))
 
 	tests := []struct {
-		name, moniker, identity, website, details string
-		commissionMsg                             CommissionMsg
-		minSelfDelegation                         sdk.Int
-		validatorAddr                             sdk.ValAddress
-		pubkey                                    crypto.PubKey
-		bond                                      sdk.Coin
-		expectPass                                bool
+		name, moniker, identity, website, securityContact, details string
+		commissionMsg                                              CommissionMsg
+		minSelfDelegation                                          sdk.Int
+		validatorAddr                                              sdk.ValAddress
+		pubkey                                                     crypto.PubKey
+		bond                                                       sdk.Coin
+		expectPass                                                 bool
 	}{
-		{"basic good", "a", "b", "c", "d", commission1, sdk.OneInt(), addr1, pk1, coinPos, true},
-		{"partial description", "", "", "c", "", commission1, sdk.OneInt(), addr1, pk1, coinPos, true},
-		{"empty description", "", "", "", "", commission2, sdk.OneInt(), addr1, pk1, coinPos, false},
-		{"empty address", "a", "b", "c", "d", commission2, sdk.OneInt(), emptyAddr, pk1, coinPos, false},
-		{"empty pubkey", "a", "b", "c", "d", commission1, sdk.OneInt(), addr1, emptyPubkey, coinPos, true},
-		{"empty bond", "a", "b", "c", "d", commission2, sdk.OneInt(), addr1, pk1, coinZero, false},
-		{"zero min self delegation", "a", "b", "c", "d", commission1, sdk.ZeroInt(), addr1, pk1, coinPos, false},
-		{"negative min self delegation", "a", "b", "c", "d", commission1, sdk.NewInt(-1), addr1, pk1, coinPos, false},
-		{"delegation less than min self delegation", "a", "b", "c", "d", commission1, coinPos.Amount.Add(sdk.OneInt()), addr1, pk1, coinPos, false},
+		{"basic good", "a", "b", "c", "d", "e", commission1, sdk.OneInt(), addr1, pk1, coinPos, true},
+		{"partial description", "", "", "c", "", "", commission1, sdk.OneInt(), addr1, pk1, coinPos, true},
+		{"empty description", "", "", "", "", "", commission2, sdk.OneInt(), addr1, pk1, coinPos, false},
+		{"too long security contact", "a", "b", "c", strings.Repeat("d", MaxSecurityContactLength+1), "e", commission1, sdk.OneInt(), addr1, pk1, coinPos, false},
+		{"too long moniker", strings.Repeat("a", MaxMonikerLength+1), "b", "c", "d", "e", commission1, sdk.OneInt(), addr1, pk1, coinPos, false},
+		{"empty address", "a", "b", "c", "d", "e", commission2, sdk.OneInt(), emptyAddr, pk1, coinPos, false},
+		{"empty pubkey", "a", "b", "c", "d", "e", commission1, sdk.OneInt(), addr1, emptyPubkey, coinPos, true},
+		{"empty bond", "a", "b", "c", "d", "e", commission2, sdk.OneInt(), addr1, pk1, coinZero, false},
+		{"zero min self delegation", "a", "b", "c", "d", "e", commission1, sdk.ZeroInt(), addr1, pk1, coinPos, false},
+		{"negative min self delegation", "a", "b", "c", "d", "e", commission1, sdk.NewInt(-1), addr1, pk1, coinPos, false},
+		{"delegation less than min self delegation", "a", "b", "c", "d", "e", commission1, coinPos.Amount.Add(sdk.OneInt()), addr1, pk1, coinPos, false},
 	}
 
 	for _, tc := range tests {
-		description := NewDescription(tc.moniker, tc.identity, tc.website, tc.details)
+		description := NewDescription(tc.moniker, tc.identity, tc.website, tc.securityContact, tc.details)
 		msg := NewMsgCreateValidator(tc.validatorAddr, tc.pubkey, tc.bond, description, tc.commissionMsg, tc.minSelfDelegation)
 		if tc.expectPass {
 			require.Nil(t, msg.ValidateBasic(), "test: %v", tc.name)
@@ -53,18 +56,19 @@ func TestMsgCreateValidator(t *testing.T) {
 // test ValidateBasic for MsgEditValidator
 func TestMsgEditValidator(t *testing.T) {
 	tests := []struct {
-		name, moniker, identity, website, details string
-		validatorAddr                             sdk.ValAddress
-		expectPass                                bool
+		name, moniker, identity, website, securityContact, details string
+		validatorAddr                                              sdk.ValAddress
+		expectPass                                                 bool
 	}{
-		{"basic good", "a", "b", "c", "d", addr1, true},
-		{"partial description", "", "", "c", "", addr1, true},
-		{"empty description", "", "", "", "", addr1, false},
-		{"empty address", "a", "b", "c", "d", emptyAddr, false},
+		{"basic good", "a", "b", "c", "d", "e", addr1, true},
+		{"partial description", "", "", "c", "", "", addr1, true},
+		{"empty description", "", "", "", "", "", addr1, false},
+		{"too long website", "a", "b", strings.Repeat("c", MaxWebsiteLength+1), "d", "e", addr1, false},
+		{"empty address", "a", "b", "c", "d", "e", emptyAddr, false},
 	}
 
 	for _, tc := range tests {
-		description := NewDescription(tc.moniker, tc.identity, tc.website, tc.details)
+		description := NewDescription(tc.moniker, tc.identity, tc.website, tc.securityContact, tc.details)
 		newRate := sdk.ZeroDec()
 		newMinSelfDelegation := sdk.OneInt()
 
@@ -21,6 +21,9 @@ const (
 
 	// Default maximum entries in a UBD/RED pair
 	DefaultMaxEntries uint16 = 7
+
+	// Default number of recent blocks whose historical info is kept
+	DefaultHistoricalEntries uint16 = 100
 )
 
 // nolint - Keys for parameter access
@@ -29,6 +32,8 @@ var (
 	KeyMaxValidators = []byte("MaxValidators")
 	KeyMaxEntries    = []byte("KeyMaxEntries")
 	KeyBondDenom     = []byte("BondDenom")
+
+	KeyHistoricalEntries = []byte("HistoricalEntries")
 )
 
 var _ params.ParamSet = (*Params)(nil)
@@ -39,17 +44,19 @@ type Params struct {
 	MaxValidators uint16        `json:"max_validators"` // maximum number of validators (max uint16 = 65535)
 	MaxEntries    uint16        `json:"max_entries"`    // max entries for either unbonding delegation or redelegation (per pair/trio)
 	// note: we need to be a bit careful about potential overflow here, since this is user-determined
-	BondDenom string `json:"bond_denom"` // bondable coin denomination
+	BondDenom         string `json:"bond_denom"`         // bondable coin denomination
+	HistoricalEntries uint16 `json:"historical_entries"` // number of recent blocks whose historical info is kept
 }
 
 func NewParams(unbondingTime time.Duration, maxValidators, maxEntries uint16,
-	bondDenom string) Params {
+	bondDenom string, historicalEntries uint16) Params {
 
 	return Params{
-		UnbondingTime: unbondingTime,
-		MaxValidators: maxValidators,
-		MaxEntries:    maxEntries,
-		BondDenom:     bondDenom,
+		UnbondingTime:     unbondingTime,
+		MaxValidators:     maxValidators,
+		MaxEntries:        maxEntries,
+		BondDenom:         bondDenom,
+		HistoricalEntries: historicalEntries,
 	}
 }
 
@@ -60,6 +67,7 @@ func (p *Params) ParamSetPairs() params.ParamSetPairs {
 		{KeyMaxValidators, &p.MaxValidators},
 		{KeyMaxEntries, &p.MaxEntries},
 		{KeyBondDenom, &p.BondDenom},
+		{KeyHistoricalEntries, &p.HistoricalEntries},
 	}
 }
 
@@ -73,17 +81,19 @@ func (p Params) Equal(p2 Params) bool {
 
 // DefaultParams returns a default set of parameters.
 func DefaultParams() Params {
-	return NewParams(DefaultUnbondingTime, DefaultMaxValidators, DefaultMaxEntries, sdk.DefaultBondDenom)
+	return NewParams(DefaultUnbondingTime, DefaultMaxValidators, DefaultMaxEntries, sdk.DefaultBondDenom,
+		DefaultHistoricalEntries)
 }
 
 // String returns a human readable string representation of the parameters.
 func (p Params) String() string {
 	return fmt.Sprintf(`Params:
-  Unbonding Time:    %s
-  Max Validators:    %d
-  Max Entries:       %d
-  Bonded Coin Denom: %s`, p.UnbondingTime,
-		p.MaxValidators, p.MaxEntries, p.BondDenom)
+  Unbonding Time:     %s
+  Max Validators:     %d
+  Max Entries:        %d
+  Bonded Coin Denom:  %s
+  Historical Entries: %d`, p.UnbondingTime,
+		p.MaxValidators, p.MaxEntries, p.BondDenom, p.HistoricalEntries)
 }
 
 // unmarshal the current staking params value from store key or panic
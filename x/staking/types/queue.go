@@ -0,0 +1,149 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/PhenixChain/PhenixChain/types"
+)
+
+// UnbondingQueueEntry is a pending unbonding delegation entry, as found in
+// the unbonding queue, with the time at which its tokens are released.
+type UnbondingQueueEntry struct {
+	DelegatorAddress sdk.AccAddress `json:"delegator_address"`
+	ValidatorAddress sdk.ValAddress `json:"validator_address"`
+	CreationHeight   int64          `json:"creation_height"`
+	CompletionTime   time.Time      `json:"completion_time"`
+	Balance          sdk.Int        `json:"balance"`
+}
+
+// NewUnbondingQueueEntry creates a new unbonding queue entry.
+func NewUnbondingQueueEntry(delegatorAddr sdk.AccAddress, validatorAddr sdk.ValAddress,
+	entry UnbondingDelegationEntry) UnbondingQueueEntry {
+
+	return UnbondingQueueEntry{
+		DelegatorAddress: delegatorAddr,
+		ValidatorAddress: validatorAddr,
+		CreationHeight:   entry.CreationHeight,
+		CompletionTime:   entry.CompletionTime,
+		Balance:          entry.Balance,
+	}
+}
+
+// UnbondingQueueEntries is a collection of UnbondingQueueEntry, ordered by
+// completion time.
+type UnbondingQueueEntries []UnbondingQueueEntry
+
+func (entries UnbondingQueueEntries) String() string {
+	if len(entries) == 0 {
+		return "[]"
+	}
+	out := fmt.Sprintf("%-30s %-45s %-52s %s\n", "Completion Time", "Delegator", "Validator", "Balance")
+	for _, entry := range entries {
+		out += fmt.Sprintf("%-30s %-45s %-52s %s\n", entry.CompletionTime.UTC().Format(time.RFC3339),
+			entry.DelegatorAddress, entry.ValidatorAddress, entry.Balance)
+	}
+	return strings.TrimSpace(out)
+}
+
+// RedelegationQueueEntry is a pending redelegation entry, as found in the
+// redelegation queue, with the time at which it completes.
+type RedelegationQueueEntry struct {
+	DelegatorAddress    sdk.AccAddress `json:"delegator_address"`
+	ValidatorSrcAddress sdk.ValAddress `json:"validator_src_address"`
+	ValidatorDstAddress sdk.ValAddress `json:"validator_dst_address"`
+	CreationHeight      int64          `json:"creation_height"`
+	CompletionTime      time.Time      `json:"completion_time"`
+	InitialBalance      sdk.Int        `json:"initial_balance"`
+	SharesDst           sdk.Dec        `json:"shares_dst"`
+}
+
+// NewRedelegationQueueEntry creates a new redelegation queue entry.
+func NewRedelegationQueueEntry(delegatorAddr sdk.AccAddress, validatorSrcAddr,
+	validatorDstAddr sdk.ValAddress, entry RedelegationEntry) RedelegationQueueEntry {
+
+	return RedelegationQueueEntry{
+		DelegatorAddress:    delegatorAddr,
+		ValidatorSrcAddress: validatorSrcAddr,
+		ValidatorDstAddress: validatorDstAddr,
+		CreationHeight:      entry.CreationHeight,
+		CompletionTime:      entry.CompletionTime,
+		InitialBalance:      entry.InitialBalance,
+		SharesDst:           entry.SharesDst,
+	}
+}
+
+// RedelegationQueueEntries is a collection of RedelegationQueueEntry, ordered
+// by completion time.
+type RedelegationQueueEntries []RedelegationQueueEntry
+
+func (entries RedelegationQueueEntries) String() string {
+	if len(entries) == 0 {
+		return "[]"
+	}
+	out := fmt.Sprintf("%-30s %-45s %-52s %-52s %s\n", "Completion Time", "Delegator",
+		"Source Validator", "Destination Validator", "Initial Balance")
+	for _, entry := range entries {
+		out += fmt.Sprintf("%-30s %-45s %-52s %-52s %s\n", entry.CompletionTime.UTC().Format(time.RFC3339),
+			entry.DelegatorAddress, entry.ValidatorSrcAddress, entry.ValidatorDstAddress, entry.InitialBalance)
+	}
+	return strings.TrimSpace(out)
+}
+
+// QueueTotals sums up the pending unbonding and redelegation entries, either
+// of a single delegator or of the whole chain.
+type QueueTotals struct {
+	UnbondingEntries     int64     `json:"unbonding_entries"`
+	UnbondingBalance     sdk.Int   `json:"unbonding_balance"`   // tokens released once the unbondings complete
+	NextUnbondingTime    time.Time `json:"next_unbonding_time"` // zero if nothing is unbonding
+	RedelegationEntries  int64     `json:"redelegation_entries"`
+	RedelegationBalance  sdk.Int   `json:"redelegation_balance"`   // tokens redelegated, at their initial balance
+	NextRedelegationTime time.Time `json:"next_redelegation_time"` // zero if nothing is redelegating
+}
+
+// NewQueueTotals creates empty queue totals.
+func NewQueueTotals() QueueTotals {
+	return QueueTotals{
+		UnbondingBalance:    sdk.ZeroInt(),
+		RedelegationBalance: sdk.ZeroInt(),
+	}
+}
+
+// AddUnbonding adds an unbonding queue entry to the totals.
+func (qt QueueTotals) AddUnbonding(entry UnbondingQueueEntry) QueueTotals {
+	qt.UnbondingEntries++
+	qt.UnbondingBalance = qt.UnbondingBalance.Add(entry.Balance)
+	if qt.NextUnbondingTime.IsZero() || entry.CompletionTime.Before(qt.NextUnbondingTime) {
+		qt.NextUnbondingTime = entry.CompletionTime
+	}
+	return qt
+}
+
+// AddRedelegation adds a redelegation queue entry to the totals.
+func (qt QueueTotals) AddRedelegation(entry RedelegationQueueEntry) QueueTotals {
+	qt.RedelegationEntries++
+	qt.RedelegationBalance = qt.RedelegationBalance.Add(entry.InitialBalance)
+	if qt.NextRedelegationTime.IsZero() || entry.CompletionTime.Before(qt.NextRedelegationTime) {
+		qt.NextRedelegationTime = entry.CompletionTime
+	}
+	return qt
+}
+
+func (qt QueueTotals) String() string {
+	formatTime := func(t time.Time) string {
+		if t.IsZero() {
+			return "none"
+		}
+		return t.UTC().Format(time.RFC3339)
+	}
+	return fmt.Sprintf(`Queue Totals:
+  Unbonding Entries:      %d
+  Unbonding Balance:      %s
+  Next Unbonding Time:    %s
+  Redelegation Entries:   %d
+  Redelegation Balance:   %s
+  Next Redelegation Time: %s`,
+		qt.UnbondingEntries, qt.UnbondingBalance, formatTime(qt.NextUnbondingTime),
+		qt.RedelegationEntries, qt.RedelegationBalance, formatTime(qt.NextRedelegationTime))
+}
@@ -0,0 +1,37 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/PhenixChain/PhenixChain/types"
+)
+
+func TestQueueTotals(t *testing.T) {
+	now := time.Unix(1000, 0).UTC()
+
+	totals := NewQueueTotals()
+	require.True(t, totals.NextUnbondingTime.IsZero())
+	require.NotEmpty(t, totals.String())
+
+	ubd1 := NewUnbondingQueueEntry(sdk.AccAddress(addr1), addr2,
+		NewUnbondingDelegationEntry(1, now.Add(time.Hour), sdk.NewInt(10)))
+	ubd2 := NewUnbondingQueueEntry(sdk.AccAddress(addr1), addr3,
+		NewUnbondingDelegationEntry(2, now, sdk.NewInt(5)))
+	totals = totals.AddUnbonding(ubd1).AddUnbonding(ubd2)
+	require.Equal(t, int64(2), totals.UnbondingEntries)
+	require.True(t, totals.UnbondingBalance.Equal(sdk.NewInt(15)))
+	require.True(t, totals.NextUnbondingTime.Equal(now))
+
+	red := NewRedelegationQueueEntry(sdk.AccAddress(addr1), addr2, addr3,
+		NewRedelegationEntry(3, now.Add(time.Minute), sdk.NewInt(7), sdk.NewDec(7)))
+	totals = totals.AddRedelegation(red)
+	require.Equal(t, int64(1), totals.RedelegationEntries)
+	require.True(t, totals.RedelegationBalance.Equal(sdk.NewInt(7)))
+	require.True(t, totals.NextRedelegationTime.Equal(now.Add(time.Minute)))
+
+	require.NotEmpty(t, UnbondingQueueEntries{ubd1, ubd2}.String())
+	require.NotEmpty(t, RedelegationQueueEntries{red}.String())
+}
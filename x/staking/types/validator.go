@@ -17,10 +17,11 @@ import (
 // nolint
 const (
 	// TODO: Why can't we just have one string description which can be JSON by convention
-	MaxMonikerLength  = 70
-	MaxIdentityLength = 3000
-	MaxWebsiteLength  = 140
-	MaxDetailsLength  = 280
+	MaxMonikerLength         = 70
+	MaxIdentityLength        = 3000
+	MaxWebsiteLength         = 140
+	MaxSecurityContactLength = 140
+	MaxDetailsLength         = 280
 )
 
 // Validator defines the total amount of bond shares and their exchange rate to
@@ -112,13 +113,18 @@ func (v Validator) String() string {
   Status:                     %s
   Tokens:                     %s
   Delegator Shares:           %s
-  Description:                %s
+  Moniker:                    %s
+  Identity:                   %s
+  Website:                    %s
+  Security Contact:           %s
+  Details:                    %s
   Unbonding Height:           %d
   Unbonding Completion Time:  %v
   Minimum Self Delegation:    %v
   Commission:                 %s`, v.OperatorAddress, bechConsPubKey,
-		v.Jailed, sdk.BondStatusToString(v.Status), v.Tokens,
-		v.DelegatorShares, v.Description,
+		v.Jailed, sdk.BondStatusToString(v.Status), v.Tokens, v.DelegatorShares,
+		v.Description.Moniker, v.Description.Identity, v.Description.Website,
+		v.Description.SecurityContact, v.Description.Details,
 		v.UnbondingHeight, v.UnbondingCompletionTime, v.MinSelfDelegation, v.Commission)
 }
 
@@ -206,19 +212,21 @@ const DoNotModifyDesc = "[do-not-modify]"
 
 // Description - description fields for a validator
 type Description struct {
-	Moniker  string `json:"moniker"`  // name
-	Identity string `json:"identity"` // optional identity signature (ex. UPort or Keybase)
-	Website  string `json:"website"`  // optional website link
-	Details  string `json:"details"`  // optional details
+	Moniker         string `json:"moniker"`          // name
+	Identity        string `json:"identity"`         // optional identity signature (ex. UPort or Keybase)
+	Website         string `json:"website"`          // optional website link
+	Details         string `json:"details"`          // optional details
+	SecurityContact string `json:"security_contact"` // optional security contact info
 }
 
 // NewDescription returns a new Description with the provided values.
-func NewDescription(moniker, identity, website, details string) Description {
+func NewDescription(moniker, identity, website, securityContact, details string) Description {
 	return Description{
-		Moniker:  moniker,
-		Identity: identity,
-		Website:  website,
-		Details:  details,
+		Moniker:         moniker,
+		Identity:        identity,
+		Website:         website,
+		SecurityContact: securityContact,
+		Details:         details,
 	}
 }
 
@@ -234,16 +242,20 @@ func (d Description) UpdateDescription(d2 Description) (Description, sdk.Error)
 	if d2.Website == DoNotModifyDesc {
 		d2.Website = d.Website
 	}
+	if d2.SecurityContact == DoNotModifyDesc {
+		d2.SecurityContact = d.SecurityContact
+	}
 	if d2.Details == DoNotModifyDesc {
 		d2.Details = d.Details
 	}
 
-	return Description{
-		Moniker:  d2.Moniker,
-		Identity: d2.Identity,
-		Website:  d2.Website,
-		Details:  d2.Details,
-	}.EnsureLength()
+	return NewDescription(
+		d2.Moniker,
+		d2.Identity,
+		d2.Website,
+		d2.SecurityContact,
+		d2.Details,
+	).EnsureLength()
 }
 
 // EnsureLength ensures the length of a validator's description.
@@ -257,6 +269,9 @@ func (d Description) EnsureLength() (Description, sdk.Error) {
 	if len(d.Website) > MaxWebsiteLength {
 		return d, ErrDescriptionLength(DefaultCodespace, "website", len(d.Website), MaxWebsiteLength)
 	}
+	if len(d.SecurityContact) > MaxSecurityContactLength {
+		return d, ErrDescriptionLength(DefaultCodespace, "security contact", len(d.SecurityContact), MaxSecurityContactLength)
+	}
 	if len(d.Details) > MaxDetailsLength {
 		return d, ErrDescriptionLength(DefaultCodespace, "details", len(d.Details), MaxDetailsLength)
 	}
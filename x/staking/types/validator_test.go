@@ -25,15 +25,17 @@ func TestValidatorTestEquivalent(t *testing.T) {
 
 func TestUpdateDescription(t *testing.T) {
 	d1 := Description{
-		Website: "https://validator.cosmos",
-		Details: "Test validator",
+		Website:         "https://validator.cosmos",
+		SecurityContact: "security@validator.cosmos",
+		Details:         "Test validator",
 	}
 
 	d2 := Description{
-		Moniker:  DoNotModifyDesc,
-		Identity: DoNotModifyDesc,
-		Website:  DoNotModifyDesc,
-		Details:  DoNotModifyDesc,
+		Moniker:         DoNotModifyDesc,
+		Identity:        DoNotModifyDesc,
+		Website:         DoNotModifyDesc,
+		SecurityContact: DoNotModifyDesc,
+		Details:         DoNotModifyDesc,
 	}
 
 	d3 := Description{
@@ -0,0 +1,87 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/PhenixChain/PhenixChain/client/context"
+	"github.com/PhenixChain/PhenixChain/codec"
+	sdk "github.com/PhenixChain/PhenixChain/types"
+	"github.com/PhenixChain/PhenixChain/x/token"
+)
+
+// GetCmdQueryToken implements the query token command.
+func GetCmdQueryToken(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "token [denom]",
+		Short: "Query an issued token and its supply",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s/%s", token.QuerierRoute, token.QueryToken, args[0])
+			res, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var t token.Token
+			if err := cdc.UnmarshalJSON(res, &t); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(t)
+		},
+	}
+}
+
+// GetCmdQueryTokens implements the query all tokens command.
+func GetCmdQueryTokens(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "tokens",
+		Short: "Query all issued tokens",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", token.QuerierRoute, token.QueryTokens)
+			res, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var tokens token.Tokens
+			if err := cdc.UnmarshalJSON(res, &tokens); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(tokens)
+		},
+	}
+}
+
+// GetCmdQueryIssueFee implements the query token issue fee command.
+func GetCmdQueryIssueFee(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "issue-fee",
+		Short: "Query the fee charged for issuing a token",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", token.QuerierRoute, token.QueryIssueFee)
+			res, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var issueFee sdk.Coins
+			if err := cdc.UnmarshalJSON(res, &issueFee); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(issueFee)
+		},
+	}
+}
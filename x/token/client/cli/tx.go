@@ -0,0 +1,162 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/PhenixChain/PhenixChain/client/context"
+	"github.com/PhenixChain/PhenixChain/client/utils"
+	"github.com/PhenixChain/PhenixChain/codec"
+	sdk "github.com/PhenixChain/PhenixChain/types"
+	authtxb "github.com/PhenixChain/PhenixChain/x/auth/client/txbuilder"
+	"github.com/PhenixChain/PhenixChain/x/bank/client/common"
+	"github.com/PhenixChain/PhenixChain/x/token"
+)
+
+const (
+	flagDecimals      = "decimals"
+	flagMaxSupply     = "max-supply"
+	flagMintable      = "mintable"
+	flagInitialSupply = "initial-supply"
+)
+
+// GetCmdIssue implements the issue token command.
+func GetCmdIssue(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "issue [denom] [symbol]",
+		Short: "Issue a new token",
+		Long: `Issue a new token owned by the --from account. Balances are stored in the
+base denom; the symbol is the display denom, worth 10^decimals base units.
+Supplies are given in base units. Issuing a token costs the chain's issue fee.
+
+Example:
+$ phenixcli tx token issue ufan fan --decimals 6 --initial-supply 1000000000000 --max-supply 21000000000000 --mintable --from mykey
+`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := authtxb.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc).WithAccountDecoder(cdc)
+
+			maxSupply, ok := sdk.NewIntFromString(viper.GetString(flagMaxSupply))
+			if !ok {
+				return fmt.Errorf("invalid max supply: %s", viper.GetString(flagMaxSupply))
+			}
+			initialSupply, ok := sdk.NewIntFromString(viper.GetString(flagInitialSupply))
+			if !ok {
+				return fmt.Errorf("invalid initial supply: %s", viper.GetString(flagInitialSupply))
+			}
+			decimals := viper.GetInt(flagDecimals)
+			if decimals < 0 || decimals > sdk.MaxDenomExponent {
+				return fmt.Errorf("decimals must be between 0 and %d", sdk.MaxDenomExponent)
+			}
+
+			msg := token.NewMsgIssue(cliCtx.GetFromAddress(), args[0], args[1], uint8(decimals),
+				maxSupply, viper.GetBool(flagMintable), initialSupply)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg}, false)
+		},
+	}
+
+	cmd.Flags().Uint(flagDecimals, 0, "Number of decimals of the display denom")
+	cmd.Flags().String(flagMaxSupply, "0", "Maximum supply in base units, 0 for no cap")
+	cmd.Flags().Bool(flagMintable, false, "Allow the owner to mint more tokens after issuance")
+	cmd.Flags().String(flagInitialSupply, "0", "Supply credited to the owner at issuance, in base units")
+	return cmd
+}
+
+// GetCmdMint implements the mint token command.
+func GetCmdMint(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "mint [recipient] [amount]",
+		Short: "Mint coins of a mintable token you own",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := authtxb.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc).WithAccountDecoder(cdc)
+
+			recipient, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			amount, err := parseCoin(cliCtx, cdc, args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := token.NewMsgMint(cliCtx.GetFromAddress(), recipient, amount)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg}, false)
+		},
+	}
+}
+
+// GetCmdBurn implements the burn token command.
+func GetCmdBurn(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "burn [amount]",
+		Short: "Burn coins of a token held by the sender",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := authtxb.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc).WithAccountDecoder(cdc)
+
+			amount, err := parseCoin(cliCtx, cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := token.NewMsgBurn(cliCtx.GetFromAddress(), amount)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg}, false)
+		},
+	}
+}
+
+// GetCmdTransferOwnership implements the transfer token ownership command.
+func GetCmdTransferOwnership(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "transfer-ownership [denom] [new_owner]",
+		Short: "Hand the ownership of a token over to another account",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := authtxb.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc).WithAccountDecoder(cdc)
+
+			newOwner, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := token.NewMsgTransferOwnership(cliCtx.GetFromAddress(), newOwner, args[0])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg}, false)
+		},
+	}
+}
+
+// parseCoin parses a single coin in base or display denomination.
+func parseCoin(cliCtx context.CLIContext, cdc *codec.Codec, coinStr string) (sdk.Coin, error) {
+	coins, err := common.ParseCoins(cliCtx, cdc, coinStr)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	if len(coins) != 1 {
+		return sdk.Coin{}, fmt.Errorf("expected a single coin, got %s", coinStr)
+	}
+	return coins[0], nil
+}
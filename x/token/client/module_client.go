@@ -0,0 +1,55 @@
+package client
+
+import (
+	"github.com/spf13/cobra"
+	amino "github.com/tendermint/go-amino"
+
+	"github.com/PhenixChain/PhenixChain/client"
+	"github.com/PhenixChain/PhenixChain/x/token"
+	"github.com/PhenixChain/PhenixChain/x/token/client/cli"
+)
+
+// ModuleClient exports all client functionality from this module
+type ModuleClient struct {
+	storeKey string
+	cdc      *amino.Codec
+}
+
+// NewModuleClient creates a new ModuleClient object
+func NewModuleClient(storeKey string, cdc *amino.Codec) ModuleClient {
+	return ModuleClient{
+		storeKey: storeKey,
+		cdc:      cdc,
+	}
+}
+
+// GetQueryCmd returns the cli query commands for this module
+func (mc ModuleClient) GetQueryCmd() *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:   token.ModuleName,
+		Short: "Querying commands for the token module",
+	}
+
+	queryCmd.AddCommand(client.GetCommands(
+		cli.GetCmdQueryToken(mc.cdc),
+		cli.GetCmdQueryTokens(mc.cdc),
+		cli.GetCmdQueryIssueFee(mc.cdc),
+	)...)
+	return queryCmd
+}
+
+// GetTxCmd returns the transaction commands for this module
+func (mc ModuleClient) GetTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:   token.ModuleName,
+		Short: "Token transactions subcommands",
+	}
+
+	txCmd.AddCommand(client.PostCommands(
+		cli.GetCmdIssue(mc.cdc),
+		cli.GetCmdMint(mc.cdc),
+		cli.GetCmdBurn(mc.cdc),
+		cli.GetCmdTransferOwnership(mc.cdc),
+	)...)
+	return txCmd
+}
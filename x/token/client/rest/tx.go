@@ -0,0 +1,223 @@
+package rest
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"github.com/PhenixChain/PhenixChain/client/context"
+	clientrest "github.com/PhenixChain/PhenixChain/client/rest"
+	"github.com/PhenixChain/PhenixChain/codec"
+	sdk "github.com/PhenixChain/PhenixChain/types"
+	"github.com/PhenixChain/PhenixChain/types/rest"
+	"github.com/PhenixChain/PhenixChain/x/token"
+)
+
+func registerTxRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Codec) {
+	r.HandleFunc(
+		"/token/tokens",
+		issueHandlerFn(cdc, cliCtx),
+	).Methods("POST")
+
+	r.HandleFunc(
+		"/token/tokens/{denom}/mint",
+		mintHandlerFn(cdc, cliCtx),
+	).Methods("POST")
+
+	r.HandleFunc(
+		"/token/tokens/{denom}/burn",
+		burnHandlerFn(cdc, cliCtx),
+	).Methods("POST")
+
+	r.HandleFunc(
+		"/token/tokens/{denom}/ownership",
+		transferOwnershipHandlerFn(cdc, cliCtx),
+	).Methods("POST")
+}
+
+type (
+	// IssueReq defines the properties of an issue token request's body.
+	IssueReq struct {
+		BaseReq       rest.BaseReq `json:"base_req"`
+		Denom         string       `json:"denom"`
+		Symbol        string       `json:"symbol"`
+		Decimals      uint8        `json:"decimals"`
+		MaxSupply     string       `json:"max_supply"` // base units, empty or 0 for no cap
+		Mintable      bool         `json:"mintable"`
+		InitialSupply string       `json:"initial_supply"` // base units
+	}
+
+	// MintReq defines the properties of a mint token request's body.
+	MintReq struct {
+		BaseReq   rest.BaseReq   `json:"base_req"`
+		Recipient sdk.AccAddress `json:"recipient"`
+		Amount    string         `json:"amount"` // base units
+	}
+
+	// BurnReq defines the properties of a burn token request's body.
+	BurnReq struct {
+		BaseReq rest.BaseReq `json:"base_req"`
+		Amount  string       `json:"amount"` // base units
+	}
+
+	// TransferOwnershipReq defines the properties of a transfer token
+	// ownership request's body.
+	TransferOwnershipReq struct {
+		BaseReq  rest.BaseReq   `json:"base_req"`
+		NewOwner sdk.AccAddress `json:"new_owner"`
+	}
+)
+
+func issueHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req IssueReq
+		if !rest.ReadRESTReq(w, r, cdc, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		owner, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		maxSupply, ok := parseInt(w, req.MaxSupply)
+		if !ok {
+			return
+		}
+		initialSupply, ok := parseInt(w, req.InitialSupply)
+		if !ok {
+			return
+		}
+
+		msg := token.NewMsgIssue(owner, req.Denom, req.Symbol, req.Decimals,
+			maxSupply, req.Mintable, initialSupply)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		clientrest.WriteGenerateStdTxResponse(w, cdc, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func mintHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		denom := mux.Vars(r)["denom"]
+
+		var req MintReq
+		if !rest.ReadRESTReq(w, r, cdc, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		owner, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		amount, ok := parseInt(w, req.Amount)
+		if !ok {
+			return
+		}
+
+		msg := token.NewMsgMint(owner, req.Recipient, sdk.Coin{Denom: denom, Amount: amount})
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		clientrest.WriteGenerateStdTxResponse(w, cdc, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func burnHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		denom := mux.Vars(r)["denom"]
+
+		var req BurnReq
+		if !rest.ReadRESTReq(w, r, cdc, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		sender, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		amount, ok := parseInt(w, req.Amount)
+		if !ok {
+			return
+		}
+
+		msg := token.NewMsgBurn(sender, sdk.Coin{Denom: denom, Amount: amount})
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		clientrest.WriteGenerateStdTxResponse(w, cdc, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func transferOwnershipHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		denom := mux.Vars(r)["denom"]
+
+		var req TransferOwnershipReq
+		if !rest.ReadRESTReq(w, r, cdc, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		owner, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := token.NewMsgTransferOwnership(owner, req.NewOwner, denom)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		clientrest.WriteGenerateStdTxResponse(w, cdc, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+// parseInt parses an amount in base units, treating an empty string as zero.
+// It writes an error response if the amount is invalid.
+func parseInt(w http.ResponseWriter, amount string) (sdk.Int, bool) {
+	if amount == "" {
+		return sdk.ZeroInt(), true
+	}
+
+	i, ok := sdk.NewIntFromString(amount)
+	if !ok {
+		rest.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("invalid amount: %s", amount))
+		return sdk.Int{}, false
+	}
+	return i, true
+}
@@ -0,0 +1,23 @@
+package token
+
+import (
+	"github.com/PhenixChain/PhenixChain/codec"
+)
+
+// Register concrete types on codec codec
+func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterConcrete(MsgIssue{}, "cosmos-sdk/MsgIssueToken", nil)
+	cdc.RegisterConcrete(MsgMint{}, "cosmos-sdk/MsgMintToken", nil)
+	cdc.RegisterConcrete(MsgBurn{}, "cosmos-sdk/MsgBurnToken", nil)
+	cdc.RegisterConcrete(MsgTransferOwnership{}, "cosmos-sdk/MsgTransferTokenOwnership", nil)
+}
+
+// generic sealed codec to be used throughout module
+var MsgCdc *codec.Codec
+
+func init() {
+	cdc := codec.New()
+	RegisterCodec(cdc)
+	codec.RegisterCrypto(cdc)
+	MsgCdc = cdc.Seal()
+}
@@ -0,0 +1,24 @@
+package token
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/PhenixChain/PhenixChain/codec"
+)
+
+// DecodeStore decodes a key/value pair of the token store into JSON
+func DecodeStore(cdc *codec.Codec, key, value []byte) (json.RawMessage, error) {
+	switch {
+	case bytes.HasPrefix(key, TokenKeyPrefix):
+		var token Token
+		if err := cdc.UnmarshalBinaryLengthPrefixed(value, &token); err != nil {
+			return nil, err
+		}
+		return cdc.MarshalJSON(token)
+
+	default:
+		return nil, fmt.Errorf("unknown key %X", key)
+	}
+}
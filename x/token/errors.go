@@ -0,0 +1,60 @@
+package token
+
+import (
+	"fmt"
+
+	sdk "github.com/PhenixChain/PhenixChain/types"
+)
+
+const (
+	// default codespace for token module
+	DefaultCodespace sdk.CodespaceType = ModuleName
+
+	CodeInvalidInput      sdk.CodeType = 101
+	CodeTokenExists       sdk.CodeType = 102
+	CodeUnknownToken      sdk.CodeType = 103
+	CodeNotOwner          sdk.CodeType = 104
+	CodeNotMintable       sdk.CodeType = 105
+	CodeMaxSupplyExceeded sdk.CodeType = 106
+)
+
+// ErrNilOwner - no owner provided for the input
+func ErrNilOwner(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidInput, "owner address is nil")
+}
+
+// ErrInvalidToken - the token attributes are invalid
+func ErrInvalidToken(codespace sdk.CodespaceType, err error) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidInput, fmt.Sprintf("invalid token: %s", err))
+}
+
+// ErrInvalidAmount - the amount is not positive
+func ErrInvalidAmount(codespace sdk.CodespaceType, amount string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidInput, fmt.Sprintf("invalid amount %s", amount))
+}
+
+// ErrTokenExists - a token or coin with the denom already exists
+func ErrTokenExists(codespace sdk.CodespaceType, denom string) sdk.Error {
+	return sdk.NewError(codespace, CodeTokenExists, fmt.Sprintf("denom %s is already in use", denom))
+}
+
+// ErrUnknownToken - no token was issued with the denom
+func ErrUnknownToken(codespace sdk.CodespaceType, denom string) sdk.Error {
+	return sdk.NewError(codespace, CodeUnknownToken, fmt.Sprintf("token %s does not exist", denom))
+}
+
+// ErrNotOwner - the signer does not own the token
+func ErrNotOwner(codespace sdk.CodespaceType, denom string) sdk.Error {
+	return sdk.NewError(codespace, CodeNotOwner, fmt.Sprintf("signer is not the owner of token %s", denom))
+}
+
+// ErrNotMintable - the token cannot be minted after issuance
+func ErrNotMintable(codespace sdk.CodespaceType, denom string) sdk.Error {
+	return sdk.NewError(codespace, CodeNotMintable, fmt.Sprintf("token %s is not mintable", denom))
+}
+
+// ErrMaxSupplyExceeded - minting would exceed the max supply of the token
+func ErrMaxSupplyExceeded(codespace sdk.CodespaceType, denom string, maxSupply sdk.Int) sdk.Error {
+	return sdk.NewError(codespace, CodeMaxSupplyExceeded,
+		fmt.Sprintf("supply of token %s cannot exceed %s", denom, maxSupply))
+}
@@ -0,0 +1,64 @@
+package token
+
+import (
+	"fmt"
+
+	sdk "github.com/PhenixChain/PhenixChain/types"
+)
+
+// GenesisState - token genesis state
+type GenesisState struct {
+	IssueFee sdk.Coins `json:"issue_fee"`
+	Tokens   Tokens    `json:"tokens"`
+}
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(issueFee sdk.Coins, tokens Tokens) GenesisState {
+	return GenesisState{
+		IssueFee: issueFee,
+		Tokens:   tokens,
+	}
+}
+
+// DefaultGenesisState creates a default GenesisState object
+func DefaultGenesisState() GenesisState {
+	return GenesisState{
+		IssueFee: sdk.NewCoins(sdk.NewCoin(sdk.NativeDenom, sdk.NewInt(1000))),
+	}
+}
+
+// InitGenesis sets the token parameters and issued tokens. Balances of the
+// tokens are part of the genesis accounts.
+func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) {
+	keeper.SetIssueFee(ctx, data.IssueFee)
+	for _, token := range data.Tokens {
+		keeper.SetToken(ctx, token)
+	}
+}
+
+// ExportGenesis returns a GenesisState for a given context and keeper.
+func ExportGenesis(ctx sdk.Context, keeper Keeper) GenesisState {
+	issueFee := keeper.GetIssueFee(ctx)
+	tokens := keeper.GetAllTokens(ctx)
+	return NewGenesisState(issueFee, tokens)
+}
+
+// ValidateGenesis performs basic validation of token genesis data returning an
+// error for any failed validation criteria.
+func ValidateGenesis(data GenesisState) error {
+	if !data.IssueFee.IsValid() {
+		return fmt.Errorf("invalid token issue fee: %s", data.IssueFee)
+	}
+
+	seen := make(map[string]bool)
+	for _, token := range data.Tokens {
+		if err := token.Validate(); err != nil {
+			return err
+		}
+		if seen[token.Denom] {
+			return fmt.Errorf("duplicate token %s", token.Denom)
+		}
+		seen[token.Denom] = true
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+package token
+
+import (
+	"fmt"
+
+	sdk "github.com/PhenixChain/PhenixChain/types"
+)
+
+// NewHandler returns a handler for "token" type messages.
+func NewHandler(k Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+		switch msg := msg.(type) {
+		case MsgIssue:
+			return handleMsgIssue(ctx, k, msg)
+		case MsgMint:
+			return handleMsgMint(ctx, k, msg)
+		case MsgBurn:
+			return handleMsgBurn(ctx, k, msg)
+		case MsgTransferOwnership:
+			return handleMsgTransferOwnership(ctx, k, msg)
+		default:
+			errMsg := fmt.Sprintf("Unrecognized token Msg type: %s", msg.Type())
+			return sdk.ErrUnknownRequest(errMsg).Result()
+		}
+	}
+}
+
+func handleMsgIssue(ctx sdk.Context, k Keeper, msg MsgIssue) sdk.Result {
+	token := NewToken(msg.Denom, msg.Symbol, msg.Decimals, msg.MaxSupply, msg.Mintable, msg.Owner)
+	tags, err := k.IssueToken(ctx, token, msg.InitialSupply)
+	if err != nil {
+		return err.Result()
+	}
+
+	tags = tags.AppendTags(sdk.NewTags(
+		sdk.TagAction, TagActionIssue,
+		TagKeyDenom, msg.Denom,
+		TagKeyOwner, msg.Owner.String(),
+	))
+	return sdk.Result{
+		Tags: tags,
+	}
+}
+
+func handleMsgMint(ctx sdk.Context, k Keeper, msg MsgMint) sdk.Result {
+	tags, err := k.MintTokens(ctx, msg.Owner, msg.Recipient, msg.Amount)
+	if err != nil {
+		return err.Result()
+	}
+
+	tags = tags.AppendTags(sdk.NewTags(
+		sdk.TagAction, TagActionMint,
+		TagKeyDenom, msg.Amount.Denom,
+		TagKeyOwner, msg.Owner.String(),
+	))
+	return sdk.Result{
+		Tags: tags,
+	}
+}
+
+func handleMsgBurn(ctx sdk.Context, k Keeper, msg MsgBurn) sdk.Result {
+	tags, err := k.BurnTokens(ctx, msg.Sender, msg.Amount)
+	if err != nil {
+		return err.Result()
+	}
+
+	tags = tags.AppendTags(sdk.NewTags(
+		sdk.TagAction, TagActionBurn,
+		TagKeyDenom, msg.Amount.Denom,
+	))
+	return sdk.Result{
+		Tags: tags,
+	}
+}
+
+func handleMsgTransferOwnership(ctx sdk.Context, k Keeper, msg MsgTransferOwnership) sdk.Result {
+	err := k.TransferOwnership(ctx, msg.Denom, msg.Owner, msg.NewOwner)
+	if err != nil {
+		return err.Result()
+	}
+
+	tags := sdk.NewTags(
+		sdk.TagAction, TagActionTransferOwnership,
+		TagKeyDenom, msg.Denom,
+		TagKeyOwner, msg.Owner.String(),
+		TagKeyNewOwner, msg.NewOwner.String(),
+	)
+	return sdk.Result{
+		Tags: tags,
+	}
+}
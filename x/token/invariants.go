@@ -0,0 +1,46 @@
+package token
+
+import (
+	"fmt"
+
+	sdk "github.com/PhenixChain/PhenixChain/types"
+	"github.com/PhenixChain/PhenixChain/x/auth"
+)
+
+// register token invariants
+func RegisterInvariants(c CrisisKeeper, k Keeper, ak AccountKeeper) {
+	c.RegisterRoute(ModuleName, "supply",
+		SupplyInvariant(k, ak))
+}
+
+// SupplyInvariant checks that the sum of the balances of every issued token
+// across all accounts equals the supply of the token
+func SupplyInvariant(k Keeper, ak AccountKeeper) sdk.Invariant {
+	return func(ctx sdk.Context) error {
+		balances := make(map[string]sdk.Int)
+		k.IterateTokens(ctx, func(token Token) bool {
+			balances[token.Denom] = sdk.ZeroInt()
+			return false
+		})
+
+		ak.IterateAccounts(ctx, func(acc auth.Account) bool {
+			for _, coin := range acc.GetCoins() {
+				if balance, ok := balances[coin.Denom]; ok {
+					balances[coin.Denom] = balance.Add(coin.Amount)
+				}
+			}
+			return false
+		})
+
+		var broken error
+		k.IterateTokens(ctx, func(token Token) bool {
+			if !balances[token.Denom].Equal(token.Supply) {
+				broken = fmt.Errorf("sum of %s balances %s does not equal its supply %s",
+					token.Denom, balances[token.Denom], token.Supply)
+				return true
+			}
+			return false
+		})
+		return broken
+	}
+}
@@ -0,0 +1,203 @@
+package token
+
+import (
+	"github.com/PhenixChain/PhenixChain/codec"
+	sdk "github.com/PhenixChain/PhenixChain/types"
+	"github.com/PhenixChain/PhenixChain/x/params"
+)
+
+const (
+	// ModuleName is the name of the module
+	ModuleName = "token"
+
+	// StoreKey is the default store key for token
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for token
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for token
+	QuerierRoute = ModuleName
+)
+
+var (
+	// TokenKeyPrefix is the prefix of the token records, keyed by denom
+	TokenKeyPrefix = []byte{0x01}
+)
+
+// GetTokenKey returns the store key of the token with the given denom
+func GetTokenKey(denom string) []byte {
+	return append(TokenKeyPrefix, []byte(denom)...)
+}
+
+// Keeper - token keeper
+type Keeper struct {
+	storeKey   sdk.StoreKey
+	cdc        *codec.Codec
+	paramSpace params.Subspace
+	codespace  sdk.CodespaceType
+
+	bankKeeper          BankKeeper
+	feeCollectionKeeper FeeCollectionKeeper
+}
+
+// NewKeeper creates a new Keeper object
+func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, paramSpace params.Subspace,
+	bankKeeper BankKeeper, feeCollectionKeeper FeeCollectionKeeper,
+	codespace sdk.CodespaceType) Keeper {
+
+	return Keeper{
+		storeKey:            key,
+		cdc:                 cdc,
+		paramSpace:          paramSpace.WithKeyTable(ParamKeyTable()),
+		codespace:           codespace,
+		bankKeeper:          bankKeeper,
+		feeCollectionKeeper: feeCollectionKeeper,
+	}
+}
+
+// Codespace returns the keeper's codespace
+func (k Keeper) Codespace() sdk.CodespaceType {
+	return k.codespace
+}
+
+// GetToken returns the token with the given denom
+func (k Keeper) GetToken(ctx sdk.Context, denom string) (token Token, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(GetTokenKey(denom))
+	if bz == nil {
+		return token, false
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &token)
+	return token, true
+}
+
+// SetToken stores a token
+func (k Keeper) SetToken(ctx sdk.Context, token Token) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(token)
+	store.Set(GetTokenKey(token.Denom), bz)
+}
+
+// IterateTokens iterates over all tokens in denom order
+func (k Keeper) IterateTokens(ctx sdk.Context, process func(Token) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, TokenKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var token Token
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &token)
+		if process(token) {
+			return
+		}
+	}
+}
+
+// GetAllTokens returns all issued tokens
+func (k Keeper) GetAllTokens(ctx sdk.Context) (tokens Tokens) {
+	k.IterateTokens(ctx, func(token Token) bool {
+		tokens = append(tokens, token)
+		return false
+	})
+	return tokens
+}
+
+// IssueToken registers a new token, charging the issuance fee to its owner,
+// and credits the initial supply to the owner.
+func (k Keeper) IssueToken(ctx sdk.Context, token Token, initialSupply sdk.Int) (sdk.Tags, sdk.Error) {
+	if _, found := k.GetToken(ctx, token.Denom); found {
+		return nil, ErrTokenExists(k.codespace, token.Denom)
+	}
+	// coins of the denom may already exist without being a token, e.g. at genesis
+	if k.bankKeeper.GetSupply(ctx).AmountOf(token.Denom).IsPositive() {
+		return nil, ErrTokenExists(k.codespace, token.Denom)
+	}
+
+	// registering the display metadata also rejects denoms and symbols
+	// already used by other coins
+	metadata := append(k.bankKeeper.GetDenomMetadata(ctx), token.DenomMetadata())
+	if err := sdk.ValidateDenomMetadata(metadata); err != nil {
+		return nil, ErrInvalidToken(k.codespace, err)
+	}
+
+	issueFee := k.GetIssueFee(ctx)
+	if !issueFee.IsZero() {
+		_, _, err := k.bankKeeper.SubtractCoins(ctx, token.Owner, issueFee)
+		if err != nil {
+			return nil, err
+		}
+		_ = k.feeCollectionKeeper.AddCollectedFees(ctx, issueFee)
+	}
+
+	k.bankKeeper.SetDenomMetadata(ctx, metadata)
+	k.SetToken(ctx, token)
+
+	if !initialSupply.IsPositive() {
+		return sdk.EmptyTags(), nil
+	}
+	return k.mint(ctx, token, token.Owner, initialSupply)
+}
+
+// MintTokens increases the supply of a mintable token and credits the newly
+// minted coins to the recipient.
+func (k Keeper) MintTokens(ctx sdk.Context, owner, recipient sdk.AccAddress, amount sdk.Coin) (sdk.Tags, sdk.Error) {
+	token, found := k.GetToken(ctx, amount.Denom)
+	if !found {
+		return nil, ErrUnknownToken(k.codespace, amount.Denom)
+	}
+	if !token.Owner.Equals(owner) {
+		return nil, ErrNotOwner(k.codespace, token.Denom)
+	}
+	if !token.Mintable {
+		return nil, ErrNotMintable(k.codespace, token.Denom)
+	}
+	return k.mint(ctx, token, recipient, amount.Amount)
+}
+
+func (k Keeper) mint(ctx sdk.Context, token Token, recipient sdk.AccAddress, amount sdk.Int) (sdk.Tags, sdk.Error) {
+	token.Supply = token.Supply.Add(amount)
+	if token.HasMaxSupply() && token.Supply.GT(token.MaxSupply) {
+		return nil, ErrMaxSupplyExceeded(k.codespace, token.Denom, token.MaxSupply)
+	}
+
+	tags, err := k.bankKeeper.MintCoins(ctx, recipient, sdk.NewCoins(sdk.NewCoin(token.Denom, amount)))
+	if err != nil {
+		return nil, err
+	}
+
+	k.SetToken(ctx, token)
+	return tags, nil
+}
+
+// BurnTokens removes coins of a token from the sender and decreases the
+// supply of the token accordingly.
+func (k Keeper) BurnTokens(ctx sdk.Context, sender sdk.AccAddress, amount sdk.Coin) (sdk.Tags, sdk.Error) {
+	token, found := k.GetToken(ctx, amount.Denom)
+	if !found {
+		return nil, ErrUnknownToken(k.codespace, amount.Denom)
+	}
+
+	tags, err := k.bankKeeper.BurnCoins(ctx, sender, sdk.NewCoins(amount))
+	if err != nil {
+		return nil, err
+	}
+
+	token.Supply = token.Supply.Sub(amount.Amount)
+	k.SetToken(ctx, token)
+	return tags, nil
+}
+
+// TransferOwnership hands the ownership of a token over to a new owner.
+func (k Keeper) TransferOwnership(ctx sdk.Context, denom string, owner, newOwner sdk.AccAddress) sdk.Error {
+	token, found := k.GetToken(ctx, denom)
+	if !found {
+		return ErrUnknownToken(k.codespace, denom)
+	}
+	if !token.Owner.Equals(owner) {
+		return ErrNotOwner(k.codespace, denom)
+	}
+
+	token.Owner = newOwner
+	k.SetToken(ctx, token)
+	return nil
+}
@@ -0,0 +1,178 @@
+package token
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+	dbm "github.com/tendermint/tendermint/libs/db"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/PhenixChain/PhenixChain/codec"
+	"github.com/PhenixChain/PhenixChain/store"
+	sdk "github.com/PhenixChain/PhenixChain/types"
+	"github.com/PhenixChain/PhenixChain/x/auth"
+	"github.com/PhenixChain/PhenixChain/x/bank"
+	"github.com/PhenixChain/PhenixChain/x/params"
+)
+
+type testInput struct {
+	ctx sdk.Context
+	ak  auth.AccountKeeper
+	bk  bank.Keeper
+	fck auth.FeeCollectionKeeper
+	k   Keeper
+}
+
+func setupTestInput() testInput {
+	db := dbm.NewMemDB()
+
+	cdc := codec.New()
+	auth.RegisterBaseAccount(cdc)
+
+	keyAcc := sdk.NewKVStoreKey(auth.StoreKey)
+	keyAddress := sdk.NewKVStoreKey(auth.StoreAdrKey)
+	keyFee := sdk.NewKVStoreKey(auth.FeeStoreKey)
+	keyBank := sdk.NewKVStoreKey(bank.StoreKey)
+	keyParams := sdk.NewKVStoreKey(params.StoreKey)
+	tkeyParams := sdk.NewTransientStoreKey(params.TStoreKey)
+	keyToken := sdk.NewKVStoreKey(StoreKey)
+
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(keyAcc, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyAddress, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyFee, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyBank, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(tkeyParams, sdk.StoreTypeTransient, db)
+	ms.MountStoreWithDB(keyToken, sdk.StoreTypeIAVL, db)
+	ms.LoadLatestVersion()
+
+	pk := params.NewKeeper(cdc, keyParams, tkeyParams)
+	ak := auth.NewAccountKeeper(cdc, keyAcc, pk.Subspace(auth.DefaultParamspace), auth.ProtoBaseAccount)
+	bk := bank.NewBaseKeeper(ak, bank.NewTxKeeper(cdc, keyAddress), keyBank, pk.Subspace(bank.DefaultParamspace), bank.DefaultCodespace)
+	fck := auth.NewFeeCollectionKeeper(cdc, keyFee)
+	k := NewKeeper(cdc, keyToken, pk.Subspace(DefaultParamspace), bk, fck, DefaultCodespace)
+
+	ctx := sdk.NewContext(ms, abci.Header{ChainID: "test-chain-id"}, false, log.NewNopLogger())
+	bk.SetDenomMetadata(ctx, []sdk.DenomMetadata{sdk.NewDenomMetadata("upnx", "pnx", 6, "")})
+	k.SetIssueFee(ctx, sdk.NewCoins(sdk.NewInt64Coin("upnx", 100)))
+
+	return testInput{ctx: ctx, ak: ak, bk: bk, fck: fck, k: k}
+}
+
+func newAddr() sdk.AccAddress {
+	return sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+}
+
+func TestIssueMintBurn(t *testing.T) {
+	input := setupTestInput()
+	ctx, k := input.ctx, input.k
+	handler := NewHandler(k)
+	invariant := SupplyInvariant(k, input.ak)
+	bankInvariant := bank.TotalSupplyInvariant(input.bk, input.ak, input.fck.GetCollectedFees)
+
+	owner, holder := newAddr(), newAddr()
+	_, err := input.bk.MintCoins(ctx, owner, sdk.NewCoins(sdk.NewInt64Coin("upnx", 150)))
+	require.Nil(t, err)
+
+	issue := NewMsgIssue(owner, "ufan", "fan", 6, sdk.NewInt(1000), true, sdk.NewInt(600))
+	require.True(t, handler(ctx, issue).IsOK())
+	require.NoError(t, invariant(ctx))
+	require.NoError(t, bankInvariant(ctx))
+
+	// the issue fee is collected and the initial supply credited to the owner
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("upnx", 100)), input.fck.GetCollectedFees(ctx))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("ufan", 600), sdk.NewInt64Coin("upnx", 50)),
+		input.bk.GetCoins(ctx, owner))
+
+	// the display metadata is registered with bank
+	require.Contains(t, input.bk.GetDenomMetadata(ctx), sdk.NewDenomMetadata("ufan", "fan", 6, ""))
+
+	// denoms and symbols cannot be issued twice, nor without paying the fee
+	require.False(t, handler(ctx, issue).IsOK())
+	require.False(t, handler(ctx, NewMsgIssue(owner, "ufan2", "pnx", 6, sdk.ZeroInt(), true, sdk.ZeroInt())).IsOK())
+	require.False(t, handler(ctx, NewMsgIssue(owner, "ufoo", "foo", 6, sdk.ZeroInt(), true, sdk.ZeroInt())).IsOK())
+
+	// only the owner may mint, up to the max supply
+	require.False(t, handler(ctx, NewMsgMint(holder, holder, sdk.NewInt64Coin("ufan", 100))).IsOK())
+	require.True(t, handler(ctx, NewMsgMint(owner, holder, sdk.NewInt64Coin("ufan", 400))).IsOK())
+	require.False(t, handler(ctx, NewMsgMint(owner, holder, sdk.NewInt64Coin("ufan", 1))).IsOK())
+	require.NoError(t, invariant(ctx))
+
+	token, found := k.GetToken(ctx, "ufan")
+	require.True(t, found)
+	require.Equal(t, sdk.NewInt(1000), token.Supply)
+
+	// holders burn their own coins
+	require.True(t, handler(ctx, NewMsgBurn(holder, sdk.NewInt64Coin("ufan", 300))).IsOK())
+	require.False(t, handler(ctx, NewMsgBurn(holder, sdk.NewInt64Coin("ufan", 101))).IsOK())
+	require.False(t, handler(ctx, NewMsgBurn(owner, sdk.NewInt64Coin("upnx", 1))).IsOK())
+	require.NoError(t, invariant(ctx))
+
+	token, _ = k.GetToken(ctx, "ufan")
+	require.Equal(t, sdk.NewInt(700), token.Supply)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("ufan", 100)), input.bk.GetCoins(ctx, holder))
+
+	// the bank supply follows every mint and burn
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("ufan", 700), sdk.NewInt64Coin("upnx", 150)),
+		input.bk.GetSupply(ctx))
+	require.NoError(t, bankInvariant(ctx))
+
+	// coins created outside of the token module break the invariants
+	_, _, err = input.bk.AddCoins(ctx, holder, sdk.NewCoins(sdk.NewInt64Coin("ufan", 1)))
+	require.Nil(t, err)
+	require.Error(t, invariant(ctx))
+	require.Error(t, bankInvariant(ctx))
+}
+
+func TestIssueExistingDenom(t *testing.T) {
+	input := setupTestInput()
+	ctx, k := input.ctx, input.k
+	handler := NewHandler(k)
+	k.SetIssueFee(ctx, sdk.NewCoins())
+
+	// coins existing before the token module knows of them, such as the bond
+	// denom held since genesis, are part of the supply and cannot become a token
+	owner := newAddr()
+	_, err := input.bk.MintCoins(ctx, owner, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)))
+	require.Nil(t, err)
+	issue := NewMsgIssue(owner, sdk.DefaultBondDenom, "bond", 0, sdk.ZeroInt(), true, sdk.NewInt(1000))
+	require.False(t, handler(ctx, issue).IsOK())
+	_, found := k.GetToken(ctx, sdk.DefaultBondDenom)
+	require.False(t, found)
+
+	// coins only collected as fees remain in the supply as well
+	gold := sdk.NewCoins(sdk.NewInt64Coin("ugold", 10))
+	_, err = input.bk.MintCoins(ctx, owner, gold)
+	require.Nil(t, err)
+	_, _, err = input.bk.SubtractCoins(ctx, owner, gold)
+	require.Nil(t, err)
+	input.fck.AddCollectedFees(ctx, gold)
+	require.False(t, handler(ctx, NewMsgIssue(owner, "ugold", "gold", 6, sdk.ZeroInt(), true, sdk.ZeroInt())).IsOK())
+}
+
+func TestNonMintableAndOwnership(t *testing.T) {
+	input := setupTestInput()
+	ctx, k := input.ctx, input.k
+	handler := NewHandler(k)
+	k.SetIssueFee(ctx, sdk.NewCoins())
+
+	owner, newOwner := newAddr(), newAddr()
+	require.True(t, handler(ctx, NewMsgIssue(owner, "ufan", "fan", 6, sdk.ZeroInt(), false, sdk.NewInt(1000))).IsOK())
+	require.False(t, handler(ctx, NewMsgMint(owner, owner, sdk.NewInt64Coin("ufan", 1))).IsOK())
+
+	require.False(t, handler(ctx, NewMsgTransferOwnership(newOwner, owner, "ufan")).IsOK())
+	require.False(t, handler(ctx, NewMsgTransferOwnership(owner, newOwner, "ubar")).IsOK())
+	res := handler(ctx, NewMsgTransferOwnership(owner, newOwner, "ufan"))
+	require.True(t, res.IsOK())
+	require.Contains(t, res.Tags, sdk.MakeTag(sdk.TagAction, TagActionTransferOwnership))
+
+	token, _ := k.GetToken(ctx, "ufan")
+	require.Equal(t, newOwner, token.Owner)
+
+	genesis := ExportGenesis(ctx, k)
+	require.NoError(t, ValidateGenesis(genesis))
+	require.Equal(t, Tokens{token}, genesis.Tokens)
+}
@@ -0,0 +1,201 @@
+package token
+
+import (
+	"errors"
+
+	sdk "github.com/PhenixChain/PhenixChain/types"
+)
+
+// ensure Msg interface compliance at compile time
+var (
+	_ sdk.Msg = &MsgIssue{}
+	_ sdk.Msg = &MsgMint{}
+	_ sdk.Msg = &MsgBurn{}
+	_ sdk.Msg = &MsgTransferOwnership{}
+)
+
+//______________________________________________________________________
+
+// MsgIssue - issue a new token and credit its initial supply to the owner
+type MsgIssue struct {
+	Owner         sdk.AccAddress `json:"owner"`
+	Denom         string         `json:"denom"`
+	Symbol        string         `json:"symbol"`
+	Decimals      uint8          `json:"decimals"`
+	MaxSupply     sdk.Int        `json:"max_supply"`
+	Mintable      bool           `json:"mintable"`
+	InitialSupply sdk.Int        `json:"initial_supply"`
+}
+
+// NewMsgIssue creates a new MsgIssue object
+func NewMsgIssue(owner sdk.AccAddress, denom, symbol string, decimals uint8,
+	maxSupply sdk.Int, mintable bool, initialSupply sdk.Int) MsgIssue {
+
+	return MsgIssue{
+		Owner:         owner,
+		Denom:         denom,
+		Symbol:        symbol,
+		Decimals:      decimals,
+		MaxSupply:     maxSupply,
+		Mintable:      mintable,
+		InitialSupply: initialSupply,
+	}
+}
+
+// nolint
+func (msg MsgIssue) Route() string                { return RouterKey }
+func (msg MsgIssue) Type() string                 { return "issue" }
+func (msg MsgIssue) GetSigners() []sdk.AccAddress { return []sdk.AccAddress{msg.Owner} }
+
+// GetSignBytes gets the sign bytes for the msg MsgIssue
+func (msg MsgIssue) GetSignBytes() []byte {
+	bz := MsgCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// quick validity check
+func (msg MsgIssue) ValidateBasic() sdk.Error {
+	if msg.Owner.Empty() {
+		return ErrNilOwner(DefaultCodespace)
+	}
+	if msg.MaxSupply.IsNil() || msg.InitialSupply.IsNil() {
+		return ErrInvalidAmount(DefaultCodespace, "nil")
+	}
+	if msg.InitialSupply.IsNegative() {
+		return ErrInvalidAmount(DefaultCodespace, msg.InitialSupply.String())
+	}
+	if !msg.Mintable && !msg.InitialSupply.IsPositive() {
+		return ErrInvalidAmount(DefaultCodespace, msg.InitialSupply.String())
+	}
+
+	token := NewToken(msg.Denom, msg.Symbol, msg.Decimals, msg.MaxSupply, msg.Mintable, msg.Owner)
+	token.Supply = msg.InitialSupply
+	if err := token.Validate(); err != nil {
+		return ErrInvalidToken(DefaultCodespace, err)
+	}
+	return nil
+}
+
+//______________________________________________________________________
+
+// MsgMint - mint coins of a mintable token, signed by the token owner
+type MsgMint struct {
+	Owner     sdk.AccAddress `json:"owner"`
+	Recipient sdk.AccAddress `json:"recipient"`
+	Amount    sdk.Coin       `json:"amount"`
+}
+
+// NewMsgMint creates a new MsgMint object
+func NewMsgMint(owner, recipient sdk.AccAddress, amount sdk.Coin) MsgMint {
+	return MsgMint{
+		Owner:     owner,
+		Recipient: recipient,
+		Amount:    amount,
+	}
+}
+
+// nolint
+func (msg MsgMint) Route() string                { return RouterKey }
+func (msg MsgMint) Type() string                 { return "mint" }
+func (msg MsgMint) GetSigners() []sdk.AccAddress { return []sdk.AccAddress{msg.Owner} }
+
+// GetSignBytes gets the sign bytes for the msg MsgMint
+func (msg MsgMint) GetSignBytes() []byte {
+	bz := MsgCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// quick validity check
+func (msg MsgMint) ValidateBasic() sdk.Error {
+	if msg.Owner.Empty() {
+		return ErrNilOwner(DefaultCodespace)
+	}
+	if msg.Recipient.Empty() {
+		return sdk.ErrInvalidAddress("missing recipient address")
+	}
+	if !msg.Amount.IsPositive() {
+		return ErrInvalidAmount(DefaultCodespace, msg.Amount.String())
+	}
+	return nil
+}
+
+//______________________________________________________________________
+
+// MsgBurn - burn coins of a token held by the sender
+type MsgBurn struct {
+	Sender sdk.AccAddress `json:"sender"`
+	Amount sdk.Coin       `json:"amount"`
+}
+
+// NewMsgBurn creates a new MsgBurn object
+func NewMsgBurn(sender sdk.AccAddress, amount sdk.Coin) MsgBurn {
+	return MsgBurn{
+		Sender: sender,
+		Amount: amount,
+	}
+}
+
+// nolint
+func (msg MsgBurn) Route() string                { return RouterKey }
+func (msg MsgBurn) Type() string                 { return "burn" }
+func (msg MsgBurn) GetSigners() []sdk.AccAddress { return []sdk.AccAddress{msg.Sender} }
+
+// GetSignBytes gets the sign bytes for the msg MsgBurn
+func (msg MsgBurn) GetSignBytes() []byte {
+	bz := MsgCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// quick validity check
+func (msg MsgBurn) ValidateBasic() sdk.Error {
+	if msg.Sender.Empty() {
+		return sdk.ErrInvalidAddress("missing sender address")
+	}
+	if !msg.Amount.IsPositive() {
+		return ErrInvalidAmount(DefaultCodespace, msg.Amount.String())
+	}
+	return nil
+}
+
+//______________________________________________________________________
+
+// MsgTransferOwnership - hand the ownership of a token over to a new owner
+type MsgTransferOwnership struct {
+	Owner    sdk.AccAddress `json:"owner"`
+	NewOwner sdk.AccAddress `json:"new_owner"`
+	Denom    string         `json:"denom"`
+}
+
+// NewMsgTransferOwnership creates a new MsgTransferOwnership object
+func NewMsgTransferOwnership(owner, newOwner sdk.AccAddress, denom string) MsgTransferOwnership {
+	return MsgTransferOwnership{
+		Owner:    owner,
+		NewOwner: newOwner,
+		Denom:    denom,
+	}
+}
+
+// nolint
+func (msg MsgTransferOwnership) Route() string                { return RouterKey }
+func (msg MsgTransferOwnership) Type() string                 { return "transfer_ownership" }
+func (msg MsgTransferOwnership) GetSigners() []sdk.AccAddress { return []sdk.AccAddress{msg.Owner} }
+
+// GetSignBytes gets the sign bytes for the msg MsgTransferOwnership
+func (msg MsgTransferOwnership) GetSignBytes() []byte {
+	bz := MsgCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// quick validity check
+func (msg MsgTransferOwnership) ValidateBasic() sdk.Error {
+	if msg.Owner.Empty() {
+		return ErrNilOwner(DefaultCodespace)
+	}
+	if msg.NewOwner.Empty() {
+		return sdk.ErrInvalidAddress("missing new owner address")
+	}
+	if msg.Denom == "" {
+		return ErrInvalidToken(DefaultCodespace, errors.New("missing denom"))
+	}
+	return nil
+}
@@ -0,0 +1,63 @@
+package token
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/PhenixChain/PhenixChain/types"
+)
+
+func TestMsgIssueValidation(t *testing.T) {
+	owner := sdk.AccAddress([]byte("owner"))
+	var emptyAddr sdk.AccAddress
+
+	cases := []struct {
+		valid bool
+		msg   MsgIssue
+	}{
+		{true, NewMsgIssue(owner, "ufan", "fan", 6, sdk.ZeroInt(), true, sdk.ZeroInt())},
+		{true, NewMsgIssue(owner, "ufan", "fan", 6, sdk.NewInt(100), false, sdk.NewInt(100))},
+		{true, NewMsgIssue(owner, "fan", "fan", 0, sdk.ZeroInt(), false, sdk.NewInt(100))},
+		{false, NewMsgIssue(emptyAddr, "ufan", "fan", 6, sdk.ZeroInt(), true, sdk.ZeroInt())}, // no owner
+		{false, NewMsgIssue(owner, "UFAN", "fan", 6, sdk.ZeroInt(), true, sdk.ZeroInt())},     // invalid denom
+		{false, NewMsgIssue(owner, "ufan", "f", 6, sdk.ZeroInt(), true, sdk.ZeroInt())},       // invalid symbol
+		{false, NewMsgIssue(owner, "fan", "fan", 6, sdk.ZeroInt(), true, sdk.ZeroInt())},      // symbol is denom
+		{false, NewMsgIssue(owner, "ufan", "fan", 19, sdk.ZeroInt(), true, sdk.ZeroInt())},    // too many decimals
+		{false, NewMsgIssue(owner, "ufan", "fan", 6, sdk.NewInt(-1), true, sdk.ZeroInt())},    // negative max supply
+		{false, NewMsgIssue(owner, "ufan", "fan", 6, sdk.NewInt(10), true, sdk.NewInt(11))},   // above max supply
+		{false, NewMsgIssue(owner, "ufan", "fan", 6, sdk.ZeroInt(), false, sdk.ZeroInt())},    // nothing to ever hold
+		{false, NewMsgIssue(owner, "ufan", "fan", 6, sdk.ZeroInt(), true, sdk.NewInt(-1))},    // negative initial supply
+		{false, NewMsgIssue(owner, "ufan", "fan", 6, sdk.ZeroInt(), true, sdk.Int{})},         // no initial supply
+		{false, NewMsgIssue(owner, "ufan", "fan", 6, sdk.Int{}, true, sdk.ZeroInt())},         // no max supply
+	}
+
+	for i, tc := range cases {
+		err := tc.msg.ValidateBasic()
+		if tc.valid {
+			require.Nil(t, err, "case %d", i)
+		} else {
+			require.NotNil(t, err, "case %d", i)
+		}
+	}
+}
+
+func TestMsgMintBurnValidation(t *testing.T) {
+	owner := sdk.AccAddress([]byte("owner"))
+	var emptyAddr sdk.AccAddress
+	fan10 := sdk.NewInt64Coin("ufan", 10)
+	fan0 := sdk.NewInt64Coin("ufan", 0)
+
+	require.Nil(t, NewMsgMint(owner, owner, fan10).ValidateBasic())
+	require.NotNil(t, NewMsgMint(emptyAddr, owner, fan10).ValidateBasic())
+	require.NotNil(t, NewMsgMint(owner, emptyAddr, fan10).ValidateBasic())
+	require.NotNil(t, NewMsgMint(owner, owner, fan0).ValidateBasic())
+
+	require.Nil(t, NewMsgBurn(owner, fan10).ValidateBasic())
+	require.NotNil(t, NewMsgBurn(emptyAddr, fan10).ValidateBasic())
+	require.NotNil(t, NewMsgBurn(owner, fan0).ValidateBasic())
+
+	require.Nil(t, NewMsgTransferOwnership(owner, owner, "ufan").ValidateBasic())
+	require.NotNil(t, NewMsgTransferOwnership(owner, emptyAddr, "ufan").ValidateBasic())
+	require.NotNil(t, NewMsgTransferOwnership(owner, owner, "").ValidateBasic())
+}
@@ -0,0 +1,34 @@
+package token
+
+import (
+	sdk "github.com/PhenixChain/PhenixChain/types"
+	"github.com/PhenixChain/PhenixChain/x/params"
+)
+
+// Default parameter namespace
+const (
+	DefaultParamspace = ModuleName
+)
+
+var (
+	// key for the token issuance fee parameter
+	ParamStoreKeyIssueFee = []byte("IssueFee")
+)
+
+// type declaration for parameters
+func ParamKeyTable() params.KeyTable {
+	return params.NewKeyTable(
+		ParamStoreKeyIssueFee, sdk.Coins{},
+	)
+}
+
+// GetIssueFee gets the token issuance fee from the paramSpace
+func (k Keeper) GetIssueFee(ctx sdk.Context) (issueFee sdk.Coins) {
+	k.paramSpace.Get(ctx, ParamStoreKeyIssueFee, &issueFee)
+	return
+}
+
+// SetIssueFee sets the token issuance fee in the paramSpace
+func (k Keeper) SetIssueFee(ctx sdk.Context, issueFee sdk.Coins) {
+	k.paramSpace.Set(ctx, ParamStoreKeyIssueFee, issueFee)
+}
@@ -0,0 +1,77 @@
+package token
+
+import (
+	"fmt"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/PhenixChain/PhenixChain/codec"
+	sdk "github.com/PhenixChain/PhenixChain/types"
+)
+
+// Query endpoints supported by the token querier
+const (
+	QueryToken    = "token"
+	QueryTokens   = "tokens"
+	QueryIssueFee = "issue_fee"
+)
+
+// NewQuerier returns a token Querier handler.
+func NewQuerier(k Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, _ abci.RequestQuery) ([]byte, sdk.Error) {
+		switch path[0] {
+		case QueryToken:
+			return queryToken(ctx, path[1:], k)
+
+		case QueryTokens:
+			return queryTokens(ctx, k)
+
+		case QueryIssueFee:
+			return queryIssueFee(ctx, k)
+
+		default:
+			return nil, sdk.ErrUnknownRequest(fmt.Sprintf("unknown token query endpoint: %s", path[0]))
+		}
+	}
+}
+
+func queryToken(ctx sdk.Context, path []string, k Keeper) ([]byte, sdk.Error) {
+	if len(path) == 0 {
+		return nil, sdk.ErrUnknownRequest("missing token denom")
+	}
+
+	token, found := k.GetToken(ctx, path[0])
+	if !found {
+		return nil, ErrUnknownToken(k.codespace, path[0])
+	}
+
+	res, err := codec.MarshalJSONIndent(k.cdc, token)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to marshal JSON", err.Error()))
+	}
+
+	return res, nil
+}
+
+func queryTokens(ctx sdk.Context, k Keeper) ([]byte, sdk.Error) {
+	tokens := k.GetAllTokens(ctx)
+	if tokens == nil {
+		tokens = Tokens{}
+	}
+
+	res, err := codec.MarshalJSONIndent(k.cdc, tokens)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to marshal JSON", err.Error()))
+	}
+
+	return res, nil
+}
+
+func queryIssueFee(ctx sdk.Context, k Keeper) ([]byte, sdk.Error) {
+	res, err := codec.MarshalJSONIndent(k.cdc, k.GetIssueFee(ctx))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to marshal JSON", err.Error()))
+	}
+
+	return res, nil
+}
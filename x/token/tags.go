@@ -0,0 +1,15 @@
+package token
+
+// Tag keys and values, messages are also tagged with their action, module and
+// senders. The token actions are tagged alongside the message type, the action
+// every message is tagged with, so that searches by them keep working.
+var (
+	TagActionIssue             = "issue-token"
+	TagActionMint              = "mint-token"
+	TagActionBurn              = "burn-token"
+	TagActionTransferOwnership = "transfer-token-ownership"
+
+	TagKeyDenom    = "denom"
+	TagKeyOwner    = "owner"
+	TagKeyNewOwner = "new-owner"
+)
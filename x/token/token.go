@@ -0,0 +1,91 @@
+package token
+
+import (
+	"fmt"
+
+	sdk "github.com/PhenixChain/PhenixChain/types"
+)
+
+// Token is a fungible token issued by a user. Balances of the token are held
+// in regular accounts under Denom; Supply is the amount in circulation.
+type Token struct {
+	Denom     string         `json:"denom"`      // base denomination balances are stored in
+	Symbol    string         `json:"symbol"`     // display denomination
+	Decimals  uint8          `json:"decimals"`   // 1 symbol = 10^decimals denom
+	MaxSupply sdk.Int        `json:"max_supply"` // zero means the supply is not capped
+	Mintable  bool           `json:"mintable"`   // whether the owner may mint after issuance
+	Owner     sdk.AccAddress `json:"owner"`
+	Supply    sdk.Int        `json:"supply"`
+}
+
+// NewToken returns a new Token with no supply.
+func NewToken(denom, symbol string, decimals uint8, maxSupply sdk.Int,
+	mintable bool, owner sdk.AccAddress) Token {
+
+	return Token{
+		Denom:     denom,
+		Symbol:    symbol,
+		Decimals:  decimals,
+		MaxSupply: maxSupply,
+		Mintable:  mintable,
+		Owner:     owner,
+		Supply:    sdk.ZeroInt(),
+	}
+}
+
+// DenomMetadata returns the bank display metadata of the token.
+func (t Token) DenomMetadata() sdk.DenomMetadata {
+	return sdk.NewDenomMetadata(t.Denom, t.Symbol, t.Decimals, "")
+}
+
+// HasMaxSupply reports whether the supply of the token is capped.
+func (t Token) HasMaxSupply() bool {
+	return t.MaxSupply.IsPositive()
+}
+
+// Validate performs basic validation of the token.
+func (t Token) Validate() error {
+	if err := t.DenomMetadata().Validate(); err != nil {
+		return err
+	}
+	if t.Owner.Empty() {
+		return fmt.Errorf("token %s has no owner", t.Denom)
+	}
+	if t.MaxSupply.IsNegative() {
+		return fmt.Errorf("token %s has a negative max supply", t.Denom)
+	}
+	if t.Supply.IsNegative() {
+		return fmt.Errorf("token %s has a negative supply", t.Denom)
+	}
+	if t.HasMaxSupply() && t.Supply.GT(t.MaxSupply) {
+		return fmt.Errorf("token %s supply %s exceeds max supply %s", t.Denom, t.Supply, t.MaxSupply)
+	}
+	return nil
+}
+
+// String implements the Stringer interface.
+func (t Token) String() string {
+	return fmt.Sprintf(`Token:
+  Denom:      %s
+  Symbol:     %s
+  Decimals:   %d
+  Max Supply: %s
+  Mintable:   %t
+  Owner:      %s
+  Supply:     %s`, t.Denom, t.Symbol, t.Decimals, t.MaxSupply,
+		t.Mintable, t.Owner, t.Supply)
+}
+
+// Tokens is a collection of Token
+type Tokens []Token
+
+// String implements the Stringer interface.
+func (t Tokens) String() (out string) {
+	for _, token := range t {
+		out += token.String() + "\n"
+	}
+	if len(out) > 0 {
+		out = out[:len(out)-1]
+	}
+	return out
+}